@@ -2,12 +2,24 @@ package dao
 
 import (
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 )
 
+// A recurring payment from the pDAO treasury
+type TreasuryContract struct {
+	Recipient       common.Address `abi:"recipient"`
+	AmountPerPeriod *big.Int       `abi:"amountPerPeriod"`
+	PeriodLength    *big.Int       `abi:"periodLength"`
+	LastPaymentTime *big.Int       `abi:"lastPaymentTime"`
+	NumPeriods      *big.Int       `abi:"numPeriods"`
+	PeriodsPaid     *big.Int       `abi:"periodsPaid"`
+}
+
 func GetContractExists(rp *rocketpool.RocketPool, contractName string, opts *bind.CallOpts) (bool, error) {
 	rocketClaimDAO, err := getRocketClaimDAO(rp, opts)
 	if err != nil {
@@ -20,6 +32,19 @@ func GetContractExists(rp *rocketpool.RocketPool, contractName string, opts *bin
 	return *result, nil
 }
 
+// Get the details of a recurring treasury payment
+func GetContract(rp *rocketpool.RocketPool, contractName string, opts *bind.CallOpts) (TreasuryContract, error) {
+	rocketClaimDAO, err := getRocketClaimDAO(rp, opts)
+	if err != nil {
+		return TreasuryContract{}, err
+	}
+	result := new(TreasuryContract)
+	if err := rocketClaimDAO.Call(opts, result, "getContract", contractName); err != nil {
+		return TreasuryContract{}, fmt.Errorf("error getting contract %s: %w", contractName, err)
+	}
+	return *result, nil
+}
+
 // Get contracts
 var rocketClaimDAOLock sync.Mutex
 
@@ -50,6 +50,10 @@ type ExecutionClient interface {
 	// a timely execution of a transaction.
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 
+	// FeeHistory retrieves the fee market history for the requested number of blocks up to lastBlock,
+	// including the priority fees paid at each of the requested reward percentiles.
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+
 	// EstimateGas tries to estimate the gas needed to execute a specific
 	// transaction based on the current pending state of the backend blockchain.
 	// There is no guarantee that this is the true gas limit requirement as other
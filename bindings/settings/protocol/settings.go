@@ -0,0 +1,50 @@
+package protocol
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+)
+
+// Get the current value of a uint setting by its path
+func GetSettingUint(rp *rocketpool.RocketPool, contractName string, settingPath string, opts *bind.CallOpts) (*big.Int, error) {
+	settingsContract, err := rp.GetContract(contractName, opts)
+	if err != nil {
+		return nil, err
+	}
+	value := new(*big.Int)
+	if err := settingsContract.Call(opts, value, "getSettingUint", settingPath); err != nil {
+		return nil, fmt.Errorf("error getting setting %s: %w", settingPath, err)
+	}
+	return *value, nil
+}
+
+// Get the current value of a bool setting by its path
+func GetSettingBool(rp *rocketpool.RocketPool, contractName string, settingPath string, opts *bind.CallOpts) (bool, error) {
+	settingsContract, err := rp.GetContract(contractName, opts)
+	if err != nil {
+		return false, err
+	}
+	value := new(bool)
+	if err := settingsContract.Call(opts, value, "getSettingBool", settingPath); err != nil {
+		return false, fmt.Errorf("error getting setting %s: %w", settingPath, err)
+	}
+	return *value, nil
+}
+
+// Get the current value of an address setting by its path
+func GetSettingAddress(rp *rocketpool.RocketPool, contractName string, settingPath string, opts *bind.CallOpts) (common.Address, error) {
+	settingsContract, err := rp.GetContract(contractName, opts)
+	if err != nil {
+		return common.Address{}, err
+	}
+	value := new(common.Address)
+	if err := settingsContract.Call(opts, value, "getSettingAddress", settingPath); err != nil {
+		return common.Address{}, fmt.Errorf("error getting setting %s: %w", settingPath, err)
+	}
+	return *value, nil
+}
@@ -262,6 +262,36 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return exitValidator(c)
 				},
 			},
+			{
+				Name:      "consolidate",
+				Aliases:   []string{"co"},
+				Usage:     "Plan and request the consolidation of megapool validators into compounding validators (EIP-7251)",
+				UsageText: "rocketpool megapool consolidate",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes",
+						Usage: "Automatically confirm the action",
+					},
+					cli.StringFlag{
+						Name:  "source-id",
+						Usage: "The validator id to consolidate instead of following the plan",
+					},
+					cli.StringFlag{
+						Name:  "target-id",
+						Usage: "The validator id to consolidate into instead of following the plan",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return consolidateValidators(c)
+				},
+			},
 			{
 				Name:      "notify-validator-exit",
 				Aliases:   []string{"n"},
@@ -0,0 +1,182 @@
+package megapool
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+	"github.com/urfave/cli"
+)
+
+func consolidateValidators(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check if Saturn is already deployed
+	saturnResp, err := rp.IsSaturnDeployed()
+	if err != nil {
+		return err
+	}
+	if !saturnResp.IsSaturnDeployed {
+		fmt.Println("This command is only available after the Saturn upgrade.")
+		return nil
+	}
+
+	// Get the consolidation plan
+	plan, err := rp.GetConsolidationPlan()
+	if err != nil {
+		return err
+	}
+
+	// Show the consolidations that are already in progress
+	fmt.Printf("There are %d consolidation(s) waiting to be processed by the Beacon Chain.\n", plan.NetworkPendingCount)
+	if len(plan.PendingConsolidations) > 0 {
+		fmt.Println("Your pending consolidations:")
+		for _, consolidation := range plan.PendingConsolidations {
+			fmt.Printf("\tValidator ID %d (index %s) into validator ID %d (index %s)\n", consolidation.SourceId, consolidation.SourceIndex, consolidation.TargetId, consolidation.TargetIndex)
+		}
+	}
+	fmt.Println()
+
+	// Use the provided validators instead of the plan if requested
+	steps := plan.Steps
+	if c.IsSet("source-id") || c.IsSet("target-id") {
+		if !c.IsSet("source-id") || !c.IsSet("target-id") {
+			return fmt.Errorf("Both --source-id and --target-id must be provided to consolidate specific validators.")
+		}
+		sourceId, err := cliutils.ValidateUint32("source-id", c.String("source-id"))
+		if err != nil {
+			return err
+		}
+		targetId, err := cliutils.ValidateUint32("target-id", c.String("target-id"))
+		if err != nil {
+			return err
+		}
+		steps = []api.MegapoolConsolidationStep{{
+			SourceId:            sourceId,
+			TargetId:            targetId,
+			SwitchToCompounding: sourceId == targetId,
+		}}
+	} else {
+		if len(steps) == 0 {
+			fmt.Println("Your megapool doesn't have any validators that can be consolidated.")
+			return nil
+		}
+
+		// Show the plan
+		fmt.Printf("%s=== Consolidation Plan ===%s\n", colorGreen, colorReset)
+		for i, step := range steps {
+			if step.SwitchToCompounding {
+				fmt.Printf("%d. Switch validator ID %d to compounding withdrawal credentials\n", i+1, step.SourceId)
+			} else {
+				fmt.Printf("%d. Consolidate validator ID %d (%.6f ETH) into validator ID %d\n", i+1, step.SourceId, gweiToEth(step.SourceBalance), step.TargetId)
+			}
+		}
+		fmt.Println()
+
+		// Show the impact on the queue
+		fmt.Printf("%s=== Queue Impact ===%s\n", colorGreen, colorReset)
+		fmt.Printf("Validators exiting:           %d\n", plan.ExitingValidators)
+		fmt.Printf("Balance added to the churn:   %.6f ETH\n", gweiToEth(plan.ChurnBalance))
+		fmt.Printf("Balance already in the queue: %.6f ETH\n", gweiToEth(plan.PendingChurnBalance))
+		if plan.EstimatedQueueEpochs > 0 {
+			fmt.Printf("Estimated processing:         %d epochs (%s)\n", plan.EstimatedQueueEpochs, plan.EstimatedQueueTime.Format(TimeFormat))
+		}
+		fmt.Println("Consolidations are processed in order, so this delays the ones submitted after yours.")
+		fmt.Println()
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || prompt.Confirm(fmt.Sprintf("Are you sure you want to submit %d consolidation request(s)? Consolidated validators will exit and their balance will move to the target validator.", len(steps)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Submit the requests in order, since each one depends on the previous ones being processed
+	for i, step := range steps {
+		canConsolidate, err := rp.CanConsolidateValidators(step.SourceId, step.TargetId)
+		if err != nil {
+			return err
+		}
+		if !canConsolidate.CanConsolidate {
+			fmt.Printf("Validator ID %d can't be consolidated into validator ID %d:\n", step.SourceId, step.TargetId)
+			printConsolidationIssues(canConsolidate)
+			if i > 0 {
+				fmt.Println("\nThe requests you've already submitted need to be processed by the Beacon Chain first. They're listed as pending consolidations by this command; run it again once they're done to continue the plan.")
+			}
+			return nil
+		}
+
+		// Assign max fees
+		err = gas.AssignMaxFeeAndLimit(canConsolidate.GasInfo, rp, c.Bool("yes"))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("The current request fee is %.9f ETH; twice this will be sent in case the fee rises before the request is included, and the excess is not refunded.\n", eth.WeiToEth(canConsolidate.Fee))
+
+		// Request the consolidation
+		response, err := rp.ConsolidateValidators(step.SourceId, step.TargetId)
+		if err != nil {
+			return err
+		}
+
+		if step.SwitchToCompounding {
+			fmt.Printf("Requesting the switch of validator ID %d to compounding withdrawal credentials...\n", step.SourceId)
+		} else {
+			fmt.Printf("Requesting the consolidation of validator ID %d into validator ID %d...\n", step.SourceId, step.TargetId)
+		}
+		cliutils.PrintTransactionHash(rp, response.TxHash)
+		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+			return err
+		}
+	}
+
+	// Log & return
+	fmt.Println("Successfully submitted the consolidation requests. Consolidated balances are moved once the Beacon Chain processes the pending consolidations.")
+	return nil
+
+}
+
+// Print the reasons a consolidation can't be requested
+func printConsolidationIssues(response api.CanConsolidateValidatorsResponse) {
+	if response.InvalidStatus {
+		fmt.Println("Both validators need to be active on the Beacon Chain.")
+	}
+	if response.AlreadyExiting {
+		fmt.Println("One of the validators is already exiting.")
+	}
+	if response.NotActiveLongEnough {
+		fmt.Printf("The source validator can't be consolidated until epoch %d.\n", response.EligibleEpoch)
+	}
+	if response.AlreadyCompounding {
+		fmt.Println("The validator already has compounding withdrawal credentials.")
+	}
+	if response.TargetNotCompounding {
+		fmt.Println("The target validator needs to be switched to compounding withdrawal credentials first.")
+	}
+	if response.BlsCredentials {
+		fmt.Println("The source validator still has BLS (0x00) withdrawal credentials.")
+	}
+	if response.ContractCredentials {
+		fmt.Printf("The validator's withdrawal credentials belong to your megapool contract (%s), which can't submit consolidation requests.\n", response.CredentialsOwner.Hex())
+		fmt.Println("Megapool validators can't be consolidated until the megapool supports it.")
+	}
+	if response.NotCredentialsOwner {
+		fmt.Printf("The validator's withdrawal credentials belong to %s, not the node account. Only that address can request the consolidation.\n", response.CredentialsOwner.Hex())
+	}
+}
+
+// Convert a Beacon Chain balance to ETH for display
+func gweiToEth(gwei uint64) float64 {
+	return math.RoundDown(float64(gwei)/float64(eth.WeiPerGwei), 6)
+}
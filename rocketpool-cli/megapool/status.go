@@ -11,6 +11,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
+	validatorutils "github.com/rocket-pool/smartnode/shared/utils/validator"
 	"github.com/urfave/cli"
 )
 
@@ -233,6 +234,9 @@ func getValidatorStatus(c *cli.Context) error {
 	if noValidators {
 		fmt.Println("The megapool does not have any validators yet.")
 	}
+	if validatorMap.Warning != "" {
+		fmt.Printf("\n%sWARNING: %s%s\n", colorYellow, validatorMap.Warning, colorReset)
+	}
 
 	return nil
 
@@ -259,6 +263,15 @@ func printValidatorDetails(validator api.MegapoolValidatorDetails, status string
 		}
 		fmt.Printf("Validator index:              %s\n", validator.BeaconStatus.Index)
 		fmt.Printf("Beacon status:                %s\n", validator.BeaconStatus.Status)
+		if validator.Activated {
+			fmt.Printf("Beacon balance:               %.6f ETH\n", gweiToEth(validator.BeaconStatus.Balance))
+			fmt.Printf("Effective balance:            %.6f ETH\n", gweiToEth(validator.BeaconStatus.EffectiveBalance))
+			if validatorutils.IsCompounding(validator.BeaconStatus.WithdrawalCredentials) {
+				fmt.Printf("Compounding:                  yes\n")
+			} else {
+				fmt.Printf("Compounding:                  no\n")
+			}
+		}
 
 	}
 
@@ -301,6 +314,24 @@ func printValidatorDetails(validator api.MegapoolValidatorDetails, status string
 		fmt.Printf("Beacon status:                %s\n", validator.BeaconStatus.Status)
 	}
 
+	// Beacon Chain queue estimates
+	estimates := validator.QueueEstimates
+	if estimates.PendingDepositBalance > 0 {
+		fmt.Printf("Pending deposits:             %.6f ETH (position %d in the deposit queue)\n", gweiToEth(estimates.PendingDepositBalance), estimates.DepositQueuePosition+1)
+	}
+	if estimates.EstimatedActivationEpoch != 0 {
+		fmt.Printf("Estimated activation:         epoch %d (%s)\n", estimates.EstimatedActivationEpoch, estimates.EstimatedActivationTime.Format(TimeFormat))
+	}
+	if estimates.PendingWithdrawalBalance > 0 {
+		fmt.Printf("Pending withdrawals:          %.6f ETH\n", gweiToEth(estimates.PendingWithdrawalBalance))
+	}
+	if estimates.Consolidating {
+		fmt.Printf("Consolidating:                yes\n")
+	}
+	if estimates.EstimatedWithdrawableEpoch != 0 {
+		fmt.Printf("Estimated withdrawal:         epoch %d (%s)\n", estimates.EstimatedWithdrawableEpoch, estimates.EstimatedWithdrawableTime.Format(TimeFormat))
+	}
+
 	// Main details
 	if validator.ExpressUsed {
 		fmt.Printf("Express Ticket Used:          yes\n")
@@ -232,6 +232,9 @@ func closeMinipools(c *cli.Context) error {
 		}
 
 		fmt.Printf("Closing minipool %s...\n", minipool.Address.Hex())
+		if response.KeyRemovalError != "" {
+			fmt.Printf("%sWARNING: Couldn't remove the minipool's validator key from your Validator Client: %s%s\n", colorYellow, response.KeyRemovalError, colorReset)
+		}
 		cliutils.PrintTransactionHash(rp, response.TxHash)
 		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
 			fmt.Printf("Could not close minipool %s: %s.\n", minipool.Address.Hex(), err.Error())
@@ -142,6 +142,10 @@ func getStatus(c *cli.Context) error {
 		fmt.Println("")
 	}
 
+	if status.Warning != "" {
+		fmt.Printf("%sWARNING: %s%s\n\n", colorYellow, status.Warning, colorReset)
+	}
+
 	// Return
 	return nil
 
@@ -201,6 +205,15 @@ func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Ad
 		} else {
 			fmt.Printf("Validator seen:        no\n")
 		}
+		if minipool.QueueEstimates.PendingWithdrawalBalance > 0 {
+			fmt.Printf("Pending withdrawals:   %.6f ETH\n", math.RoundDown(float64(minipool.QueueEstimates.PendingWithdrawalBalance)/float64(eth.WeiPerGwei), 6))
+		}
+		if minipool.QueueEstimates.EstimatedActivationEpoch != 0 {
+			fmt.Printf("Est. activation:       epoch %d (%s)\n", minipool.QueueEstimates.EstimatedActivationEpoch, minipool.QueueEstimates.EstimatedActivationTime.Format(TimeFormat))
+		}
+		if minipool.QueueEstimates.EstimatedWithdrawableEpoch != 0 {
+			fmt.Printf("Est. withdrawal:       epoch %d (%s)\n", minipool.QueueEstimates.EstimatedWithdrawableEpoch, minipool.QueueEstimates.EstimatedWithdrawableTime.Format(TimeFormat))
+		}
 	}
 
 	// Withdrawal details - withdrawable minipools
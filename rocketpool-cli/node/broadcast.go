@@ -0,0 +1,69 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+func broadcast(c *cli.Context, input string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Load the signed transactions
+	bundle, err := wallet.ReadOfflineTransactionBundle(input)
+	if err != nil {
+		return err
+	}
+	if len(bundle.Transactions) == 0 {
+		fmt.Println("There are no transactions to submit.")
+		return nil
+	}
+	for _, tx := range bundle.Transactions {
+		if len(tx.Signed) == 0 {
+			return fmt.Errorf("The transaction with nonce %d hasn't been signed yet. Please sign the transactions with `rocketpool wallet sign-offline` first.", tx.Nonce)
+		}
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || prompt.Confirm(fmt.Sprintf("Are you sure you want to submit %d signed transaction(s)?", len(bundle.Transactions)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Submit them
+	blob, err := bundle.Encode()
+	if err != nil {
+		return err
+	}
+	response, err := rp.NodeBroadcast(blob)
+	if err != nil {
+		return err
+	}
+
+	// Wait for each one in order
+	for i, hash := range response.TxHashes {
+		if i < len(response.TxHashes)-1 {
+			cliutils.PrintTransactionHashNoCancel(rp, hash)
+		} else {
+			cliutils.PrintTransactionHash(rp, hash)
+		}
+		if _, err = rp.WaitForTransaction(hash); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Successfully submitted %d transaction(s).\n", len(response.TxHashes))
+	return nil
+
+}
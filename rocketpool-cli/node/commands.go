@@ -54,6 +54,80 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "tx-queue",
+				Usage:     "Show the automatic transactions the Smartnode is waiting on",
+				UsageText: "rocketpool node tx-queue",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getTxQueue(c)
+
+				},
+			},
+
+			{
+				Name:      "tasks",
+				Usage:     "Show the status of the automatic tasks the node and watchtower daemons run",
+				UsageText: "rocketpool node tasks",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getTasks(c)
+
+				},
+			},
+
+			{
+				Name:      "dry-run",
+				Usage:     "Show the transactions the node and watchtower daemons would have sent if dry-run mode weren't enabled",
+				UsageText: "rocketpool node dry-run",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getDryRun(c)
+
+				},
+			},
+
+			{
+				Name:      "broadcast",
+				Usage:     "Submit transactions that were signed offline with 'rocketpool wallet sign-offline'",
+				UsageText: "rocketpool node broadcast [options] signed-transactions-file-or-blob",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm submitting the transactions",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcast(c, c.Args().Get(0))
+
+				},
+			},
+
 			{
 				Name:      "register",
 				Aliases:   []string{"r"},
@@ -0,0 +1,72 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+)
+
+func getDryRun(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the simulated transactions
+	response, err := rp.NodeDryRun()
+	if err != nil {
+		return err
+	}
+
+	if response.Enabled {
+		fmt.Printf("%sDry-run mode is enabled. The node and watchtower daemons are simulating their automatic transactions instead of sending them.%s\n\n", colorYellow, colorReset)
+	} else {
+		fmt.Println("Dry-run mode is disabled. You can enable it in the Smartnode section of the `rocketpool service config` TUI.")
+		fmt.Println()
+	}
+
+	printed := false
+	for _, daemonName := range []string{tasks.NodeDaemonName, tasks.WatchtowerDaemonName} {
+		transactions, exists := response.Transactions[daemonName]
+		if !exists || len(transactions) == 0 {
+			continue
+		}
+		printed = true
+
+		fmt.Printf("=== %s daemon ===\n", daemonName)
+		for i := len(transactions) - 1; i >= 0; i-- {
+			tx := transactions[i]
+			if tx.SimulationError == "" {
+				fmt.Printf("- %s: would have sent %s%s%s\n", tx.Task, colorGreen, tx.Hash.Hex(), colorReset)
+			} else {
+				fmt.Printf("- %s: would have sent %s%s (reverts)%s\n", tx.Task, colorRed, tx.Hash.Hex(), colorReset)
+			}
+			fmt.Printf("\tSimulated:  %s ago at block %d\n", time.Since(tx.Time).Round(time.Second), tx.BlockNumber)
+			if tx.To != nil {
+				fmt.Printf("\tTo:         %s\n", tx.To.Hex())
+			}
+			if tx.Value != nil && tx.Value.Sign() > 0 {
+				fmt.Printf("\tValue:      %.6f ETH\n", eth.WeiToEth(tx.Value))
+			}
+			fmt.Printf("\tGas:        %d estimated, %d limit\n", tx.EstimatedGas, tx.GasLimit)
+			if tx.GasFeeCap != nil && tx.GasTipCap != nil {
+				fmt.Printf("\tFees:       %.2f gwei max, %.2f gwei priority\n", eth.WeiToGwei(tx.GasFeeCap), eth.WeiToGwei(tx.GasTipCap))
+			}
+			if tx.SimulationError != "" {
+				fmt.Printf("\t%sError:      %s%s\n", colorRed, tx.SimulationError, colorReset)
+			}
+		}
+		fmt.Println()
+	}
+
+	if !printed {
+		fmt.Println("The node and watchtower daemons haven't simulated any transactions.")
+	}
+	return nil
+
+}
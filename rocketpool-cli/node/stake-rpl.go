@@ -103,6 +103,12 @@ func nodeStakeRpl(c *cli.Context) error {
 				hash := response.ApproveTxHash
 				fmt.Printf("Approving legacy RPL for swapping...\n")
 				cliutils.PrintTransactionHash(rp, hash)
+
+				// The swap can't be built until the approval is on chain
+				if rp.IsOfflineTx() {
+					fmt.Println("Once the approval has been signed and broadcast, run this command again to swap your RPL.")
+					return nil
+				}
 				if _, err = rp.WaitForTransaction(hash); err != nil {
 					return err
 				}
@@ -147,6 +153,12 @@ func nodeStakeRpl(c *cli.Context) error {
 
 			fmt.Printf("Swapping old RPL for new RPL...\n")
 			cliutils.PrintTransactionHash(rp, swapResponse.SwapTxHash)
+
+			// The stake depends on the swapped RPL, so it can't be built until the swap is on chain
+			if rp.IsOfflineTx() {
+				fmt.Println("Once the swap has been signed and broadcast, run this command again to stake your RPL.")
+				return nil
+			}
 			if _, err = rp.WaitForTransaction(swapResponse.SwapTxHash); err != nil {
 				return err
 			}
@@ -288,6 +300,12 @@ func nodeStakeRpl(c *cli.Context) error {
 		hash := response.ApproveTxHash
 		fmt.Printf("Approving RPL for staking...\n")
 		cliutils.PrintTransactionHash(rp, hash)
+
+		// The stake can't be built until the approval is on chain
+		if rp.IsOfflineTx() {
+			fmt.Println("Once the approval has been signed and broadcast, run this command again to stake your RPL.")
+			return nil
+		}
 		if _, err = rp.WaitForTransaction(hash); err != nil {
 			return err
 		}
@@ -0,0 +1,85 @@
+package node
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+)
+
+func getTasks(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the task statuses
+	response, err := rp.NodeTasks()
+	if err != nil {
+		return err
+	}
+
+	printed := false
+	for _, daemonName := range []string{tasks.NodeDaemonName, tasks.WatchtowerDaemonName} {
+		statuses, exists := response.Tasks[daemonName]
+		if !exists || len(statuses) == 0 {
+			continue
+		}
+		printed = true
+
+		fmt.Printf("=== %s daemon ===\n", daemonName)
+		for _, status := range statuses {
+			switch {
+			case !status.Enabled:
+				fmt.Printf("- %s: disabled\n", status.Name)
+				continue
+			case !status.Active:
+				fmt.Printf("- %s: not active for this node\n", status.Name)
+			case status.LastRun.IsZero():
+				fmt.Printf("- %s: hasn't run yet\n", status.Name)
+			case status.LastError != "":
+				fmt.Printf("- %s: %sfailed%s\n", status.Name, colorRed, colorReset)
+			default:
+				fmt.Printf("- %s: %sOK%s\n", status.Name, colorGreen, colorReset)
+			}
+			fmt.Printf("\tInterval:  %s\n", status.Interval)
+			if len(status.Events) > 0 {
+				events := make([]string, len(status.Events))
+				for i, event := range status.Events {
+					events[i] = string(event)
+				}
+				fmt.Printf("\tEvents:    %s\n", strings.Join(events, ", "))
+			}
+			if !status.LastRun.IsZero() {
+				fmt.Printf("\tLast run:  %s ago (took %s)\n", time.Since(status.LastRun).Round(time.Second), status.LastDuration.Round(time.Millisecond))
+			}
+			if !status.NextRun.IsZero() {
+				fmt.Printf("\tNext run:  %s\n", formatNextRun(status.NextRun))
+			}
+			fmt.Printf("\tRuns:      %d (%d failed)\n", status.RunCount, status.ErrorCount)
+			if status.LastError != "" {
+				fmt.Printf("\t%sLast error: %s%s\n", colorRed, status.LastError, colorReset)
+			}
+		}
+		fmt.Println()
+	}
+
+	if !printed {
+		fmt.Println("The node and watchtower daemons haven't run any tasks yet.")
+	}
+	return nil
+
+}
+
+// Describe when a task will run next
+func formatNextRun(nextRun time.Time) string {
+	untilNextRun := time.Until(nextRun).Round(time.Second)
+	if untilNextRun <= 0 {
+		return "as soon as the daemon is ready"
+	}
+	return fmt.Sprintf("in %s", untilNextRun)
+}
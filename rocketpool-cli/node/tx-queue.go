@@ -0,0 +1,63 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+)
+
+func getTxQueue(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the queues
+	response, err := rp.NodeTxQueue()
+	if err != nil {
+		return err
+	}
+
+	for _, daemonName := range []string{txmanager.NodeDaemonName, txmanager.WatchtowerDaemonName} {
+		queue, exists := response.Queues[daemonName]
+		if !exists || (len(queue.Pending) == 0 && len(queue.Completed) == 0) {
+			continue
+		}
+
+		fmt.Printf("=== %s daemon ===\n", daemonName)
+		if len(queue.Pending) == 0 {
+			fmt.Println("No transactions are pending.")
+		} else {
+			fmt.Printf("%s%d transaction(s) are pending:%s\n", colorYellow, len(queue.Pending), colorReset)
+			for _, tx := range queue.Pending {
+				fmt.Printf("- %s (nonce %d)\n", tx.Description, tx.Nonce)
+				fmt.Printf("\tHash:         %s\n", tx.LatestHash().Hex())
+				fmt.Printf("\tPending for:  %s\n", time.Since(tx.SubmittedTime).Round(time.Second))
+				fmt.Printf("\tMax fee:      %.6f gwei (limit %.6f gwei)\n", eth.WeiToGwei(tx.GasFeeCap), eth.WeiToGwei(tx.MaxFeeCeiling))
+				fmt.Printf("\tSped up:      %d time(s)\n", tx.ReplacementCount())
+			}
+		}
+
+		if len(queue.Completed) > 0 {
+			fmt.Println()
+			fmt.Println("Recently finished transactions:")
+			for i := len(queue.Completed) - 1; i >= 0; i-- {
+				tx := queue.Completed[i]
+				color := colorGreen
+				if tx.Status != txmanager.TransactionStatus_Confirmed {
+					color = colorRed
+				}
+				fmt.Printf("- %s%s%s: %s (%s)\n", color, tx.Status, colorReset, tx.Description, tx.CompletedTime.Format(time.RFC822))
+			}
+		}
+		fmt.Println()
+	}
+
+	return nil
+
+}
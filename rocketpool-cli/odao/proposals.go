@@ -11,6 +11,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
@@ -138,6 +139,12 @@ func getProposal(c *cli.Context, id uint64) error {
 		return nil
 	}
 
+	// Get the changes the proposal makes
+	details, err := rp.TNDAOProposal(id)
+	if err != nil {
+		return err
+	}
+
 	// Find the proposer
 	var memberID string
 	for _, member := range allMembers.Members {
@@ -152,6 +159,12 @@ func getProposal(c *cli.Context, id uint64) error {
 	fmt.Printf("Message:              %s\n", proposal.Message)
 	fmt.Printf("Payload:              %s\n", proposal.PayloadStr)
 	fmt.Printf("Payload (bytes):      %s\n", hex.EncodeToString(proposal.Payload))
+	if changes := proposals.FormatProposalPayload(details.DecodedPayload); len(changes) > 0 {
+		fmt.Printf("Changes:              %s\n", changes[0])
+		for _, change := range changes[1:] {
+			fmt.Printf("                      %s\n", change)
+		}
+	}
 	fmt.Printf("Proposed by:          %s (%s)\n", memberID, proposal.ProposerAddress.Hex())
 	fmt.Printf("Created at:           %s\n", cliutils.GetDateTimeString(proposal.CreatedTime))
 
@@ -478,2192 +478,70 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 
 					{
-						Name:    "setting",
-						Aliases: []string{"s"},
-						Usage:   "Make a Protocol DAO setting proposal",
-						Subcommands: []cli.Command{
-
-							{
-								Name:    "auction",
-								Aliases: []string{"a"},
-								Usage:   "Auction settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "is-create-lot-enabled",
-										Aliases:   []string{"icle"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.CreateLotEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting auction is-create-lot-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingAuctionIsCreateLotEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "is-bid-on-lot-enabled",
-										Aliases:   []string{"ibole"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.BidOnLotEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting auction is-bid-on-lot-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingAuctionIsBidOnLotEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "lot-minimum-eth-value",
-										Aliases:   []string{"lminev"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.LotMinimumEthValueSettingPath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting auction lot-minimum-eth-value value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingAuctionLotMinimumEthValue(c, value)
-
-										},
-									},
-
-									{
-										Name:      "lot-maximum-eth-value",
-										Aliases:   []string{"lmaxev"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.LotMaximumEthValueSettingPath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting auction lot-maximum-eth-value value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingAuctionLotMaximumEthValue(c, value)
-
-										},
-									},
-
-									{
-										Name:      "lot-duration",
-										Aliases:   []string{"ld"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.LotDurationSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting auction lot-duration value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingAuctionLotDuration(c, value)
-
-										},
-									},
-
-									{
-										Name:      "lot-starting-price-ratio",
-										Aliases:   []string{"lspr"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.LotStartingPriceRatioSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting auction lot-starting-price-ratio value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingAuctionLotStartingPriceRatio(c, value)
-
-										},
-									},
-
-									{
-										Name:      "lot-reserve-price-ratio",
-										Aliases:   []string{"lrpr"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.LotReservePriceRatioSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting auction lot-reserve-price-ratio value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingAuctionLotReservePriceRatio(c, value)
-
-										},
-									},
-								},
-							},
-
-							{
-								Name:    "deposit",
-								Aliases: []string{"d"},
-								Usage:   "Deposit pool settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "is-depositing-enabled",
-										Aliases:   []string{"ide"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.DepositEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting deposit is-depositing-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositIsDepositingEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "are-deposit-assignments-enabled",
-										Aliases:   []string{"adae"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.AssignDepositsEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting deposit are-deposit-assignments-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositAreDepositAssignmentsEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "minimum-deposit",
-										Aliases:   []string{"md"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MinimumDepositSettingPath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting deposit minimum-deposit value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositMinimumDeposit(c, value)
-
-										},
-									},
-
-									{
-										Name:      "maximum-deposit-pool-size",
-										Aliases:   []string{"mdps"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MaximumDepositPoolSizeSettingPath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting deposit maximum-deposit-pool-size value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositMaximumDepositPoolSize(c, value)
-
-										},
-									},
-
-									{
-										Name:      "maximum-assignments-per-deposit",
-										Aliases:   []string{"mapd"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MaximumDepositAssignmentsSettingPath, uintUsage),
-										UsageText: "rocketpool pdao propose setting deposit maximum-assignments-per-deposit value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidatePositiveUint("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositMaximumAssignmentsPerDeposit(c, value)
-
-										},
-									},
-
-									{
-										Name:      "maximum-socialised-assignments-per-deposit",
-										Aliases:   []string{"msapd"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MaximumSocializedDepositAssignmentsSettingPath, uintUsage),
-										UsageText: "rocketpool pdao propose setting deposit maximum-socialised-assignments-per-deposit value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidatePositiveUint("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositMaximumSocialisedAssignmentsPerDeposit(c, value)
-
-										},
-									},
-
-									{
-										Name:      "deposit-fee",
-										Aliases:   []string{"df"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.DepositFeeSettingPath, "specify a percentage between 0 and 0.01 (e.g., '0.001' for 0.10%)"),
-										UsageText: "rocketpool pdao propose setting deposit deposit-fee value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositDepositFee(c, value)
-
-										},
-									},
-
-									{
-										Name:      "express-queue-rate",
-										Aliases:   []string{"eqr"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ExpressQueueRatePath, uintUsage),
-										UsageText: "rocketpool pdao propose setting deposit express-queue-rate value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidatePositiveUint("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositExpressQueueRate(c, value)
-
-										},
-									},
-
-									{
-										Name:      "express-queue-tickets-base-provision",
-										Aliases:   []string{"eqtbp"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ExpressQueueTicketsBaseProvisionPath, uintUsage),
-										UsageText: "rocketpool pdao propose setting deposit express-queue-tickets-base-provision value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidatePositiveUint("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingDepositExpressQueueTicketsBaseProvision(c, value)
-
-										},
-									},
-								},
-							},
-
-							{
-								Name:    "minipool",
-								Aliases: []string{"m"},
-								Usage:   "Minipool settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "is-submit-withdrawable-enabled",
-										Aliases:   []string{"iswe"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MinipoolSubmitWithdrawableEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting minipool is-submit-withdrawable-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMinipoolIsSubmitWithdrawableEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "launch-timeout",
-										Aliases:   []string{"lt"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MinipoolLaunchTimeoutSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting minipool launch-timeout value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMinipoolLaunchTimeout(c, value)
-
-										},
-									},
-
-									{
-										Name:      "is-bond-reduction-enabled",
-										Aliases:   []string{"ibre"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.BondReductionEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting minipool is-bond-reduction-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMinipoolIsBondReductionEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "max-count",
-										Aliases:   []string{"mc"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MaximumMinipoolCountSettingPath, uintUsage),
-										UsageText: "rocketpool pdao propose setting minipool max-count value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidatePositiveUint("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMinipoolMaximumCount(c, value)
-
-										},
-									},
-
-									{
-										Name:      "user-distribute-window-start",
-										Aliases:   []string{"udws"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MinipoolUserDistributeWindowStartSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting minipool user-distribute-window-start value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMinipoolUserDistributeWindowStart(c, value)
-
-										},
-									},
-
-									{
-										Name:      "user-distribute-window-length",
-										Aliases:   []string{"udwl"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MinipoolUserDistributeWindowLengthSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting minipool user-distribute-window-length value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMinipoolUserDistributeWindowLength(c, value)
-
-										},
-									},
-								},
-							},
-
-							{
-								Name:    "network",
-								Aliases: []string{"ne"},
-								Usage:   "Network settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "oracle-dao-consensus-threshold",
-										Aliases:   []string{"odct"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NodeConsensusThresholdSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting network oracle-dao-consensus-threshold value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkOracleDaoConsensusThreshold(c, value)
-
-										},
-									},
-
-									{
-										Name:      "node-penalty-threshold",
-										Aliases:   []string{"npt"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkPenaltyThresholdSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting network node-penalty-threshold value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkNodePenaltyThreshold(c, value)
-
-										},
-									},
-
-									{
-										Name:      "per-penalty-rate",
-										Aliases:   []string{"ppr"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkPenaltyPerRateSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting network per-penalty-rate value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkPerPenaltyRate(c, value)
-
-										},
-									},
-
-									{
-										Name:      "is-submit-balances-enabled",
-										Aliases:   []string{"isbe"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SubmitBalancesEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting network is-submit-balances-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkIsSubmitBalancesEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "submit-balances-frequency",
-										Aliases:   []string{"sbf"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SubmitBalancesFrequencySettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting network submit-balances-frequency value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkSubmitBalancesFrequency(c, value)
-
-										},
-									},
-
-									{
-										Name:      "is-submit-prices-enabled",
-										Aliases:   []string{"ispe"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SubmitPricesEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting network is-submit-prices-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkIsSubmitPricesEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "submit-prices-frequency",
-										Aliases:   []string{"spf"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SubmitPricesFrequencySettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting network submit-prices-frequency value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkSubmitPricesFrequency(c, value)
-
-										},
-									},
-
-									{
-										Name:      "minimum-node-fee",
-										Aliases:   []string{"minnf"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MinimumNodeFeeSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting network minimum-node-fee value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkMinimumNodeFee(c, value)
-
-										},
-									},
-
-									{
-										Name:      "target-node-fee",
-										Aliases:   []string{"tnf"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.TargetNodeFeeSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting network target-node-fee value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkTargetNodeFee(c, value)
-
-										},
-									},
-
-									{
-										Name:      "maximum-node-fee",
-										Aliases:   []string{"maxnf"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MaximumNodeFeeSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting network maximum-node-fee value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkMaximumNodeFee(c, value)
-
-										},
-									},
-
-									{
-										Name:      "node-fee-demand-range",
-										Aliases:   []string{"nfdr"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NodeFeeDemandRangeSettingPath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting network node-fee-demand-range value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkNodeFeeDemandRange(c, value)
-
-										},
-									},
-
-									{
-										Name:      "target-reth-collateral-rate",
-										Aliases:   []string{"trcr"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.TargetRethCollateralRateSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting network target-reth-collateral-rate value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkTargetRethCollateralRate(c, value)
-
-										},
-									},
-
-									{
-										Name:      "is-submit-rewards-enabled",
-										Aliases:   []string{"isre"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SubmitRewardsEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting network is-submit-rewards-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNetworkIsSubmitRewardsEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "allow-listed-controllers",
-										Aliases:   []string{"alc"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting", protocol.NetworkAllowListedControllersPath),
-										UsageText: "rocketpool pdao propose setting network allow-listed-controllers",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-											cli.StringFlag{
-												Name:  "addressList, a",
-												Usage: "One or more addresses, separated by commas with no spaces",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 0); err != nil {
-												return err
-											}
-											// Run
-											return setAllowListedControllers(c)
-
-										},
-									},
-
-									{
-										Name:      "node-commission-share",
-										Aliases:   []string{"ncs"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkNodeCommissionSharePath, percentUsage),
-										UsageText: "rocketpool pdao propose setting node node-commission-share value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeCommissionShare(c, value)
-
-										},
-									},
-
-									{
-										Name:      "node-commission-share-council-adder",
-										Aliases:   []string{"ncsca"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkNodeCommissionShareSecurityCouncilAdderPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting node node-commission-share-council-adder value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeCommissionShareSecurityCouncilAdder(c, value)
-
-										},
-									},
-
-									{
-										Name:      "voter-share",
-										Aliases:   []string{"vs"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkVoterSharePath, percentUsage),
-										UsageText: "rocketpool pdao propose setting node voter-share value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingVoterShare(c, value)
-
-										},
-									},
-
-									{
-										Name:      "pdao-share",
-										Aliases:   []string{"ps"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkPDAOSharePath, percentUsage),
-										UsageText: "rocketpool pdao propose setting node pdao-share value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingPDAOShare(c, value)
-
-										},
-									},
-
-									{
-										Name:      "max-node-share-security-council-adder",
-										Aliases:   []string{"mns"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkMaxNodeShareSecurityCouncilAdderPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting node max-node-share-security-council-adder value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeMaxNodeShareSecurityCouncilAdder(c, value)
-
-										},
-									},
-
-									{
-										Name:      "max-reth-balance-delta",
-										Aliases:   []string{"mrb"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NetworkMaxRethBalanceDeltaPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting node max-reth-balance-delta value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeMaxRethBalanceDelta(c, value)
-
-										},
-									},
-								},
-							},
-
-							{
-								Name:    "node",
-								Aliases: []string{"no"},
-								Usage:   "Node settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "is-registration-enabled",
-										Aliases:   []string{"ire"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NodeRegistrationEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting node is-registration-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeIsRegistrationEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "is-smoothing-pool-registration-enabled",
-										Aliases:   []string{"ispre"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SmoothingPoolRegistrationEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting node is-smoothing-pool-registration-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeIsSmoothingPoolRegistrationEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "is-depositing-enabled",
-										Aliases:   []string{"ide"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NodeDepositEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting node is-depositing-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeIsDepositingEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "are-vacant-minipools-enabled",
-										Aliases:   []string{"avme"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.VacantMinipoolsEnabledSettingPath, boolUsage),
-										UsageText: "rocketpool pdao propose setting node are-vacant-minipools-enabled value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateBool("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeAreVacantMinipoolsEnabled(c, value)
-
-										},
-									},
-
-									{
-										Name:      "minimum-per-minipool-stake",
-										Aliases:   []string{"minpms"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MinimumPerMinipoolStakeSettingPath, unboundedPercentUsage),
-										UsageText: "rocketpool pdao propose setting node minimum-per-minipool-stake value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeMinimumPerMinipoolStake(c, value)
-
-										},
-									},
-
-									{
-										Name:      "maximum-per-minipool-stake",
-										Aliases:   []string{"maxpms"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MaximumPerMinipoolStakeSettingPath, unboundedPercentUsage),
-										UsageText: "rocketpool pdao propose setting node maximum-per-minipool-stake value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeMaximumPerMinipoolStake(c, value)
-
-										},
-									},
-
-									{
-										Name:      "reduced-bond",
-										Aliases:   []string{"rb"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ReducedBondSettingPath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting node reduced-bond value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingReducedBond(c, value)
-
-										},
-									},
-
-									{
-										Name:      "node-unstaking-period",
-										Aliases:   []string{"nup"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.NodeUnstakingPeriodSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting node node-unstaking-period value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingNodeUnstakingPeriod(c, value)
-
-										},
-									},
-								},
-							},
-
-							{
-								Name:    "proposals",
-								Aliases: []string{"p"},
-								Usage:   "Proposal settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "vote-phase1-time",
-										Aliases:   []string{"vt1"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.VotePhase1TimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting proposals vote-phase1-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsVotePhase1Time(c, value)
-
-										},
-									},
-
-									{
-										Name:      "vote-phase2-time",
-										Aliases:   []string{"vt2"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.VotePhase2TimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting proposals vote-phase2-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsVotePhase2Time(c, value)
-
-										},
-									},
-
-									{
-										Name:      "vote-delay-time",
-										Aliases:   []string{"vdt"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.VoteDelayTimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting proposals vote-delay-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsVoteDelayTime(c, value)
-
-										},
-									},
-
-									{
-										Name:      "execute-time",
-										Aliases:   []string{"et"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ExecuteTimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting proposals execute-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsExecuteTime(c, value)
-
-										},
-									},
-
-									{
-										Name:      "proposal-bond",
-										Aliases:   []string{"pb"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ProposalBondSettingPath, floatRplUsage),
-										UsageText: "rocketpool pdao propose setting proposals proposal-bond value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsProposalBond(c, value)
-
-										},
-									},
-
-									{
-										Name:      "challenge-bond",
-										Aliases:   []string{"cb"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ChallengeBondSettingPath, floatRplUsage),
-										UsageText: "rocketpool pdao propose setting proposals challenge-bond value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsChallengeBond(c, value)
-
-										},
-									},
-
-									{
-										Name:      "challenge-period",
-										Aliases:   []string{"cp"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ChallengePeriodSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting proposals challenge-period value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsChallengePeriod(c, value)
-
-										},
-									},
-
-									{
-										Name:      "quorum",
-										Aliases:   []string{"q"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ProposalQuorumSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting proposals quorum value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsQuorum(c, value)
-
-										},
-									},
-
-									{
-										Name:      "veto-quorum",
-										Aliases:   []string{"vq"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ProposalVetoQuorumSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting proposals veto-quorum value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsVetoQuorum(c, value)
-
-										},
-									},
-
-									{
-										Name:      "max-block-age",
-										Aliases:   []string{"mba"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.ProposalMaxBlockAgeSettingPath, blockCountUsage),
-										UsageText: "rocketpool pdao propose setting proposals max-block-age value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidatePositiveUint("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingProposalsMaxBlockAge(c, value)
-
-										},
-									},
-								},
-							},
-
-							{
-								Name:    "rewards",
-								Aliases: []string{"r"},
-								Usage:   "Rewards settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "interval-periods",
-										Aliases:   []string{"ip"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting - the rewards interval will consist of this number of price/balances submission periods; %s", protocol.RewardsClaimIntervalPeriodsSettingPath, uintUsage),
-										UsageText: "rocketpool pdao propose setting rewards interval-periods value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidatePositiveUint("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingRewardsIntervalPeriods(c, value)
-
-										},
-									},
-								},
+						Name:      "settings-bundle",
+						Aliases:   []string{"sb"},
+						Usage:     "Propose changing several Protocol DAO settings at once, as described in a YAML file",
+						UsageText: "rocketpool pdao propose settings-bundle --file path",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "file, f",
+								Usage: "The YAML file listing the changes; each entry under 'settings' needs a 'contract' (e.g. 'network' or 'rocketDAOProtocolSettingsNetwork'), a 'setting' path, and a 'value' given the same way as for the matching 'pdao propose setting' command (e.g. '16.0' for ETH, '0.05' for 5%, or '72h' for a duration)",
 							},
-
-							{
-								Name:    "security",
-								Aliases: []string{"s"},
-								Usage:   "Security council settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "members-quorum",
-										Aliases:   []string{"mq"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SecurityMembersQuorumSettingPath, percentUsage),
-										UsageText: "rocketpool pdao propose setting security members-quorum value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), true)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingSecurityMembersQuorum(c, value)
-
-										},
-									},
-
-									{
-										Name:      "members-leave-time",
-										Aliases:   []string{"mlt"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SecurityMembersLeaveTimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting security members-leave-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingSecurityMembersLeaveTime(c, value)
-
-										},
-									},
-
-									{
-										Name:      "proposal-vote-time",
-										Aliases:   []string{"pvt"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SecurityProposalVoteTimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting security proposal-vote-phase1-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingSecurityProposalVoteTime(c, value)
-
-										},
-									},
-
-									{
-										Name:      "proposal-execute-time",
-										Aliases:   []string{"pet"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SecurityProposalExecuteTimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting security proposal-execute-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingSecurityProposalExecuteTime(c, value)
-
-										},
-									},
-
-									{
-										Name:      "proposal-action-time",
-										Aliases:   []string{"pat"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.SecurityProposalActionTimeSettingPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting security proposal-action-time value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingSecurityProposalActionTime(c, value)
-
-										},
-									},
-								},
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm all interactive questions",
 							},
+						},
+						Action: func(c *cli.Context) error {
 
-							{
-								Name:    "megapool",
-								Aliases: []string{"g"},
-								Usage:   "Megapool settings",
-								Subcommands: []cli.Command{
-
-									{
-										Name:      "time-before-dissolve",
-										Aliases:   []string{"tbd"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MegapoolTimeBeforeDissolveSettingsPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting megapool time-before-dissolve value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMegapoolTimeBeforeDissolve(c, value)
-
-										},
-									},
-
-									{
-										Name:      "maximum-megapool-eth-penalty",
-										Aliases:   []string{"mmep"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MegapoolMaximumMegapoolEthPenaltyPath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting megapool maximum-megapool-eth-penalty value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMaximumMegapoolEthPenalty(c, value)
-
-										},
-									},
-
-									{
-										Name:      "notify-threshold",
-										Aliases:   []string{"nt"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MegapoolNotifyThresholdPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting megapool time-before-dissolve value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+							if c.String("file") == "" {
+								return fmt.Errorf("Please provide the settings bundle with --file")
+							}
 
-											// Run
-											return proposeSettingMegapoolNotifyThreshold(c, value)
+							// Run
+							return proposeSettingsBundle(c, c.String("file"))
 
-										},
-									},
+						},
+					},
 
-									{
-										Name:      "late-notify-fine",
-										Aliases:   []string{"lnf"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MegapoolLateNotifyFinePath, floatEthUsage),
-										UsageText: "rocketpool pdao propose setting megapool late-notify-fine value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "raw",
-												Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
-											},
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
+					{
+						Name:    "setting",
+						Aliases: []string{"s"},
+						Usage:   "Make a Protocol DAO setting proposal",
+						Subcommands: getProposeSettingCommands(map[string][]cli.Command{
+							protocol.NetworkSettingsContractName: {
+								{
+									Name:      "allow-listed-controllers",
+									Aliases:   []string{"alc"},
+									Usage:     fmt.Sprintf("Propose updating the %s setting", protocol.NetworkAllowListedControllersPath),
+									UsageText: "rocketpool pdao propose setting network allow-listed-controllers",
+									Flags: []cli.Flag{
+										cli.BoolFlag{
+											Name:  "yes, y",
+											Usage: "Automatically confirm all interactive questions",
 										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateFloat(c, "value", c.Args().Get(0), false)
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMegapoolLateNotifyFine(c, value)
-
+										cli.StringFlag{
+											Name:  "addressList, a",
+											Usage: "One or more addresses, separated by commas with no spaces",
 										},
 									},
+									Action: func(c *cli.Context) error {
 
-									{
-										Name:      "user-distribute-length",
-										Aliases:   []string{"udl"},
-										Usage:     fmt.Sprintf("Propose updating the %s setting; %s", protocol.MegapoolUserDistributeWindowLengthPath, durationUsage),
-										UsageText: "rocketpool pdao propose setting megapool user-distribute-length value",
-										Flags: []cli.Flag{
-											cli.BoolFlag{
-												Name:  "yes, y",
-												Usage: "Automatically confirm all interactive questions",
-											},
-										},
-										Action: func(c *cli.Context) error {
-
-											// Validate args
-											if err := cliutils.ValidateArgCount(c, 1); err != nil {
-												return err
-											}
-											value, err := cliutils.ValidateDuration("value", c.Args().Get(0))
-											if err != nil {
-												return err
-											}
-
-											// Run
-											return proposeSettingMegapoolUserDistributeLength(c, value)
+										// Validate args
+										if err := cliutils.ValidateArgCount(c, 0); err != nil {
+											return err
+										}
+										// Run
+										return setAllowListedControllers(c)
 
-										},
 									},
 								},
 							},
-						},
+						}),
 					},
 				},
 			},
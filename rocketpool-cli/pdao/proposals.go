@@ -14,6 +14,7 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	utilsMath "github.com/rocket-pool/smartnode/shared/utils/math"
@@ -131,6 +132,12 @@ func getProposal(c *cli.Context, id uint64) error {
 		return nil
 	}
 
+	// Get the changes the proposal makes
+	details, err := rp.PDAOProposalDetails(id)
+	if err != nil {
+		return err
+	}
+
 	proposal.Message = utilsStrings.Sanitize(proposal.Message)
 
 	// Main details
@@ -138,6 +145,12 @@ func getProposal(c *cli.Context, id uint64) error {
 	fmt.Printf("Message:                %s\n", proposal.Message)
 	fmt.Printf("Payload:                %s\n", proposal.PayloadStr)
 	fmt.Printf("Payload (bytes):        %s\n", hex.EncodeToString(proposal.Payload))
+	if changes := proposals.FormatProposalPayload(details.DecodedPayload); len(changes) > 0 {
+		fmt.Printf("Changes:                %s\n", changes[0])
+		for _, change := range changes[1:] {
+			fmt.Printf("                        %s\n", change)
+		}
+	}
 	fmt.Printf("Proposed by:            %s\n", proposal.ProposerAddress.Hex())
 	fmt.Printf("Created at:             %s, %s\n", proposal.CreatedTime.Format(time.RFC822), getTimeDifference(proposal.CreatedTime))
 	fmt.Printf("State:                  %s\n", types.ProtocolDaoProposalStates[proposal.State])
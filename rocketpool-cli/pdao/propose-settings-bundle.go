@@ -0,0 +1,173 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+// A file describing a bundle of setting changes to propose together
+type settingsBundleFile struct {
+	Settings []struct {
+		Contract string `yaml:"contract"`
+		Setting  string `yaml:"setting"`
+		Value    string `yaml:"value"`
+	} `yaml:"settings"`
+}
+
+func proposeSettingsBundle(c *cli.Context, path string) error {
+
+	// Read the bundle
+	contracts, settings, values, err := readSettingsBundle(path)
+	if err != nil {
+		return err
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check if Saturn is already deployed
+	saturnResp, err := rp.IsSaturnDeployed()
+	if err != nil {
+		return err
+	}
+	if !saturnResp.IsSaturnDeployed {
+		for i, setting := range settings {
+			if isSaturnOnlySetting(contracts[i], setting) {
+				fmt.Printf("The %s setting can only be changed after the Saturn upgrade.\n", setting)
+				return nil
+			}
+		}
+	}
+
+	// Check if proposal can be made
+	canPropose, err := rp.PDAOCanProposeSettingsBundle(contracts, settings, values)
+	if err != nil {
+		return err
+	}
+	if !canPropose.CanPropose {
+		fmt.Println("Cannot propose settings bundle:")
+		if canPropose.InsufficientRpl {
+			fmt.Printf("You do not have enough RPL staked but unlocked to make another proposal (unlocked: %.6f RPL, required: %.6f RPL).\n",
+				eth.WeiToEth(big.NewInt(0).Sub(canPropose.StakedRpl, canPropose.LockedRpl)), eth.WeiToEth(canPropose.ProposalBond),
+			)
+		}
+		if canPropose.IsRplLockingDisallowed {
+			fmt.Println("Please enable RPL locking using the command 'rocketpool node allow-rpl-locking' to raise proposals.")
+		}
+		return nil
+	}
+
+	// Print the changes
+	settingWidth := len("Setting")
+	currentWidth := len("Current")
+	for _, change := range canPropose.Changes {
+		settingWidth = max(settingWidth, len(change.SettingPath))
+		currentWidth = max(currentWidth, len(change.CurrentValue))
+	}
+	fmt.Println("This proposal will make the following changes:")
+	fmt.Printf("%-*s  %-*s  %s\n", settingWidth, "Setting", currentWidth, "Current", "Proposed")
+	unchanged := 0
+	for _, change := range canPropose.Changes {
+		if change.RawCurrentValue == change.RawNewValue {
+			unchanged++
+			fmt.Printf("%s%-*s  %-*s  %s (unchanged)%s\n", colorYellow, settingWidth, change.SettingPath, currentWidth, change.CurrentValue, change.NewValue, colorReset)
+			continue
+		}
+		fmt.Printf("%-*s  %-*s  %s\n", settingWidth, change.SettingPath, currentWidth, change.CurrentValue, change.NewValue)
+	}
+	fmt.Println()
+	if unchanged > 0 {
+		fmt.Printf("%sNOTE: %d of the settings in this bundle already have the proposed value.%s\n\n", colorYellow, unchanged, colorReset)
+	}
+
+	// Assign max fees
+	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || prompt.Confirm("Are you sure you want to submit this proposal?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Submit proposal
+	response, err := rp.PDAOProposeSettingsBundle(contracts, settings, values, canPropose.BlockNumber)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Submitting proposal...\n")
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Successfully submitted a proposal to update %d settings.\n", len(settings))
+	return nil
+
+}
+
+// Read a settings bundle file, returning the contract, setting and value of each change
+func readSettingsBundle(path string) ([]string, []string, []string, error) {
+
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error reading settings bundle %s: %w", path, err)
+	}
+	bundle := settingsBundleFile{}
+	if err := yaml.Unmarshal(bytes, &bundle); err != nil {
+		return nil, nil, nil, fmt.Errorf("error parsing settings bundle %s: %w", path, err)
+	}
+	if len(bundle.Settings) == 0 {
+		return nil, nil, nil, fmt.Errorf("settings bundle %s doesn't have any settings", path)
+	}
+
+	contracts := make([]string, len(bundle.Settings))
+	settings := make([]string, len(bundle.Settings))
+	values := make([]string, len(bundle.Settings))
+	for i, entry := range bundle.Settings {
+		contract := strings.TrimSpace(entry.Contract)
+		for _, settingsContract := range proposals.ProtocolDaoSettings {
+			if contract == settingsContract.Command {
+				contract = settingsContract.ContractName
+			}
+		}
+		setting := strings.TrimSpace(entry.Setting)
+		value := strings.TrimSpace(entry.Value)
+		if contract == "" || setting == "" || value == "" {
+			return nil, nil, nil, fmt.Errorf("entry %d of settings bundle %s needs a contract, setting and value", i+1, path)
+		}
+		for _, field := range []string{contract, setting, value} {
+			if strings.ContainsAny(field, ", \t") {
+				return nil, nil, nil, fmt.Errorf("entry %d of settings bundle %s has an invalid value '%s'", i+1, path, field)
+			}
+		}
+		if _, exists := proposals.GetProtocolDaoSetting(contract, setting); !exists {
+			return nil, nil, nil, fmt.Errorf("entry %d of settings bundle %s: [%s - %s] is not a valid PDAO contract and setting name combo", i+1, path, contract, setting)
+		}
+		contracts[i] = contract
+		settings[i] = setting
+		values[i] = value
+	}
+	return contracts, settings, values, nil
+
+}
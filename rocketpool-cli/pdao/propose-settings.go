@@ -3,377 +3,142 @@ package pdao
 import (
 	"fmt"
 	"math/big"
-	"strings"
-	"time"
 
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
 )
 
-func proposeSettingAuctionIsCreateLotEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.AuctionSettingsContractName, protocol.CreateLotEnabledSettingPath, trueValue)
-}
-
-func proposeSettingAuctionIsBidOnLotEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.AuctionSettingsContractName, protocol.BidOnLotEnabledSettingPath, trueValue)
-}
-
-func proposeSettingAuctionLotMinimumEthValue(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.AuctionSettingsContractName, protocol.LotMinimumEthValueSettingPath, trueValue)
-}
-
-func proposeSettingAuctionLotMaximumEthValue(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.AuctionSettingsContractName, protocol.LotMaximumEthValueSettingPath, trueValue)
-}
-
-func proposeSettingAuctionLotDuration(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.AuctionSettingsContractName, protocol.LotDurationSettingPath, trueValue)
-}
-
-func proposeSettingAuctionLotStartingPriceRatio(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.AuctionSettingsContractName, protocol.LotStartingPriceRatioSettingPath, trueValue)
-}
-
-func proposeSettingAuctionLotReservePriceRatio(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.AuctionSettingsContractName, protocol.LotReservePriceRatioSettingPath, trueValue)
-}
-
-func proposeSettingDepositIsDepositingEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.DepositEnabledSettingPath, trueValue)
-}
-
-func proposeSettingDepositAreDepositAssignmentsEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.AssignDepositsEnabledSettingPath, trueValue)
-}
-
-func proposeSettingDepositMinimumDeposit(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.MinimumDepositSettingPath, trueValue)
-}
-
-func proposeSettingDepositMaximumDepositPoolSize(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.MaximumDepositPoolSizeSettingPath, trueValue)
-}
-
-func proposeSettingDepositMaximumAssignmentsPerDeposit(c *cli.Context, value uint64) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.MaximumDepositAssignmentsSettingPath, trueValue)
-}
-
-func proposeSettingDepositMaximumSocialisedAssignmentsPerDeposit(c *cli.Context, value uint64) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.MaximumSocializedDepositAssignmentsSettingPath, trueValue)
-}
-
-func proposeSettingDepositExpressQueueRate(c *cli.Context, value uint64) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.ExpressQueueRatePath, trueValue)
-}
-
-func proposeSettingDepositExpressQueueTicketsBaseProvision(c *cli.Context, value uint64) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.ExpressQueueTicketsBaseProvisionPath, trueValue)
-}
-
-func proposeSettingDepositDepositFee(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.DepositSettingsContractName, protocol.DepositFeeSettingPath, trueValue)
-}
-
-func proposeSettingMinipoolIsSubmitWithdrawableEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.MinipoolSettingsContractName, protocol.MinipoolSubmitWithdrawableEnabledSettingPath, trueValue)
-}
-
-func proposeSettingMinipoolLaunchTimeout(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.MinipoolSettingsContractName, protocol.MinipoolLaunchTimeoutSettingPath, trueValue)
-}
-
-func proposeSettingMinipoolIsBondReductionEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.MinipoolSettingsContractName, protocol.BondReductionEnabledSettingPath, trueValue)
-}
-
-func proposeSettingMinipoolMaximumCount(c *cli.Context, value uint64) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.MinipoolSettingsContractName, protocol.MaximumMinipoolCountSettingPath, trueValue)
-}
-
-func proposeSettingMinipoolUserDistributeWindowStart(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.MinipoolSettingsContractName, protocol.MinipoolUserDistributeWindowStartSettingPath, trueValue)
-}
-
-func proposeSettingMinipoolUserDistributeWindowLength(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.MinipoolSettingsContractName, protocol.MinipoolUserDistributeWindowLengthSettingPath, trueValue)
-}
-
-func proposeSettingNetworkOracleDaoConsensusThreshold(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NodeConsensusThresholdSettingPath, trueValue)
-}
-
-func proposeSettingNetworkNodePenaltyThreshold(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkPenaltyThresholdSettingPath, trueValue)
-}
-
-func proposeSettingNetworkPerPenaltyRate(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkPenaltyPerRateSettingPath, trueValue)
-}
-
-func proposeSettingNetworkIsSubmitBalancesEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.SubmitBalancesEnabledSettingPath, trueValue)
-}
-
-func proposeSettingNetworkSubmitBalancesFrequency(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.SubmitBalancesFrequencySettingPath, trueValue)
-}
-
-func proposeSettingNetworkIsSubmitPricesEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.SubmitPricesEnabledSettingPath, trueValue)
-}
-
-func proposeSettingNetworkSubmitPricesFrequency(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.SubmitPricesFrequencySettingPath, trueValue)
-}
-
-func proposeSettingNetworkMinimumNodeFee(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.MinimumNodeFeeSettingPath, trueValue)
-}
-
-func proposeSettingNetworkTargetNodeFee(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.TargetNodeFeeSettingPath, trueValue)
-}
-
-func proposeSettingNetworkMaximumNodeFee(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.MaximumNodeFeeSettingPath, trueValue)
-}
-
-func proposeSettingNetworkNodeFeeDemandRange(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NodeFeeDemandRangeSettingPath, trueValue)
-}
-
-func proposeSettingNetworkTargetRethCollateralRate(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.TargetRethCollateralRateSettingPath, trueValue)
-}
-
-func proposeSettingNetworkIsSubmitRewardsEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.SubmitRewardsEnabledSettingPath, trueValue)
-}
-
-func proposeSettingNodeIsRegistrationEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.NodeRegistrationEnabledSettingPath, trueValue)
-}
-
-func proposeSettingNodeIsSmoothingPoolRegistrationEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.SmoothingPoolRegistrationEnabledSettingPath, trueValue)
-}
-
-func proposeSettingNodeIsDepositingEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.NodeDepositEnabledSettingPath, trueValue)
-}
-
-func proposeSettingNodeAreVacantMinipoolsEnabled(c *cli.Context, value bool) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.VacantMinipoolsEnabledSettingPath, trueValue)
-}
-
-func proposeSettingNodeMinimumPerMinipoolStake(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.MinimumPerMinipoolStakeSettingPath, trueValue)
-}
-
-func proposeSettingNodeMaximumPerMinipoolStake(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.MaximumPerMinipoolStakeSettingPath, trueValue)
-}
-
-func proposeSettingReducedBond(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.ReducedBondSettingPath, trueValue)
-}
-
-func proposeSettingNodeUnstakingPeriod(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.NodeSettingsContractName, protocol.NodeUnstakingPeriodSettingPath, trueValue)
-}
-
-func proposeSettingProposalsVotePhase1Time(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.VotePhase1TimeSettingPath, trueValue)
-}
-
-func proposeSettingProposalsVotePhase2Time(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.VotePhase2TimeSettingPath, trueValue)
-}
-
-func proposeSettingProposalsVoteDelayTime(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.VoteDelayTimeSettingPath, trueValue)
-}
-
-func proposeSettingProposalsExecuteTime(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.ExecuteTimeSettingPath, trueValue)
-}
-
-func proposeSettingProposalsProposalBond(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.ProposalBondSettingPath, trueValue)
-}
-
-func proposeSettingProposalsChallengeBond(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.ChallengeBondSettingPath, trueValue)
-}
-
-func proposeSettingProposalsChallengePeriod(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.ChallengePeriodSettingPath, trueValue)
-}
-
-func proposeSettingProposalsQuorum(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.ProposalQuorumSettingPath, trueValue)
-}
-
-func proposeSettingProposalsVetoQuorum(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.ProposalVetoQuorumSettingPath, trueValue)
-}
-
-func proposeSettingProposalsMaxBlockAge(c *cli.Context, value uint64) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.ProposalsSettingsContractName, protocol.ProposalMaxBlockAgeSettingPath, trueValue)
-}
-
-func proposeSettingRewardsIntervalPeriods(c *cli.Context, value uint64) error {
-	trueValue := fmt.Sprint(value)
-	return proposeSetting(c, protocol.RewardsSettingsContractName, protocol.RewardsClaimIntervalPeriodsSettingPath, trueValue)
-}
-
-func proposeSettingSecurityMembersQuorum(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.SecuritySettingsContractName, protocol.SecurityMembersQuorumSettingPath, trueValue)
-}
-
-func proposeSettingSecurityMembersLeaveTime(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.SecuritySettingsContractName, protocol.SecurityMembersLeaveTimeSettingPath, trueValue)
-}
-
-func proposeSettingSecurityProposalVoteTime(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.SecuritySettingsContractName, protocol.SecurityProposalVoteTimeSettingPath, trueValue)
-}
-
-func proposeSettingSecurityProposalExecuteTime(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.SecuritySettingsContractName, protocol.SecurityProposalExecuteTimeSettingPath, trueValue)
-}
-
-func proposeSettingSecurityProposalActionTime(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.SecuritySettingsContractName, protocol.SecurityProposalActionTimeSettingPath, trueValue)
-}
-
-func proposeSettingNetworkAllowListedControllers(c *cli.Context, value []common.Address) error {
-	strs := make([]string, len(value))
-	for i, addr := range value {
-		strs[i] = addr.Hex()
+// Get the `pdao propose setting` commands, one group per settings contract; extraCommands holds any hand-written commands to add to a contract's group
+func getProposeSettingCommands(extraCommands map[string][]cli.Command) []cli.Command {
+	commands := make([]cli.Command, 0, len(proposals.ProtocolDaoSettings))
+	for _, contract := range proposals.ProtocolDaoSettings {
+		subcommands := make([]cli.Command, 0, len(contract.Settings))
+		for _, setting := range contract.Settings {
+			subcommands = append(subcommands, getProposeSettingCommand(contract, setting))
+		}
+		commands = append(commands, cli.Command{
+			Name:        contract.Command,
+			Aliases:     []string{contract.Alias},
+			Usage:       contract.Usage,
+			Subcommands: append(subcommands, extraCommands[contract.ContractName]...),
+		})
 	}
-	trueValue := strings.Join(strs, "")
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkAllowListedControllersPath, trueValue)
-}
-
-func proposeSettingMegapoolTimeBeforeDissolve(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.MegapoolSettingsContractName, protocol.MegapoolTimeBeforeDissolveSettingsPath, trueValue)
-}
-
-func proposeSettingMaximumMegapoolEthPenalty(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.MegapoolSettingsContractName, protocol.MegapoolMaximumMegapoolEthPenaltyPath, trueValue)
-}
-
-func proposeSettingMegapoolNotifyThreshold(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.MegapoolSettingsContractName, protocol.MegapoolNotifyThresholdPath, trueValue)
-}
-
-func proposeSettingMegapoolLateNotifyFine(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.MegapoolSettingsContractName, protocol.MegapoolLateNotifyFinePath, trueValue)
-}
-
-func proposeSettingMegapoolUserDistributeLength(c *cli.Context, value time.Duration) error {
-	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(c, protocol.MegapoolSettingsContractName, protocol.MegapoolUserDistributeWindowLengthPath, trueValue)
-}
-
-func proposeSettingNodeCommissionShare(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionSharePath, trueValue)
+	return commands
 }
 
-func proposeSettingNodeCommissionShareSecurityCouncilAdder(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionShareSecurityCouncilAdderPath, trueValue)
-}
-
-func proposeSettingVoterShare(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkVoterSharePath, trueValue)
+// Get the command that proposes updating a setting
+func getProposeSettingCommand(contract proposals.ProtocolDaoSettingsContract, setting proposals.ProtocolDaoSetting) cli.Command {
+	usage := fmt.Sprintf("Propose updating the %s setting", setting.Path)
+	if setting.Description != "" {
+		usage += " - " + setting.Description
+	}
+	usage += "; " + getSettingValueUsage(setting)
+
+	flags := []cli.Flag{}
+	if isFloatSetting(setting) {
+		flags = append(flags, cli.BoolFlag{
+			Name:  "raw",
+			Usage: "Add this flag if your setting is an 18-decimal-fixed-point-integer (wei) value instead of a float",
+		})
+	}
+	flags = append(flags, cli.BoolFlag{
+		Name:  "yes, y",
+		Usage: "Automatically confirm all interactive questions",
+	})
+
+	return cli.Command{
+		Name:      setting.Command,
+		Aliases:   []string{setting.Alias},
+		Usage:     usage,
+		UsageText: fmt.Sprintf("rocketpool pdao propose setting %s %s value", contract.Command, setting.Command),
+		Flags:     flags,
+		Action: func(c *cli.Context) error {
+
+			// Validate args
+			if err := cliutils.ValidateArgCount(c, 1); err != nil {
+				return err
+			}
+			value, err := parseSettingValue(c, setting, c.Args().Get(0))
+			if err != nil {
+				return err
+			}
+
+			// Run
+			return proposeSetting(c, contract.ContractName, setting.Path, value)
+
+		},
+	}
 }
 
-func proposeSettingPDAOShare(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkPDAOSharePath, trueValue)
+// Get the hint on how to specify a setting's value
+func getSettingValueUsage(setting proposals.ProtocolDaoSetting) string {
+	if setting.ValueUsage != "" {
+		return setting.ValueUsage
+	}
+	switch setting.Unit {
+	case api.ProposalSettingUnit_Bool:
+		return boolUsage
+	case api.ProposalSettingUnit_Eth:
+		return floatEthUsage
+	case api.ProposalSettingUnit_Rpl:
+		return floatRplUsage
+	case api.ProposalSettingUnit_Percent:
+		if setting.IsUnbounded {
+			return unboundedPercentUsage
+		}
+		return percentUsage
+	case api.ProposalSettingUnit_Duration:
+		return durationUsage
+	case api.ProposalSettingUnit_Blocks:
+		return blockCountUsage
+	}
+	return uintUsage
 }
 
-func proposeMaxNodeShareSecurityCouncilAdder(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkMaxNodeShareSecurityCouncilAdderPath, trueValue)
+// Check if a setting's value is given as a float that's stored as an 18-decimal fixed point integer
+func isFloatSetting(setting proposals.ProtocolDaoSetting) bool {
+	switch setting.Unit {
+	case api.ProposalSettingUnit_Eth, api.ProposalSettingUnit_Rpl, api.ProposalSettingUnit_Percent:
+		return true
+	}
+	return false
 }
 
-func proposeMaxRethBalanceDelta(c *cli.Context, value *big.Int) error {
-	trueValue := value.String()
-	return proposeSetting(c, protocol.NetworkSettingsContractName, protocol.NetworkMaxRethBalanceDeltaPath, trueValue)
+// Parse a setting's value from the command line into the string the API expects
+func parseSettingValue(c *cli.Context, setting proposals.ProtocolDaoSetting, value string) (string, error) {
+	switch {
+	case setting.Unit == api.ProposalSettingUnit_Bool:
+		boolValue, err := cliutils.ValidateBool("value", value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(boolValue), nil
+	case isFloatSetting(setting):
+		isFraction := setting.Unit == api.ProposalSettingUnit_Percent && !setting.IsUnbounded
+		floatValue, err := cliutils.ValidateFloat(c, "value", value, isFraction)
+		if err != nil {
+			return "", err
+		}
+		return floatValue.String(), nil
+	case setting.Unit == api.ProposalSettingUnit_Duration:
+		duration, err := cliutils.ValidateDuration("value", value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(uint64(duration.Seconds())), nil
+	}
+	count, err := cliutils.ValidatePositiveUint("value", value)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(count), nil
 }
 
 // Master general proposal function
@@ -390,7 +155,7 @@ func proposeSetting(c *cli.Context, contract string, setting string, value strin
 	if err != nil {
 		return err
 	}
-	if !saturnResp.IsSaturnDeployed && isSaturnOnlySetting(setting) {
+	if !saturnResp.IsSaturnDeployed && isSaturnOnlySetting(contract, setting) {
 		fmt.Println("This command is only available after the Saturn upgrade.")
 		return nil
 	}
@@ -443,28 +208,7 @@ func proposeSetting(c *cli.Context, contract string, setting string, value strin
 }
 
 // Returns true if the given setting is only available after the Saturn upgrade.
-func isSaturnOnlySetting(setting string) bool {
-
-	// Map of saturn only settings
-	saturnOnlySettings := map[string]struct{}{
-		protocol.ExpressQueueRatePath:                               {},
-		protocol.ExpressQueueTicketsBaseProvisionPath:               {},
-		protocol.NetworkAllowListedControllersPath:                  {},
-		protocol.NetworkNodeCommissionSharePath:                     {},
-		protocol.NetworkNodeCommissionShareSecurityCouncilAdderPath: {},
-		protocol.NetworkVoterSharePath:                              {},
-		protocol.NetworkPDAOSharePath:                               {},
-		protocol.NetworkMaxNodeShareSecurityCouncilAdderPath:        {},
-		protocol.NetworkMaxRethBalanceDeltaPath:                     {},
-		protocol.ReducedBondSettingPath:                             {},
-		protocol.NodeUnstakingPeriodSettingPath:                     {},
-		protocol.MegapoolTimeBeforeDissolveSettingsPath:             {},
-		protocol.MegapoolMaximumMegapoolEthPenaltyPath:              {},
-		protocol.MegapoolNotifyThresholdPath:                        {},
-		protocol.MegapoolLateNotifyFinePath:                         {},
-		protocol.MegapoolUserDistributeWindowLengthPath:             {},
-	}
-
-	_, exists := saturnOnlySettings[setting]
-	return exists
+func isSaturnOnlySetting(contract string, setting string) bool {
+	settingInfo, exists := proposals.GetProtocolDaoSetting(contract, setting)
+	return exists && settingInfo.IsSaturnOnly
 }
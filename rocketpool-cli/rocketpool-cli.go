@@ -62,6 +62,10 @@ A special thanks to the Rocket Pool community for all their contributions.
 			Name:  "daemon-path, d",
 			Usage: "Interact with a Rocket Pool service daemon at a `path` on the host OS, running outside of docker",
 		},
+		cli.StringFlag{
+			Name:  "api-server",
+			Usage: "Send API commands to a running `rocketpool api serve` instance at this unix socket path or http:// URL instead of starting the daemon for each command",
+		},
 		cli.Float64Flag{
 			Name:  "maxFee, f",
 			Usage: "The max fee (including the priority fee) you want a transaction to cost, in gwei",
@@ -78,6 +82,14 @@ A special thanks to the Rocket Pool community for all their contributions.
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.BoolFlag{
+			Name:  "offline-tx",
+			Usage: "Export transactions for signing on an offline machine with 'rocketpool wallet sign-offline' instead of sending them. Use 'rocketpool node broadcast' to submit them once they're signed",
+		},
+		cli.StringFlag{
+			Name:  "node",
+			Usage: "The name of the node to run the command for, when this installation manages several nodes. Leave it blank or use 'default' for the primary node",
+		},
 		cli.BoolFlag{
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
@@ -489,6 +489,88 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:  "slashing-protection",
+				Usage: "Manage the slashing protection database of your validator client",
+				Subcommands: []cli.Command{
+
+					{
+						Name:      "export",
+						Aliases:   []string{"e"},
+						Usage:     "Export the validator client's slashing protection database to an EIP-3076 interchange file",
+						UsageText: "rocketpool service slashing-protection export [options]",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "file, f",
+								Usage: "The path on the node to write the interchange file to (defaults to slashing-protection.json in the validators folder of your data directory)",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run command
+							return exportSlashingProtection(c)
+
+						},
+					},
+
+					{
+						Name:      "import",
+						Aliases:   []string{"i"},
+						Usage:     "Import an EIP-3076 interchange file into the validator client's slashing protection database",
+						UsageText: "rocketpool service slashing-protection import [options]",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "file, f",
+								Usage: "The path on the node of the interchange file to import (defaults to slashing-protection.json in the validators folder of your data directory)",
+							},
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm stopping the validator client during the import",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run command
+							return importSlashingProtection(c)
+
+						},
+					},
+
+					{
+						Name:      "inspect",
+						Usage:     "Show the validators and signing history in an EIP-3076 interchange file",
+						UsageText: "rocketpool service slashing-protection inspect [options]",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "file, f",
+								Usage: "The path of the interchange file to inspect (defaults to slashing-protection.json in the validators folder of your data directory)",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run command
+							return inspectSlashingProtection(c)
+
+						},
+					},
+				},
+			},
+
 			{
 				Name:      "terminate",
 				Aliases:   []string{"t"},
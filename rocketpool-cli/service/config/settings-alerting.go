@@ -54,6 +54,24 @@ var alertingParametersDockerMode map[string]interface{} = map[string]interface{}
 	"lowETHBalanceThreshold":                   nil,
 }
 
+// Notification sinks the node sends to directly, without going through Alertmanager
+var alertingParametersNotifiers map[string]interface{} = map[string]interface{}{
+	"notifierWebhookURL":          nil,
+	"notifierWebhookMinSeverity":  nil,
+	"notifierSmtpHost":            nil,
+	"notifierSmtpPort":            nil,
+	"notifierSmtpUsername":        nil,
+	"notifierSmtpPassword":        nil,
+	"notifierSmtpFrom":            nil,
+	"notifierSmtpTo":              nil,
+	"notifierSmtpMinSeverity":     nil,
+	"notifierTelegramBotToken":    nil,
+	"notifierTelegramChatID":      nil,
+	"notifierTelegramMinSeverity": nil,
+	"notifierDiscordWebhookURL":   nil,
+	"notifierDiscordMinSeverity":  nil,
+}
+
 // The page wrapper for the alerting config
 type AlertingConfigPage struct {
 	mainDisplay         *mainDisplay
@@ -63,6 +81,7 @@ type AlertingConfigPage struct {
 	masterConfig        *config.RocketPoolConfig
 	alertingEnabledItem parameterizedFormItem
 	otherItems          []*parameterizedFormItem
+	notifierItems       []*parameterizedFormItem
 }
 
 func NewAlertingConfigPage(home *settingsHome) *AlertingConfigPage {
@@ -128,6 +147,10 @@ func (configPage *AlertingConfigPage) createContent() {
 			enableAlertingBox = item.item.(*tview.Checkbox)
 			continue
 		}
+		if _, isNotifierParameter := alertingParametersNotifiers[item.parameter.ID]; isNotifierParameter {
+			configPage.notifierItems = append(configPage.notifierItems, item)
+			continue
+		}
 		_, isNativeParameter := alertingParametersNativeMode[item.parameter.ID]
 		_, isDockerParameter := alertingParametersDockerMode[item.parameter.ID]
 		if (configPage.masterConfig.IsNativeMode && isNativeParameter) || (!configPage.masterConfig.IsNativeMode && isDockerParameter) {
@@ -157,6 +180,7 @@ func (configPage *AlertingConfigPage) handleLayoutChanged() {
 	configPage.layout.addFormItems([]*parameterizedFormItem{&configPage.alertingEnabledItem})
 	if configPage.masterConfig.Alertmanager.EnableAlerting.Value == true {
 		configPage.layout.addFormItems(configPage.otherItems)
+		configPage.layout.addFormItems(configPage.notifierItems)
 	}
 	configPage.layout.refresh()
 }
@@ -652,6 +652,14 @@ func checkForValidatorChange(rp *rocketpool.Client, cfg *config.RocketPoolConfig
 			}
 		}
 
+		// Carry the slashing protection database over to the new client so it knows what the old one signed
+		err = migrateSlashingProtection(rp, cfg, validatorDutyContainerName, currentValidatorImageString)
+		if err == nil {
+			fmt.Printf("%sThe slashing protection database was moved from %s to %s - no slashing prevention delay necessary.%s\n", colorGreen, currentValidatorName, pendingValidatorName, colorReset)
+			return nil
+		}
+		fmt.Printf("%sWARNING: couldn't move the slashing protection database to the new client:\n\t%s\nFalling back to the slashing prevention delay.%s\n\n", colorYellow, err.Error(), colorReset)
+
 		// Print the warning and start the time lockout
 		safeStartTime := validatorFinishTime.Add(15 * time.Minute)
 		remainingTime := time.Until(safeStartTime)
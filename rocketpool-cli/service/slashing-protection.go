@@ -0,0 +1,457 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lodestar"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+// Settings
+const (
+	slashingProtectionFilename string = "slashing-protection.json"
+	slashingProtectionExport   string = "export"
+	slashingProtectionImport   string = "import"
+)
+
+// Export the validator client's slashing protection database to an EIP-3076 interchange file
+func exportSlashingProtection(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the config
+	cfg, err := loadDockerModeConfig(rp)
+	if err != nil {
+		return err
+	}
+	interchangePath, err := getInterchangePath(c, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Warn if the validator is still running, since anything it signs after the export won't be in the file
+	prefix, err := rp.GetContainerPrefix()
+	if err != nil {
+		return fmt.Errorf("Error getting validator container prefix: %w", err)
+	}
+	validatorContainerName := prefix + ValidatorContainerSuffix
+	status, err := rp.GetDockerStatus(validatorContainerName)
+	if err != nil {
+		return fmt.Errorf("Error getting container [%s] status: %w", validatorContainerName, err)
+	}
+	if status == "running" {
+		fmt.Printf("%sYour validator client is still running, so anything it signs after the export will not be included.\nIf you are exporting the database to move your validators to another client or machine, stop it first with `rocketpool service stop`.%s\n\n", colorYellow, colorReset)
+	}
+
+	// Export the database
+	if _, err := ExportValidatorSlashingProtection(rp, interchangePath); err != nil {
+		return err
+	}
+
+	fmt.Printf("%sExported the slashing protection database to %s.%s\n", colorGreen, interchangePath, colorReset)
+	return nil
+
+}
+
+// Export the validator container's slashing protection database to an EIP-3076 interchange file at an absolute path,
+// and make sure it has the signing history of the client's validators before anything relies on it
+func ExportValidatorSlashingProtection(rp *rocketpool.Client, interchangePath string) (*eth2.SlashingProtectionInterchange, error) {
+
+	// Get the config
+	cfg, err := loadDockerModeConfig(rp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the validator container
+	prefix, err := rp.GetContainerPrefix()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting validator container prefix: %w", err)
+	}
+	validatorContainerName := prefix + ValidatorContainerSuffix
+	consensusClient, err := getValidatorContainerClient(rp, validatorContainerName)
+	if err != nil {
+		return nil, err
+	}
+	validatorImage, err := rp.GetDockerImage(validatorContainerName)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting current validator image: %w", err)
+	}
+
+	// Export the database
+	fmt.Printf("Exporting the slashing protection database from %s...\n", consensusClient)
+	err = runSlashingProtectionScript(rp, cfg, prefix, consensusClient, validatorImage, slashingProtectionExport, interchangePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that it picked up the client's history
+	interchange, err := readInterchange(interchangePath)
+	if err != nil {
+		return nil, err
+	}
+	dataPath, err := homedir.Expand(cfg.Smartnode.DataPath.Value.(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding data path: %w", err)
+	}
+	hadKeys, err := hasValidatorKeys(filepath.Join(dataPath, "validators"), consensusClient)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkExportedInterchange(interchange, hadKeys); err != nil {
+		return nil, err
+	}
+	return interchange, nil
+
+}
+
+// Import an EIP-3076 interchange file into the validator client's slashing protection database
+func importSlashingProtection(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the config
+	cfg, err := loadDockerModeConfig(rp)
+	if err != nil {
+		return err
+	}
+	interchangePath, err := getInterchangePath(c, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Check the file before handing it to the validator client
+	interchange, err := readInterchange(interchangePath)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("The interchange file contains the signing history of %d validators.\n\n", len(interchange.Data))
+
+	// Get the validator container
+	prefix, err := rp.GetContainerPrefix()
+	if err != nil {
+		return fmt.Errorf("Error getting validator container prefix: %w", err)
+	}
+	validatorContainerName := prefix + ValidatorContainerSuffix
+	consensusClient, _ := cfg.GetSelectedConsensusClient()
+	validatorImage, err := cfg.GetVCContainerTag()
+	if err != nil {
+		return fmt.Errorf("Error getting validator image: %w", err)
+	}
+
+	// The validator client locks its database while it's running, so it has to be stopped for the import
+	status, err := rp.GetDockerStatus(validatorContainerName)
+	if err != nil {
+		return fmt.Errorf("Error getting container [%s] status: %w", validatorContainerName, err)
+	}
+	isRunning := status == "running"
+	if isRunning {
+		if !(c.Bool("yes") || prompt.Confirm("Your validator client must be stopped to import the slashing protection database. It will be restarted afterwards. Would you like to continue?")) {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+		fmt.Printf("Stopping %s...\n", validatorContainerName)
+		if _, err := rp.StopContainer(validatorContainerName); err != nil {
+			return fmt.Errorf("Error stopping container [%s]: %w", validatorContainerName, err)
+		}
+	}
+
+	// Import the database
+	fmt.Printf("Importing the slashing protection database into %s...\n", consensusClient)
+	importErr := runSlashingProtectionScript(rp, cfg, prefix, consensusClient, validatorImage, slashingProtectionImport, interchangePath)
+
+	// Restart the validator even if the import failed, since its existing database is still intact
+	if isRunning {
+		fmt.Printf("Starting %s...\n", validatorContainerName)
+		if _, err := rp.StartContainer(validatorContainerName); err != nil {
+			return fmt.Errorf("Error starting container [%s]: %w", validatorContainerName, err)
+		}
+	}
+	if importErr != nil {
+		return importErr
+	}
+
+	fmt.Printf("%sImported the slashing protection database from %s.%s\n", colorGreen, interchangePath, colorReset)
+	return nil
+
+}
+
+// Print a summary of an EIP-3076 interchange file
+func inspectSlashingProtection(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the config
+	cfg, err := loadDockerModeConfig(rp)
+	if err != nil {
+		return err
+	}
+	interchangePath, err := getInterchangePath(c, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Read the file
+	interchange, err := readInterchange(interchangePath)
+	if err != nil {
+		return err
+	}
+
+	// Print the summary
+	fmt.Printf("Interchange format version: %s\n", interchange.Metadata.InterchangeFormatVersion)
+	fmt.Printf("Genesis validators root:    %s\n", interchange.Metadata.GenesisValidatorsRoot)
+	fmt.Printf("Validators:                 %d\n\n", len(interchange.Data))
+	for _, validator := range interchange.Data {
+		fmt.Printf("%s%s%s\n", colorLightBlue, validator.Pubkey, colorReset)
+		if len(validator.SignedBlocks) == 0 {
+			fmt.Println("\tSigned blocks:       none")
+		} else {
+			var highestSlot uint64
+			for _, block := range validator.SignedBlocks {
+				highestSlot = max(highestSlot, block.Slot)
+			}
+			fmt.Printf("\tSigned blocks:       %d (highest slot %d)\n", len(validator.SignedBlocks), highestSlot)
+		}
+		if len(validator.SignedAttestations) == 0 {
+			fmt.Println("\tSigned attestations: none")
+		} else {
+			var highestSource, highestTarget uint64
+			for _, attestation := range validator.SignedAttestations {
+				highestSource = max(highestSource, attestation.SourceEpoch)
+				highestTarget = max(highestTarget, attestation.TargetEpoch)
+			}
+			fmt.Printf("\tSigned attestations: %d (highest source epoch %d, highest target epoch %d)\n", len(validator.SignedAttestations), highestSource, highestTarget)
+		}
+	}
+
+	return nil
+
+}
+
+// Move the slashing protection database from the outgoing validator client into the new one, so the new client can start without waiting out the slash timer
+func migrateSlashingProtection(rp *rocketpool.Client, cfg *config.RocketPoolConfig, validatorContainerName string, currentValidatorImage string) error {
+
+	prefix, err := rp.GetContainerPrefix()
+	if err != nil {
+		return fmt.Errorf("Error getting validator container prefix: %w", err)
+	}
+	currentClient, err := getValidatorContainerClient(rp, validatorContainerName)
+	if err != nil {
+		return err
+	}
+	pendingClient, _ := cfg.GetSelectedConsensusClient()
+	pendingValidatorImage, err := cfg.GetVCContainerTag()
+	if err != nil {
+		return fmt.Errorf("Error getting validator image: %w", err)
+	}
+
+	// Keep a copy of the exported database in the data folder in case it's needed again
+	dataPath, err := homedir.Expand(cfg.Smartnode.DataPath.Value.(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding data path: %w", err)
+	}
+	validatorsPath := filepath.Join(dataPath, "validators")
+	interchangePath := filepath.Join(validatorsPath, fmt.Sprintf("slashing-protection-%s.json", currentClient))
+
+	// Both clients have to be able to reach their databases before anything is moved
+	if err := checkSlashingProtectionClient(currentClient); err != nil {
+		return err
+	}
+	if err := checkSlashingProtectionClient(pendingClient); err != nil {
+		return err
+	}
+
+	fmt.Printf("Exporting the slashing protection database from %s...\n", currentClient)
+	err = runSlashingProtectionScript(rp, cfg, prefix, currentClient, currentValidatorImage, slashingProtectionExport, interchangePath)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the export actually picked up the old client's history before relying on it
+	interchange, err := readInterchange(interchangePath)
+	if err != nil {
+		return err
+	}
+	hadKeys, err := hasValidatorKeys(validatorsPath, currentClient)
+	if err != nil {
+		return err
+	}
+	if err := checkExportedInterchange(interchange, hadKeys); err != nil {
+		return err
+	}
+	fmt.Printf("Importing the slashing protection database into %s...\n", pendingClient)
+	err = runSlashingProtectionScript(rp, cfg, prefix, pendingClient, pendingValidatorImage, slashingProtectionImport, interchangePath)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// Run the slashing protection script for the given client in a temporary copy of the validator container
+func runSlashingProtectionScript(rp *rocketpool.Client, cfg *config.RocketPoolConfig, prefix string, consensusClient cfgtypes.ConsensusClient, validatorImage string, action string, interchangePath string) error {
+
+	if err := checkSlashingProtectionClient(consensusClient); err != nil {
+		return err
+	}
+	shell := "sh"
+	if consensusClient == cfgtypes.ConsensusClient_Prysm {
+		shell = "bash"
+	}
+
+	ccApiUrl, err := cfg.ConsensusClientApiUrl()
+	if err != nil {
+		return fmt.Errorf("Error getting consensus client API URL: %w", err)
+	}
+	envVars := map[string]string{
+		"NETWORK":         fmt.Sprint(cfg.Smartnode.Network.Value),
+		"CC_CLIENT":       string(consensusClient),
+		"CC_API_ENDPOINT": ccApiUrl,
+	}
+
+	_, err = rp.RunSlashingProtectionScript(prefix+ValidatorContainerSuffix, validatorImage, shell, prefix+"_net", envVars, action, interchangePath)
+	return err
+
+}
+
+// Check that the Smart Node can reach the client's slashing protection database.
+// Nimbus keeps it in its own data folder, which isn't mounted into the validator container, so an export would be empty and an import would be thrown away.
+func checkSlashingProtectionClient(consensusClient cfgtypes.ConsensusClient) error {
+	if consensusClient == cfgtypes.ConsensusClient_Nimbus {
+		return fmt.Errorf("The Nimbus slashing protection database is stored outside of the validator container's volumes, so the Smart Node can't export or import it. Please use Nimbus's own `slashingdb` tool instead.")
+	}
+	return nil
+}
+
+// Check if the validator client has any keys in the validators folder
+func hasValidatorKeys(validatorsPath string, consensusClient cfgtypes.ConsensusClient) (bool, error) {
+	var keysPath string
+	switch consensusClient {
+	case cfgtypes.ConsensusClient_Lighthouse:
+		keysPath = filepath.Join(validatorsPath, lighthouse.KeystoreDir, lighthouse.ValidatorsDir)
+	case cfgtypes.ConsensusClient_Lodestar:
+		keysPath = filepath.Join(validatorsPath, lodestar.KeystoreDir, lodestar.ValidatorsDir)
+	case cfgtypes.ConsensusClient_Nimbus:
+		keysPath = filepath.Join(validatorsPath, nimbus.KeystoreDir, nimbus.ValidatorsDir)
+	case cfgtypes.ConsensusClient_Teku:
+		keysPath = filepath.Join(validatorsPath, teku.KeystoreDir, teku.ValidatorsDir)
+	case cfgtypes.ConsensusClient_Prysm:
+		// Prysm keeps all of its keys in a single file
+		_, err := os.Stat(filepath.Join(validatorsPath, prysm.KeystoreDir, prysm.WalletDir, prysm.AccountsDir, prysm.KeystoreFileName))
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("Error checking the Prysm keystore: %w", err)
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("Unknown consensus client [%s]", consensusClient)
+	}
+
+	entries, err := os.ReadDir(keysPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("Error reading the %s keys folder [%s]: %w", consensusClient, keysPath, err)
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "0x") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Check that an exported interchange file can be imported in place of the old client's database
+func checkExportedInterchange(interchange *eth2.SlashingProtectionInterchange, hadKeys bool) error {
+	if hadKeys && len(interchange.Data) == 0 {
+		return fmt.Errorf("The exported slashing protection database doesn't have any validators, but the old validator client had keys loaded")
+	}
+	return nil
+}
+
+// Get the consensus client the validator container was created for
+func getValidatorContainerClient(rp *rocketpool.Client, validatorContainerName string) (cfgtypes.ConsensusClient, error) {
+	client, err := rp.GetContainerEnvVar(validatorContainerName, "CC_CLIENT")
+	if err != nil {
+		return "", fmt.Errorf("Error getting the client of container [%s]: %w", validatorContainerName, err)
+	}
+	if client == "" {
+		return "", fmt.Errorf("Container [%s] does not have a consensus client set", validatorContainerName)
+	}
+	return cfgtypes.ConsensusClient(client), nil
+}
+
+// Load the config, checking that the validator client is managed by the Smartnode
+func loadDockerModeConfig(rp *rocketpool.Client) (*config.RocketPoolConfig, error) {
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		return nil, fmt.Errorf("Settings file not found. Please run `rocketpool service config` to set up your Smart Node.")
+	}
+	if cfg.IsNativeMode {
+		return nil, fmt.Errorf("You are using Native Mode. The Smart Node does not manage your validator client, so please use its own slashing protection tools instead.")
+	}
+	return cfg, nil
+}
+
+// Get the absolute path of the interchange file, defaulting to one in the validators folder
+func getInterchangePath(c *cli.Context, cfg *config.RocketPoolConfig) (string, error) {
+	path := c.String("file")
+	if path == "" {
+		path = filepath.Join(cfg.Smartnode.DataPath.Value.(string), "validators", slashingProtectionFilename)
+	}
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return "", fmt.Errorf("Error expanding interchange file path: %w", err)
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("Error getting absolute path of the interchange file: %w", err)
+	}
+	return path, nil
+}
+
+// Read and check an interchange file
+func readInterchange(path string) (*eth2.SlashingProtectionInterchange, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading interchange file [%s]: %w", path, err)
+	}
+	interchange := new(eth2.SlashingProtectionInterchange)
+	if err := json.Unmarshal(bytes, interchange); err != nil {
+		return nil, fmt.Errorf("Error parsing interchange file [%s]: %w", path, err)
+	}
+	if interchange.Metadata.InterchangeFormatVersion != eth2.InterchangeFormatVersion {
+		return nil, fmt.Errorf("Interchange file [%s] uses format version %s, but only version %s is supported", path, interchange.Metadata.InterchangeFormatVersion, eth2.InterchangeFormatVersion)
+	}
+	return interchange, nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+)
+
+func TestCheckSlashingProtectionClient(t *testing.T) {
+	for _, client := range []cfgtypes.ConsensusClient{
+		cfgtypes.ConsensusClient_Lighthouse,
+		cfgtypes.ConsensusClient_Lodestar,
+		cfgtypes.ConsensusClient_Prysm,
+		cfgtypes.ConsensusClient_Teku,
+	} {
+		if err := checkSlashingProtectionClient(client); err != nil {
+			t.Fatalf("unexpected error for %s: %v", client, err)
+		}
+	}
+	if err := checkSlashingProtectionClient(cfgtypes.ConsensusClient_Nimbus); err == nil {
+		t.Fatal("expected Nimbus to be refused")
+	}
+}
+
+func TestHasValidatorKeys(t *testing.T) {
+	pubkey := "0xb0b0"
+	tests := []struct {
+		name     string
+		client   cfgtypes.ConsensusClient
+		file     string
+		expected bool
+	}{
+		{"lighthouse without keys", cfgtypes.ConsensusClient_Lighthouse, "", false},
+		{"lighthouse with keys", cfgtypes.ConsensusClient_Lighthouse, filepath.Join("lighthouse", "validators", pubkey, "voting-keystore.json"), true},
+		{"lighthouse with only definitions", cfgtypes.ConsensusClient_Lighthouse, filepath.Join("lighthouse", "validators", "validator_definitions.yml"), false},
+		{"lodestar with keys", cfgtypes.ConsensusClient_Lodestar, filepath.Join("lodestar", "validators", pubkey, "voting-keystore.json"), true},
+		{"keys for another client", cfgtypes.ConsensusClient_Lodestar, filepath.Join("lighthouse", "validators", pubkey, "voting-keystore.json"), false},
+		{"teku with keys", cfgtypes.ConsensusClient_Teku, filepath.Join("teku", "keys", pubkey+".json"), true},
+		{"prysm without keys", cfgtypes.ConsensusClient_Prysm, "", false},
+		{"prysm with keys", cfgtypes.ConsensusClient_Prysm, filepath.Join("prysm-non-hd", "direct", "accounts", "all-accounts.keystore.json"), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validatorsPath := t.TempDir()
+			if test.file != "" {
+				path := filepath.Join(validatorsPath, test.file)
+				if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+					t.Fatalf("error creating folder: %v", err)
+				}
+				if err := os.WriteFile(path, []byte("{}"), 0600); err != nil {
+					t.Fatalf("error writing file: %v", err)
+				}
+			}
+			hasKeys, err := hasValidatorKeys(validatorsPath, test.client)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hasKeys != test.expected {
+				t.Fatalf("expected %t, got %t", test.expected, hasKeys)
+			}
+		})
+	}
+}
+
+func TestCheckExportedInterchange(t *testing.T) {
+	empty := &eth2.SlashingProtectionInterchange{}
+	withValidator := &eth2.SlashingProtectionInterchange{
+		Data: []eth2.InterchangeValidator{{Pubkey: "0xb0b0"}},
+	}
+	tests := []struct {
+		name        string
+		interchange *eth2.SlashingProtectionInterchange
+		hadKeys     bool
+		expectError bool
+	}{
+		{"empty without keys", empty, false, false},
+		{"empty with keys", empty, true, true},
+		{"validators with keys", withValidator, true, false},
+		{"validators without keys", withValidator, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkExportedInterchange(test.interchange, test.hadKeys)
+			if test.expectError && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !test.expectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -34,6 +34,23 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "nodes",
+				Usage:     "List the nodes this installation manages. Use the global --node flag to run a command for one of them.",
+				UsageText: "rocketpool wallet nodes",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getNodes(c)
+
+				},
+			},
+
 			{
 				Name:      "init",
 				Aliases:   []string{"i"},
@@ -213,6 +230,93 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "export-validator-keys",
+				Usage:     "Export validator keys as EIP-2335 keystores along with an EIP-3076 slashing protection file, for moving your validators to another machine or client",
+				UsageText: "rocketpool wallet export-validator-keys [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the export",
+					},
+					cli.StringFlag{
+						Name:  "pubkeys, p",
+						Usage: "A comma-separated list of the validator pubkeys to export (defaults to all of the node's minipool and megapool validators)",
+					},
+					cli.StringFlag{
+						Name:  "output-dir, o",
+						Usage: "The `path` of the folder to save the keystores, their password, and the slashing protection file to",
+						Value: "validator-keys",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return exportValidatorKeys(c)
+
+				},
+			},
+			{
+				Name:      "import-validator-keys",
+				Usage:     "Import validator keys from a folder of EIP-2335 keystores, such as one created by 'rocketpool wallet export-validator-keys'",
+				UsageText: "rocketpool wallet import-validator-keys [options] folder",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the import",
+					},
+					cli.StringFlag{
+						Name:  "password",
+						Usage: "The password for the keystores (defaults to the password.txt file in the folder)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return importValidatorKeys(c, c.Args().Get(0))
+
+				},
+			},
+			{
+				Name:      "sign-offline",
+				Usage:     "Sign transactions that were exported with the --offline-tx flag. Run this on the machine that holds your node wallet.",
+				UsageText: "rocketpool wallet sign-offline [options] transactions-file-or-blob",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the transactions",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "The `path` to save the signed transactions to (defaults to the input file with a -signed suffix)",
+					},
+					cli.BoolFlag{
+						Name:  "blob, b",
+						Usage: "Print the signed transactions as a single line of text, for moving them with a QR code or copy and paste",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return signOffline(c, c.Args().Get(0))
+
+				},
+			},
 			{
 				Name:      "set-ens-name",
 				Aliases:   []string{"ens"},
@@ -0,0 +1,143 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/service"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	promptcli "github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+const (
+	exportedPasswordFilename           string = "password.txt"
+	exportedSlashingProtectionFilename string = "slashing-protection.json"
+	exportedKeystoreFilenameFormat     string = "keystore-%s.json"
+)
+
+func exportValidatorKeys(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the pubkeys to export
+	pubkeys := []types.ValidatorPubkey{}
+	if c.String("pubkeys") != "" {
+		pubkeys, err = cliutils.ValidatePubkeys("pubkeys", c.String("pubkeys"))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Make sure the output folder is empty so old keystores don't get mixed in
+	outputDir := c.String("output-dir")
+	if entries, err := os.ReadDir(outputDir); err == nil && len(entries) > 0 {
+		return fmt.Errorf("The output folder %s is not empty. Please choose a different folder.", outputDir)
+	}
+
+	fmt.Printf("%sNOTE: Your validators must only ever run on one machine at a time. This will stop your validator client so its slashing protection database can be exported with the keys; do not start it on this machine again once the keys are in use elsewhere.%s\n\n", colorYellow, colorReset)
+	if !(c.Bool("yes") || promptcli.Confirm("Are you sure you want to export your validator keys?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Export the keys
+	response, err := rp.ExportValidatorKeys(pubkeys)
+	if err != nil {
+		return err
+	}
+	if len(response.Keystores) == 0 {
+		fmt.Println("None of the node's validator keys were found, so nothing was exported.")
+		return nil
+	}
+
+	// Write the files
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return fmt.Errorf("Error creating output folder %s: %w", outputDir, err)
+	}
+	for _, key := range response.Keystores {
+		keystorePath := filepath.Join(outputDir, fmt.Sprintf(exportedKeystoreFilenameFormat, key.Pubkey.Hex()))
+		if err := os.WriteFile(keystorePath, []byte(key.Keystore), 0600); err != nil {
+			return fmt.Errorf("Error saving keystore for validator %s: %w", key.Pubkey.Hex(), err)
+		}
+	}
+	passwordPath := filepath.Join(outputDir, exportedPasswordFilename)
+	if err := os.WriteFile(passwordPath, []byte(response.Password), 0600); err != nil {
+		return fmt.Errorf("Error saving keystore password: %w", err)
+	}
+
+	// Stop the validator client so nothing is signed after its slashing protection database is exported
+	prefix, err := rp.GetContainerPrefix()
+	if err != nil {
+		return fmt.Errorf("Error getting validator container prefix: %w", err)
+	}
+	fmt.Println("Stopping the validator client...")
+	if _, err := rp.StopContainer(prefix + service.ValidatorContainerSuffix); err != nil {
+		return fmt.Errorf("Error stopping the validator client: %w", err)
+	}
+
+	// Export the database and keep the history of the exported validators
+	slashingProtectionPath, err := filepath.Abs(filepath.Join(outputDir, exportedSlashingProtectionFilename))
+	if err != nil {
+		return fmt.Errorf("Error getting slashing protection file path: %w", err)
+	}
+	interchange, err := service.ExportValidatorSlashingProtection(rp, slashingProtectionPath)
+	if err != nil {
+		return err
+	}
+	if err := saveExportedInterchange(slashingProtectionPath, interchange, response.Keystores); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Exported %d validator key(s) to %s.\n", len(response.Keystores), outputDir)
+	if len(response.MissingKeys) > 0 {
+		fmt.Printf("%sThe keys for the following validators aren't on this node, so they weren't exported:%s\n", colorYellow, colorReset)
+		for _, pubkey := range response.MissingKeys {
+			fmt.Printf("\t%s\n", pubkey.Hex())
+		}
+	}
+	fmt.Println()
+	fmt.Printf("The keystores all use the password in %s. Keep it and the keystores somewhere safe.\n", passwordPath)
+	fmt.Printf("%s has your validator client's slashing protection history for the exported validators. Import it into the new validator client before starting it there.\n", slashingProtectionPath)
+	fmt.Printf("%sYour validator client has been stopped. Don't restart the Smartnode's services on this machine while the exported keys are in use elsewhere.%s\n", colorYellow, colorReset)
+	return nil
+
+}
+
+// Rewrite an exported slashing protection file so it only has the history of the exported validators
+func saveExportedInterchange(path string, interchange *eth2.SlashingProtectionInterchange, keystores []api.ExportedValidatorKey) error {
+	data := []eth2.InterchangeValidator{}
+	for _, validator := range interchange.Data {
+		for _, key := range keystores {
+			if strings.EqualFold(validator.Pubkey, hexutil.Encode(key.Pubkey.Bytes())) {
+				data = append(data, validator)
+				break
+			}
+		}
+	}
+	interchange.Data = data
+
+	bytes, err := json.MarshalIndent(interchange, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error serializing slashing protection file: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0600); err != nil {
+		return fmt.Errorf("Error saving slashing protection file: %w", err)
+	}
+	return nil
+}
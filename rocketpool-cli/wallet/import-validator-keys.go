@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	promptcli "github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+func importValidatorKeys(c *cli.Context, inputDir string) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Find the keystores
+	keystorePaths, err := filepath.Glob(filepath.Join(inputDir, "keystore*.json"))
+	if err != nil {
+		return fmt.Errorf("Error searching for keystores in %s: %w", inputDir, err)
+	}
+	if len(keystorePaths) == 0 {
+		fmt.Printf("No keystores were found in %s.\n", inputDir)
+		return nil
+	}
+
+	// Get the password from the flag, the exported password file, or the user
+	password := c.String("password")
+	if password == "" {
+		passwordBytes, err := os.ReadFile(filepath.Join(inputDir, exportedPasswordFilename))
+		if err == nil {
+			password = strings.TrimSpace(string(passwordBytes))
+		} else if os.IsNotExist(err) {
+			password = promptcli.PromptPassword("Please enter the password for the keystores:", "^.*$", "")
+		} else {
+			return fmt.Errorf("Error reading keystore password: %w", err)
+		}
+	}
+
+	fmt.Printf("%sNOTE: Your validators must only ever run on one machine at a time. Make sure they've been stopped everywhere else before your validator client loads these keys.%s\n\n", colorYellow, colorReset)
+	if !(c.Bool("yes") || promptcli.Confirm(fmt.Sprintf("Are you sure you want to import %d validator key(s)?", len(keystorePaths)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Import the keys
+	for _, keystorePath := range keystorePaths {
+		keystoreBytes, err := os.ReadFile(keystorePath)
+		if err != nil {
+			return fmt.Errorf("Error reading keystore %s: %w", keystorePath, err)
+		}
+		response, err := rp.ImportValidatorKey(string(keystoreBytes), password)
+		if err != nil {
+			return fmt.Errorf("Error importing keystore %s: %w", keystorePath, err)
+		}
+		fmt.Printf("Imported validator %s.\n", response.Pubkey.Hex())
+	}
+
+	// Log & return
+	fmt.Println()
+	fmt.Printf("Successfully imported %d validator key(s).\n", len(keystorePaths))
+	slashingProtectionPath := filepath.Join(inputDir, exportedSlashingProtectionFilename)
+	if _, err := os.Stat(slashingProtectionPath); err == nil {
+		fmt.Printf("%sImport the slashing protection file at %s into your validator client before restarting it so it loads these keys.%s\n", colorYellow, slashingProtectionPath, colorReset)
+	} else {
+		fmt.Printf("%sNo slashing protection file was found. Import one from the machine these keys came from into your validator client before restarting it so it loads these keys.%s\n", colorYellow, colorReset)
+	}
+	return nil
+
+}
@@ -0,0 +1,38 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func getNodes(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the nodes
+	response, err := rp.WalletNodes()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("This installation manages %d node(s):\n\n", len(response.Nodes))
+	for _, node := range response.Nodes {
+		switch {
+		case node.IsMasquerading:
+			fmt.Printf("%-20s %s (masquerading)\n", node.Name, node.AccountAddress.Hex())
+		case node.WalletInitialized:
+			fmt.Printf("%-20s %s\n", node.Name, node.AccountAddress.Hex())
+		default:
+			fmt.Printf("%-20s %swallet not initialized%s\n", node.Name, colorYellow, colorReset)
+		}
+	}
+	fmt.Println()
+	fmt.Println("Use `rocketpool --node <name> wallet init` or `rocketpool --node <name> wallet recover` to add another node, then restart the node daemon so it starts managing it.")
+	return nil
+
+}
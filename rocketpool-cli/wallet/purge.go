@@ -20,9 +20,17 @@ func purge(c *cli.Context) error {
 		return nil
 	}
 
+	// Stop the VC from signing for the node's validators before their keys are deleted, since it keeps the keys it loaded through its keymanager API on its own
+	unloadResponse, err := rp.UnloadValidatorKeys()
+	if err != nil {
+		fmt.Printf("%sWARNING: Couldn't remove your validator keys from the Validator Client: %s\nThe ones it loaded through its keymanager API may still be loaded after the purge; check its logs before validating with them anywhere else.%s\n\n", colorYellow, err.Error(), colorReset)
+	} else if unloadResponse.RemovedCount > 0 {
+		fmt.Printf("Removed %d validator key(s) from the Validator Client.\n", unloadResponse.RemovedCount)
+	}
+
 	// Purge
 	composeFiles := c.Parent().StringSlice("compose-file")
-	err := rp.PurgeAllKeys(composeFiles)
+	err = rp.PurgeAllKeys(composeFiles)
 	if err != nil {
 		return fmt.Errorf("%w\n%sTHERE WAS AN ERROR DELETING YOUR KEYS. They most likely have not been deleted. Proceed with caution.%s", err, colorRed, colorReset)
 	}
@@ -0,0 +1,93 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+func signOffline(c *cli.Context, input string) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Load the unsigned transactions
+	bundle, err := wallet.ReadOfflineTransactionBundle(input)
+	if err != nil {
+		return err
+	}
+	if len(bundle.Transactions) == 0 {
+		fmt.Println("There are no transactions to sign.")
+		return nil
+	}
+
+	// Print them so they can be checked before signing
+	fmt.Printf("%d transaction(s) for chain %d:\n", len(bundle.Transactions), bundle.ChainID)
+	for _, tx := range bundle.Transactions {
+		to := "<contract creation>"
+		if tx.To != nil {
+			to = tx.To.Hex()
+		}
+		fmt.Printf("- Nonce %d from %s to %s\n", tx.Nonce, tx.From.Hex(), to)
+		fmt.Printf("\tValue:    %.6f ETH\n", eth.WeiToEth(tx.Value))
+		fmt.Printf("\tGas:      %d (max fee %.6f gwei, max priority fee %.6f gwei)\n", tx.GasLimit, eth.WeiToGwei(tx.GasFeeCap), eth.WeiToGwei(tx.GasTipCap))
+		fmt.Printf("\tData:     %d bytes\n", len(tx.Data))
+	}
+	fmt.Println()
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || prompt.Confirm("Are you sure you want to sign these transactions with your node wallet?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign them
+	blob, err := bundle.Encode()
+	if err != nil {
+		return err
+	}
+	response, err := rp.SignOfflineTransactions(blob)
+	if err != nil {
+		return err
+	}
+	signedBundle, err := wallet.DecodeOfflineTransactionBundle(response.SignedTransactions)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Signed %d transaction(s).\n", response.TransactionCount)
+
+	// Save them next to the input file unless a different path was provided
+	outputPath := c.String("output")
+	if outputPath == "" {
+		if _, err := os.Stat(input); err == nil {
+			outputPath = strings.TrimSuffix(input, filepath.Ext(input)) + "-signed.json"
+		}
+	}
+	if outputPath != "" {
+		if err := signedBundle.Save(outputPath); err != nil {
+			return err
+		}
+		fmt.Printf("The signed transactions have been saved to %s.\n", outputPath)
+	}
+
+	// Print the blob for QR codes or copy and paste
+	if c.Bool("blob") || outputPath == "" {
+		fmt.Println("Signed transactions:")
+		fmt.Println()
+		fmt.Println(response.SignedTransactions)
+		fmt.Println()
+	}
+
+	fmt.Println("Move them to your node and run `rocketpool node broadcast` to submit them.")
+	return nil
+
+}
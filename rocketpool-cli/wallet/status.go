@@ -28,6 +28,11 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print which node this is if the installation manages several
+	if node := rp.GetNode(); node != "" {
+		fmt.Printf("Node: %s%s%s\n", colorBlue, node, colorReset)
+	}
+
 	// Get wallet status
 	status, err := rp.WalletStatus()
 	if err != nil {
@@ -102,6 +102,32 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 		},
 	})
 
+	// Append the server command, which keeps the API's services warm and runs the commands above over HTTP
+	command.Subcommands = append(command.Subcommands, cli.Command{
+		Name:      serveCommandName,
+		Usage:     "Run the API as a persistent HTTP server on a unix socket or a local address",
+		UsageText: "rocketpool api serve [--socket path | --address host:port]",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "socket",
+				Usage: "The `path` of the unix socket to listen on",
+			},
+			cli.StringFlag{
+				Name:  "address",
+				Usage: "The loopback `host:port` to listen on, such as 127.0.0.1:8280",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			// Validate args
+			if err := cliutils.ValidateArgCount(c, 0); err != nil {
+				return err
+			}
+
+			// Run
+			return runServer(c, app, name)
+		},
+	})
+
 	// Register CLI command
 	app.Commands = append(app.Commands, command)
 
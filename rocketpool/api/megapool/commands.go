@@ -436,6 +436,73 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-consolidation-plan",
+				Usage:     "Plan the consolidation of the megapool's validators into compounding validators",
+				UsageText: "rocketpool api megapool get-consolidation-plan",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getConsolidationPlan(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-consolidate",
+				Usage:     "Check if a megapool validator can be consolidated into another one",
+				UsageText: "rocketpool api megapool can-consolidate source-validator-id target-validator-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					sourceId, err := cliutils.ValidateUint32("sourceValidatorId", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					targetId, err := cliutils.ValidateUint32("targetValidatorId", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canConsolidateValidators(c, sourceId, targetId))
+					return nil
+
+				},
+			},
+			{
+				Name:      "consolidate",
+				Usage:     "Request the consolidation of a megapool validator into another one",
+				UsageText: "rocketpool api megapool consolidate source-validator-id target-validator-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					sourceId, err := cliutils.ValidateUint32("sourceValidatorId", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					targetId, err := cliutils.ValidateUint32("targetValidatorId", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(consolidateValidators(c, sourceId, targetId))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-notify-validator-exit",
 				Usage:     "Check if we can notify the exit of a megapool validator",
@@ -0,0 +1,322 @@
+package megapool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func getConsolidationPlan(c *cli.Context) (*api.MegapoolConsolidationPlanResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MegapoolConsolidationPlanResponse{}
+
+	status, err := getStatus(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the validators that can take part in a consolidation
+	candidates := []validator.ConsolidationCandidate{}
+	validatorIds := map[types.ValidatorPubkey]uint32{}
+	indexIds := map[string]uint32{}
+	for _, mpValidator := range status.Megapool.Validators {
+		if mpValidator.BeaconStatus.Index != "" {
+			indexIds[mpValidator.BeaconStatus.Index] = mpValidator.ValidatorId
+		}
+		if !mpValidator.Staked || !mpValidator.Activated || mpValidator.Exiting || mpValidator.Exited ||
+			mpValidator.BeaconStatus.Status != beacon.ValidatorState_ActiveOngoing {
+			continue
+		}
+		candidates = append(candidates, validator.ConsolidationCandidate{
+			Pubkey:      mpValidator.PubKey,
+			Index:       mpValidator.BeaconStatus.Index,
+			Balance:     mpValidator.BeaconStatus.Balance,
+			Compounding: validator.IsCompounding(mpValidator.BeaconStatus.WithdrawalCredentials),
+		})
+		validatorIds[mpValidator.PubKey] = mpValidator.ValidatorId
+	}
+
+	// Get the consolidations the Beacon Chain hasn't processed yet, including the megapool's own
+	pendingConsolidations, err := bc.GetPendingConsolidations("head")
+	if err != nil {
+		return nil, err
+	}
+	response.NetworkPendingCount = len(pendingConsolidations)
+	response.PendingConsolidations = []api.MegapoolPendingConsolidation{}
+	pendingSources := make([]string, 0, len(pendingConsolidations))
+	for _, consolidation := range pendingConsolidations {
+		pendingSources = append(pendingSources, consolidation.SourceIndex)
+		sourceId, isSource := indexIds[consolidation.SourceIndex]
+		targetId, isTarget := indexIds[consolidation.TargetIndex]
+		if !isSource && !isTarget {
+			continue
+		}
+		response.PendingConsolidations = append(response.PendingConsolidations, api.MegapoolPendingConsolidation{
+			SourceId:    sourceId,
+			TargetId:    targetId,
+			SourceIndex: consolidation.SourceIndex,
+			TargetIndex: consolidation.TargetIndex,
+		})
+	}
+
+	// The balances of the pending consolidations go through the churn before any new ones
+	var pendingChurnBalance uint64
+	if len(pendingSources) > 0 {
+		balances, err := bc.GetValidatorBalances(pendingSources, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, balance := range balances {
+			pendingChurnBalance += balance.Uint64()
+		}
+	}
+
+	// Plan the consolidations
+	plan := validator.PlanConsolidations(candidates, pendingChurnBalance)
+	response.ChurnBalance = plan.ChurnBalance
+	response.ExitingValidators = plan.ExitingValidators
+	response.PendingChurnBalance = plan.PendingChurnBalance
+	response.EstimatedQueueEpochs = plan.EstimatedQueueEpochs
+	if plan.EstimatedQueueEpochs > 0 {
+		eth2Config, err := bc.GetEth2Config()
+		if err != nil {
+			return nil, err
+		}
+		head, err := bc.GetBeaconHead()
+		if err != nil {
+			return nil, err
+		}
+		response.EstimatedQueueTime = eth2Config.GetSlotTime(eth2Config.FirstSlotOfEpoch(head.Epoch + plan.EstimatedQueueEpochs))
+	}
+	response.Steps = make([]api.MegapoolConsolidationStep, len(plan.Steps))
+	for i, step := range plan.Steps {
+		response.Steps[i] = api.MegapoolConsolidationStep{
+			SourceId:            validatorIds[step.Source.Pubkey],
+			TargetId:            validatorIds[step.Target.Pubkey],
+			SourcePubkey:        step.Source.Pubkey,
+			TargetPubkey:        step.Target.Pubkey,
+			SourceBalance:       step.Source.Balance,
+			SwitchToCompounding: step.IsSwitchToCompounding(),
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func canConsolidateValidators(c *cli.Context, sourceId uint32, targetId uint32) (*api.CanConsolidateValidatorsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanConsolidateValidatorsResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the consolidation
+	sourcePubkey, targetPubkey, check, err := checkConsolidation(rp, bc, nodeAccount.Address, sourceId, targetId)
+	if err != nil {
+		return nil, err
+	}
+	response.InvalidStatus = check.InvalidStatus
+	response.AlreadyExiting = check.AlreadyExiting
+	response.NotActiveLongEnough = check.NotActiveLongEnough
+	response.AlreadyCompounding = check.AlreadyCompounding
+	response.TargetNotCompounding = check.TargetNotCompounding
+	response.BlsCredentials = check.BlsCredentials
+	response.ContractCredentials = check.ContractCredentials
+	response.NotCredentialsOwner = check.NotCredentialsOwner
+	response.CredentialsOwner = check.CredentialsOwner
+	response.EligibleEpoch = check.EligibleEpoch
+	response.CanConsolidate = check.CanRequest()
+	if !response.CanConsolidate {
+		return &response, nil
+	}
+
+	// Get the request fee
+	response.Fee, err = validator.GetConsolidationRequestFee(ec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get gas estimate
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	response.GasInfo, err = validator.EstimateConsolidationRequestGas(ec, sourcePubkey, targetPubkey, response.Fee, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error estimating gas for consolidation request: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func consolidateValidators(c *cli.Context, sourceId uint32, targetId uint32) (*api.ConsolidateValidatorsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ConsolidateValidatorsResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the request will be honored; the system contract accepts requests from anyone,
+	// but the Beacon Chain ignores the ones that don't come from the source's withdrawal credentials
+	sourcePubkey, targetPubkey, check, err := checkConsolidation(rp, bc, nodeAccount.Address, sourceId, targetId)
+	if err != nil {
+		return nil, err
+	}
+	if !check.CanRequest() {
+		return nil, fmt.Errorf("The consolidation of validator %d into validator %d can't be requested by the node account", sourceId, targetId)
+	}
+	response.SourcePubkey = sourcePubkey
+	response.TargetPubkey = targetPubkey
+
+	// Get the request fee
+	fee, err := validator.GetConsolidationRequestFee(ec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Send the request
+	hash, err := validator.SendConsolidationRequest(ec, w.GetChainID(), sourcePubkey, targetPubkey, fee, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error sending consolidation request: %w", err)
+	}
+	response.TxHash = hash
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the pubkeys of two of the node's megapool validators and check whether the node account can consolidate them
+func checkConsolidation(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress common.Address, sourceId uint32, targetId uint32) (types.ValidatorPubkey, types.ValidatorPubkey, validator.ConsolidationRequestCheck, error) {
+
+	// Get the megapool address
+	megapoolAddress, err := megapool.GetMegapoolExpectedAddress(rp, nodeAddress, nil)
+	if err != nil {
+		return types.ValidatorPubkey{}, types.ValidatorPubkey{}, validator.ConsolidationRequestCheck{}, err
+	}
+
+	// Load the megapool
+	mp, err := megapool.NewMegaPoolV1(rp, megapoolAddress, nil)
+	if err != nil {
+		return types.ValidatorPubkey{}, types.ValidatorPubkey{}, validator.ConsolidationRequestCheck{}, err
+	}
+
+	// Get the validator pubkeys
+	sourceInfo, err := mp.GetValidatorInfoAndPubkey(sourceId, nil)
+	if err != nil {
+		return types.ValidatorPubkey{}, types.ValidatorPubkey{}, validator.ConsolidationRequestCheck{}, err
+	}
+	targetInfo, err := mp.GetValidatorInfoAndPubkey(targetId, nil)
+	if err != nil {
+		return types.ValidatorPubkey{}, types.ValidatorPubkey{}, validator.ConsolidationRequestCheck{}, err
+	}
+	sourcePubkey := types.ValidatorPubkey(sourceInfo.Pubkey)
+	targetPubkey := types.ValidatorPubkey(targetInfo.Pubkey)
+
+	// Get the validators' Beacon Chain status
+	statuses, err := bc.GetValidatorStatuses([]types.ValidatorPubkey{sourcePubkey, targetPubkey}, nil)
+	if err != nil {
+		return types.ValidatorPubkey{}, types.ValidatorPubkey{}, validator.ConsolidationRequestCheck{}, err
+	}
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return types.ValidatorPubkey{}, types.ValidatorPubkey{}, validator.ConsolidationRequestCheck{}, err
+	}
+
+	check := validator.CheckConsolidationRequest(statuses[sourcePubkey], statuses[targetPubkey], head.Epoch, nodeAddress, megapoolAddress)
+	return sourcePubkey, targetPubkey, check, nil
+
+}
@@ -79,6 +79,10 @@ func exitValidator(c *cli.Context, validatorId uint32) (*api.ExitValidatorRespon
 	if err != nil {
 		return nil, err
 	}
+	signer, err := services.GetRemoteSigner(c)
+	if err != nil {
+		return nil, err
+	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -113,12 +117,6 @@ func exitValidator(c *cli.Context, validatorId uint32) (*api.ExitValidatorRespon
 
 	validatorPubkey := types.ValidatorPubkey(validatorInfo.Pubkey)
 
-	// Get validator private key
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
-
 	// Get beacon head
 	head, err := bc.GetBeaconHead()
 	if err != nil {
@@ -137,10 +135,26 @@ func exitValidator(c *cli.Context, validatorId uint32) (*api.ExitValidatorRespon
 		return nil, err
 	}
 
-	// Get signed voluntary exit message
-	signature, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, head.Epoch, signatureDomain)
-	if err != nil {
-		return nil, err
+	// Get signed voluntary exit message, from the remote signer if the validator keys are kept in one
+	var signature types.ValidatorSignature
+	if signer != nil {
+		eth2Config, err := bc.GetEth2Config()
+		if err != nil {
+			return nil, err
+		}
+		signature, err = validator.GetRemoteSignedExitMessage(signer, validatorPubkey, validatorIndex, head.Epoch, eth2Config, signatureDomain)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+		if err != nil {
+			return nil, err
+		}
+		signature, err = validator.GetSignedExitMessage(validatorKey, validatorIndex, head.Epoch, signatureDomain)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Broadcast voluntary exit message
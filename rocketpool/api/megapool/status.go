@@ -5,9 +5,11 @@ import (
 	"math/big"
 
 	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	validatorutils "github.com/rocket-pool/smartnode/shared/utils/validator"
 	"github.com/urfave/cli"
 )
 
@@ -159,11 +161,26 @@ func getValidatorMapAndBalances(c *cli.Context) (*api.MegapoolValidatorMapAndRew
 		return nil, err
 	}
 
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
 	status, err := getStatus(c)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting the megapool status")
 	}
 
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
 	// Response
 	response := api.MegapoolValidatorMapAndRewardsResponse{}
 
@@ -177,15 +194,34 @@ func getValidatorMapAndBalances(c *cli.Context) (*api.MegapoolValidatorMapAndRew
 		"Locked":      {},
 	}
 
+	// Estimate when pending activations and withdrawals will take effect; the queues were added in Electra, so not every Beacon Node provides them
+	pubkeys := make([]types.ValidatorPubkey, 0, len(status.Megapool.Validators))
+	for _, validator := range status.Megapool.Validators {
+		if validator.PubKey != (types.ValidatorPubkey{}) {
+			pubkeys = append(pubkeys, validator.PubKey)
+		}
+	}
+	queueEstimates, err := services.GetValidatorQueueEstimates(bc, nodeAccount.Address, pubkeys)
+	if err != nil {
+		response.Warning = fmt.Sprintf("Activation and withdrawal estimates are unavailable because the Beacon Node couldn't provide its pending queues: %s", err.Error())
+	}
+
 	var totalBeaconBalance uint64
-	var totalEffectiveBeaconBalance uint64
+	var skimmableBeaconBalance uint64
+	var skimmableEffectiveBeaconBalance uint64
 	// Iterate over the validators and append them based on their statuses
 	for _, validator := range status.Megapool.Validators {
+		validator.QueueEstimates = queueEstimates[validator.PubKey]
 		if validator.Staked && !validator.Exited && !validator.Exiting {
 			statusValidators["Staking"] = append(statusValidators["Staking"], validator)
 			if validator.Activated {
 				totalBeaconBalance += validator.BeaconStatus.Balance
-				totalEffectiveBeaconBalance += validator.BeaconStatus.EffectiveBalance
+				// Compounding validators keep their rewards in their effective balance instead of having them skimmed,
+				// and their principal depends on how many validators were consolidated into them, so they're left out
+				if !validatorutils.IsCompounding(validator.BeaconStatus.WithdrawalCredentials) {
+					skimmableBeaconBalance += validator.BeaconStatus.Balance
+					skimmableEffectiveBeaconBalance += validator.BeaconStatus.EffectiveBalance
+				}
 			}
 		}
 		if validator.Exited {
@@ -212,16 +248,18 @@ func getValidatorMapAndBalances(c *cli.Context) (*api.MegapoolValidatorMapAndRew
 
 	weiPerGwei := big.NewInt(int64(eth.WeiPerGwei))
 	totalBeaconBalanceWei := new(big.Int).SetUint64(totalBeaconBalance)
-	totalEffectiveBeaconBalanceWei := new(big.Int).SetUint64(totalEffectiveBeaconBalance)
 	totalBeaconBalanceWei = totalBeaconBalanceWei.Mul(totalBeaconBalanceWei, weiPerGwei)
-	totalEffectiveBeaconBalanceWei = totalEffectiveBeaconBalanceWei.Mul(totalEffectiveBeaconBalanceWei, weiPerGwei)
+	skimmableBeaconBalanceWei := new(big.Int).SetUint64(skimmableBeaconBalance)
+	skimmableEffectiveBeaconBalanceWei := new(big.Int).SetUint64(skimmableEffectiveBeaconBalance)
+	skimmableBeaconBalanceWei = skimmableBeaconBalanceWei.Mul(skimmableBeaconBalanceWei, weiPerGwei)
+	skimmableEffectiveBeaconBalanceWei = skimmableEffectiveBeaconBalanceWei.Mul(skimmableEffectiveBeaconBalanceWei, weiPerGwei)
 
 	// Get the node share of CL rewards
 	nodeShareOfCLBalance := big.NewInt(0)
-	if totalBeaconBalanceWei.Cmp(totalEffectiveBeaconBalanceWei) <= 0 {
+	if skimmableBeaconBalanceWei.Cmp(skimmableEffectiveBeaconBalanceWei) <= 0 {
 		nodeShareOfCLBalance = big.NewInt(0)
 	} else {
-		toBeSkimmed := new(big.Int).Sub(totalBeaconBalanceWei, totalEffectiveBeaconBalanceWei)
+		toBeSkimmed := new(big.Int).Sub(skimmableBeaconBalanceWei, skimmableEffectiveBeaconBalanceWei)
 		rewards, err := calculateRewards(c, toBeSkimmed)
 		if err != nil {
 			return &response, fmt.Errorf("Error calculating the rewards split for amount %s: %w", toBeSkimmed.String(), err)
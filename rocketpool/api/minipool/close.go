@@ -391,6 +391,21 @@ func closeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CloseMi
 		response.TxHash = hash
 	}
 
+	// The validator is done, so stop the VC from signing for it; the transaction has already been sent, so a failure here is only reported
+	km, err := services.GetKeymanager(c)
+	if err != nil {
+		return nil, err
+	}
+	if km != nil {
+		pubkey, err := minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+		if err == nil {
+			_, _, err = km.DeleteValidators([]types.ValidatorPubkey{pubkey})
+		}
+		if err != nil {
+			response.KeyRemovalError = err.Error()
+		}
+	}
+
 	// Return response
 	return &response, nil
 
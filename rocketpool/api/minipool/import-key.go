@@ -88,6 +88,19 @@ func importKey(c *cli.Context, minipoolAddress common.Address, mnemonic string)
 		return nil, fmt.Errorf("error saving keystore: %w", err)
 	}
 
+	// Check if the running Validator Client loaded it through its keymanager API
+	km, err := services.GetKeymanager(c)
+	if err != nil {
+		return nil, err
+	}
+	if km != nil {
+		response.KeyLoaded, err = km.IsValidatorLoaded(pubkey)
+		if err != nil {
+			// The key was saved to disk, so the VC will still load it when it restarts
+			response.KeyLoaded = false
+		}
+	}
+
 	// Return response
 	return &response, nil
 }
@@ -18,7 +18,9 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/contracts"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/services/web3signer"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
@@ -41,6 +43,10 @@ func getMinipoolRescueDissolvedDetailsForNode(c *cli.Context) (*api.GetMinipoolR
 	if err != nil {
 		return nil, err
 	}
+	signer, err := services.GetRemoteSigner(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.GetMinipoolRescueDissolvedDetailsForNodeResponse{}
@@ -73,7 +79,7 @@ func getMinipoolRescueDissolvedDetailsForNode(c *cli.Context) (*api.GetMinipoolR
 			mi := mi
 			wg.Go(func() error {
 				address := addresses[mi]
-				mpDetails, err := getMinipoolRescueDissolvedDetails(rp, w, bc, address, nodeAccount.Address)
+				mpDetails, err := getMinipoolRescueDissolvedDetails(rp, w, bc, signer, address, nodeAccount.Address)
 				if err == nil {
 					details[mi] = mpDetails
 				}
@@ -91,7 +97,7 @@ func getMinipoolRescueDissolvedDetailsForNode(c *cli.Context) (*api.GetMinipoolR
 
 }
 
-func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w wallet.Wallet, bc beacon.Client, minipoolAddress common.Address, nodeAddress common.Address) (api.MinipoolRescueDissolvedDetails, error) {
+func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w wallet.Wallet, bc beacon.Client, signer *web3signer.Client, minipoolAddress common.Address, nodeAddress common.Address) (api.MinipoolRescueDissolvedDetails, error) {
 
 	// Create minipool
 	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
@@ -193,7 +199,7 @@ func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w wallet.Walle
 	opts.GasLimit = 0
 
 	// Get the gas info for depositing
-	tx, err := getDepositTx(rp, w, bc, minipoolAddress, one, opts)
+	tx, err := getDepositTx(rp, w, bc, signer, minipoolAddress, one, opts)
 	if err != nil {
 		return api.MinipoolRescueDissolvedDetails{}, fmt.Errorf("error estimating gas for rescue deposit on minipool %s: %w", minipoolAddress.Hex(), err)
 	}
@@ -216,7 +222,7 @@ func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w wallet.Walle
 }
 
 // Create a transaction for submitting a rescue deposit, optionally simulating it only for gas estimation
-func getDepositTx(rp *rocketpool.RocketPool, w wallet.Wallet, bc beacon.Client, minipoolAddress common.Address, amount *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
+func getDepositTx(rp *rocketpool.RocketPool, w wallet.Wallet, bc beacon.Client, signer *web3signer.Client, minipoolAddress common.Address, amount *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
 
 	blankAddress := common.Address{}
 	casperAddress, err := rp.GetAddress("casperDeposit", nil)
@@ -250,23 +256,32 @@ func getDepositTx(rp *rocketpool.RocketPool, w wallet.Wallet, bc beacon.Client,
 		return nil, err
 	}
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 	if err != nil {
 		return nil, err
 	}
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
 
 	// Get the deposit amount in gwei
 	amountGwei := big.NewInt(0).Div(amount, big.NewInt(1e9)).Uint64()
 
-	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, amountGwei)
-	if err != nil {
-		return nil, err
+	// Get validator deposit data, signed by the remote signer if the validator keys are kept in one
+	var depositData generic.DepositData
+	var depositDataRoot common.Hash
+	if signer != nil {
+		depositData, depositDataRoot, err = validator.GetRemoteSignedDepositData(signer, validatorPubkey, withdrawalCredentials, eth2Config, amountGwei)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+		if err != nil {
+			return nil, err
+		}
+		depositData, depositDataRoot, err = validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, amountGwei)
+		if err != nil {
+			return nil, err
+		}
 	}
 	signature := rptypes.BytesToValidatorSignature(depositData.Signature)
 
@@ -299,6 +314,10 @@ func rescueDissolvedMinipool(c *cli.Context, minipoolAddress common.Address, amo
 	if err != nil {
 		return nil, err
 	}
+	signer, err := services.GetRemoteSigner(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.RescueDissolvedMinipoolResponse{}
@@ -319,7 +338,7 @@ func rescueDissolvedMinipool(c *cli.Context, minipoolAddress common.Address, amo
 	opts.NoSend = !submit
 
 	// Submit the rescue deposit
-	tx, err := getDepositTx(rp, w, bc, minipoolAddress, amount, opts)
+	tx, err := getDepositTx(rp, w, bc, signer, minipoolAddress, amount, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error submitting rescue deposit: %w", err)
 	}
@@ -13,6 +13,7 @@ import (
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
@@ -35,6 +36,10 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 	if err != nil {
 		return nil, err
 	}
+	signer, err := services.GetRemoteSigner(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.CanStakeMinipoolResponse{
@@ -98,15 +103,11 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 			return nil, err
 		}
 
-		// Get the validator key for the minipool
+		// Get the validator pubkey for the minipool
 		validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 		if err != nil {
 			return nil, err
 		}
-		validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-		if err != nil {
-			return nil, err
-		}
 
 		// Get the minipool type
 		depositType, err := minipool.GetMinipoolDepositType(rp, mp.GetAddress(), nil)
@@ -124,10 +125,23 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 			return nil, fmt.Errorf("error staking minipool %s: unknown deposit type %d", mp.GetAddress().Hex(), depositType)
 		}
 
-		// Get validator deposit data
-		depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
-		if err != nil {
-			return nil, err
+		// Get validator deposit data, signed by the remote signer if the validator keys are kept in one
+		var depositData generic.DepositData
+		var depositDataRoot common.Hash
+		if signer != nil {
+			depositData, depositDataRoot, err = validator.GetRemoteSignedDepositData(signer, validatorPubkey, withdrawalCredentials, eth2Config, depositAmount)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+			if err != nil {
+				return nil, err
+			}
+			depositData, depositDataRoot, err = validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		// Get transactor
@@ -167,6 +181,10 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 	if err != nil {
 		return nil, err
 	}
+	signer, err := services.GetRemoteSigner(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.StakeMinipoolResponse{}
@@ -201,15 +219,11 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 		return nil, err
 	}
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 	if err != nil {
 		return nil, err
 	}
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
 
 	// Get the minipool type
 	depositType, err := minipool.GetMinipoolDepositType(rp, mp.GetAddress(), nil)
@@ -227,10 +241,23 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 		return nil, fmt.Errorf("error staking minipool %s: unknown deposit type %d", mp.GetAddress().Hex(), depositType)
 	}
 
-	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
-	if err != nil {
-		return nil, err
+	// Get validator deposit data, signed by the remote signer if the validator keys are kept in one
+	var depositData generic.DepositData
+	var depositDataRoot common.Hash
+	if signer != nil {
+		depositData, depositDataRoot, err = validator.GetRemoteSignedDepositData(signer, validatorPubkey, withdrawalCredentials, eth2Config, depositAmount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+		if err != nil {
+			return nil, err
+		}
+		depositData, depositDataRoot, err = validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Stake the minipool
@@ -3,6 +3,7 @@ package minipool
 import (
 	"fmt"
 
+	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -52,6 +53,21 @@ func getStatus(c *cli.Context) (*api.MinipoolStatusResponse, error) {
 	}
 	response.Minipools = details
 
+	// Estimate when pending withdrawals will take effect; the queues were added in Electra, so not every Beacon Node provides them
+	pubkeys := make([]types.ValidatorPubkey, 0, len(details))
+	for _, mpDetails := range details {
+		if mpDetails.Validator.Exists {
+			pubkeys = append(pubkeys, mpDetails.ValidatorPubkey)
+		}
+	}
+	queueEstimates, err := services.GetValidatorQueueEstimates(bc, nodeAccount.Address, pubkeys)
+	if err != nil {
+		response.Warning = fmt.Sprintf("Withdrawal estimates are unavailable because the Beacon Node couldn't provide its pending queues: %s", err.Error())
+	}
+	for i, mpDetails := range response.Minipools {
+		response.Minipools[i].QueueEstimates = queueEstimates[mpDetails.ValidatorPubkey]
+	}
+
 	delegate, err := rp.GetContract("rocketMinipoolDelegate", nil)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting latest minipool delegate contract: %w", err)
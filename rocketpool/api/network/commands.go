@@ -34,6 +34,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "gas-suggestions",
+				Usage:     "Get the current gas fee suggestions from the selected gas oracle",
+				UsageText: "rocketpool api network gas-suggestions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getGasSuggestions(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "rpl-price",
 				Aliases:   []string{"p"},
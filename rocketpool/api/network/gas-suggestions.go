@@ -0,0 +1,36 @@
+package network
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/gas/oracle"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getGasSuggestions(c *cli.Context) (*api.GasSuggestionsResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GasSuggestionsResponse{}
+
+	// Get the suggestions
+	suggestions, err := oracle.NewOracle(cfg, ec).GetSuggestions()
+	if err != nil {
+		return nil, err
+	}
+	response.Suggestions = suggestions
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,53 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func broadcastTransactions(c *cli.Context, blob string) (*api.NodeBroadcastResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeBroadcastResponse{}
+
+	// Decode the bundle and check its signatures
+	bundle, err := wallet.DecodeOfflineTransactionBundle(blob)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.ChainID != uint64(cfg.Smartnode.GetChainID()) {
+		return nil, fmt.Errorf("The transactions are for chain %d, but this node is configured for chain %d.", bundle.ChainID, cfg.Smartnode.GetChainID())
+	}
+	txs, err := bundle.GetSignedTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	// Send them in order, stopping at the first failure since later nonces can't be mined without it
+	for _, tx := range txs {
+		if err := ec.SendTransaction(context.Background(), tx); err != nil {
+			return nil, fmt.Errorf("Error sending transaction with nonce %d after %d were sent: %w", tx.Nonce(), len(response.TxHashes), err)
+		}
+		response.TxHashes = append(response.TxHashes, tx.Hash())
+	}
+
+	// Return response
+	return &response, nil
+
+}
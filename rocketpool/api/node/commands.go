@@ -1717,6 +1717,58 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					return nil
 				},
 			},
+			{
+				Name:      "tx-queue",
+				Usage:     "Get the transactions the node and watchtower daemons are tracking",
+				UsageText: "rocketpool api node tx-queue",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					api.PrintResponse(getTxQueue(c))
+					return nil
+				},
+			},
+			{
+				Name:      "tasks",
+				Usage:     "Get the status of the node and watchtower daemons' tasks",
+				UsageText: "rocketpool api node tasks",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					api.PrintResponse(getTasks(c))
+					return nil
+				},
+			},
+			{
+				Name:      "dry-run",
+				Usage:     "Get the transactions the node and watchtower daemons simulated in dry-run mode",
+				UsageText: "rocketpool api node dry-run",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					api.PrintResponse(getDryRun(c))
+					return nil
+				},
+			},
+			{
+				Name:      "broadcast",
+				Usage:     "Send transactions that were signed offline",
+				UsageText: "rocketpool api node broadcast transactions",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					api.PrintResponse(broadcastTransactions(c, c.Args().Get(0)))
+					return nil
+				},
+			},
 			{
 				Name:      "can-claim-unclaimed-rewards",
 				Usage:     "Check if any unclaimed rewards can be sent to the node's withdrawal address",
@@ -0,0 +1,36 @@
+package node
+
+import (
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/dryrun"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/urfave/cli"
+)
+
+func getDryRun(c *cli.Context) (*api.NodeDryRunResponse, error) {
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.NodeDryRunResponse{
+		Enabled:      cfg.Smartnode.DryRun.Value == true,
+		Transactions: map[string][]dryrun.SimulatedTransaction{},
+	}
+
+	// The daemons log the transactions they simulated, so read them from disk
+	fileNames, err := getDaemonFileNames(c)
+	if err != nil {
+		return nil, err
+	}
+	for daemonName, fileName := range fileNames {
+		transactions, err := dryrun.LoadSimulatedTransactions(cfg.Smartnode.GetDryRunPath(fileName))
+		if err != nil {
+			return nil, err
+		}
+		response.Transactions[daemonName] = transactions
+	}
+
+	return &response, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
+	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
 	"github.com/urfave/cli"
 )
 
@@ -174,24 +175,57 @@ func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolR
 			return nil, err
 		}
 
-		err = rocketpool.UpdateFeeRecipientFile(*smoothingPoolContract.Address, cfg)
+		// Only the node's own validators can be changed, since the VC may also validate for other nodes or for validators outside of Rocket Pool
+		pubkeys, err := walletutils.GetNodeValidatorPubkeys(rp, nodeAccount.Address)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting validator pubkeys: %w", err)
+		}
+		km, err := services.GetKeymanager(c)
 		if err != nil {
 			return nil, err
 		}
 
-		// Restart the VC
-		err = validator.RestartValidator(cfg, bc, nil, d)
+		// The fee recipient files belong to the primary node, so additional nodes rely on the keymanager API alone
+		nodeName, err := services.GetNodeName(c)
 		if err != nil {
-			// Set the fee recipient back to the node distributor
-			err2 := rocketpool.UpdateFeeRecipientFile(distributor, cfg)
-			if err2 != nil {
-				return nil, fmt.Errorf("***WARNING***\nError restarting validator: [%s]\nError setting fee recipient back to your node's distributor: [%w]\nYour node now has the Smoothing Pool as its fee recipient, even though you aren't opted in!\nPlease visit the Rocket Pool Discord server for help with these errors, so it can be set back to your node's distributor.", err.Error(), err2)
+			return nil, err
+		}
+		if nodeName != "" {
+			if km == nil {
+				return nil, fmt.Errorf("The keymanager API must be enabled to set the fee recipients of an additional node's validators.")
+			}
+			_, err = validator.SetFeeRecipientWithKeymanager(km, *smoothingPoolContract.Address, pubkeys)
+			if err != nil {
+				return nil, fmt.Errorf("Error setting the fee recipients of your validators to the Smoothing Pool: %w\nYou have not been opted into the Smoothing Pool.", err)
+			}
+		} else {
+			err = rocketpool.UpdateFeeRecipientFile(*smoothingPoolContract.Address, cfg)
+			if err != nil {
+				return nil, err
 			}
 
-			// Restart the VC but don't pay attention to the errors, since a restart error got us here in the first place
-			validator.RestartValidator(cfg, bc, nil, d)
-
-			return nil, fmt.Errorf("Error restarting validator after updating the fee recipient to the Smoothing Pool: [%w]\nYour fee recipient has been set back to your node's distributor contract.\nYou have not been opted into the Smoothing Pool.", err)
+			// Update the running VC through its keymanager API if possible, restarting it otherwise
+			if km != nil {
+				_, err = validator.SetFeeRecipientWithKeymanager(km, *smoothingPoolContract.Address, pubkeys)
+			}
+			if km == nil || err != nil {
+				err = validator.RestartValidator(cfg, bc, nil, d)
+			}
+			if err != nil {
+				// Set the fee recipient back to the node distributor
+				err2 := rocketpool.UpdateFeeRecipientFile(distributor, cfg)
+				if err2 != nil {
+					return nil, fmt.Errorf("***WARNING***\nError restarting validator: [%s]\nError setting fee recipient back to your node's distributor: [%w]\nYour node now has the Smoothing Pool as its fee recipient, even though you aren't opted in!\nPlease visit the Rocket Pool Discord server for help with these errors, so it can be set back to your node's distributor.", err.Error(), err2)
+				}
+
+				// Restart the VC but don't pay attention to the errors, since a restart error got us here in the first place
+				if km != nil {
+					validator.SetFeeRecipientWithKeymanager(km, distributor, pubkeys)
+				}
+				validator.RestartValidator(cfg, bc, nil, d)
+
+				return nil, fmt.Errorf("Error restarting validator after updating the fee recipient to the Smoothing Pool: [%w]\nYour fee recipient has been set back to your node's distributor contract.\nYou have not been opted into the Smoothing Pool.", err)
+			}
 		}
 	}
 
@@ -0,0 +1,48 @@
+package node
+
+import (
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/urfave/cli"
+)
+
+func getTasks(c *cli.Context) (*api.NodeTasksResponse, error) {
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.NodeTasksResponse{
+		Tasks: map[string][]tasks.TaskStatus{},
+	}
+
+	// The daemons persist their task statuses, so read them from disk
+	fileNames, err := getDaemonFileNames(c)
+	if err != nil {
+		return nil, err
+	}
+	for daemonName, fileName := range fileNames {
+		statuses, err := tasks.LoadStatus(cfg.Smartnode.GetTaskStatusPath(fileName))
+		if err != nil {
+			return nil, err
+		}
+		response.Tasks[daemonName] = statuses
+	}
+
+	return &response, nil
+}
+
+// Get the name the node and watchtower daemons use for the selected node's files, keyed by daemon name.
+// Only the node daemon keeps separate files for each node.
+func getDaemonFileNames(c *cli.Context) (map[string]string, error) {
+	node, err := services.GetNodeName(c)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		tasks.NodeDaemonName:       tasks.GetNodeDaemonName(node),
+		tasks.WatchtowerDaemonName: tasks.WatchtowerDaemonName,
+	}, nil
+}
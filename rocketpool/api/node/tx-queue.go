@@ -0,0 +1,35 @@
+package node
+
+import (
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/urfave/cli"
+)
+
+func getTxQueue(c *cli.Context) (*api.NodeTxQueueResponse, error) {
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.NodeTxQueueResponse{
+		Queues: map[string]*txmanager.TransactionQueue{},
+	}
+
+	// The daemons persist their queues, so read them from disk
+	fileNames, err := getDaemonFileNames(c)
+	if err != nil {
+		return nil, err
+	}
+	for daemonName, fileName := range fileNames {
+		queue, err := txmanager.LoadTransactionQueue(cfg.Smartnode.GetTransactionQueuePath(fileName))
+		if err != nil {
+			return nil, err
+		}
+		response.Queues[daemonName] = queue
+	}
+
+	return &response, nil
+}
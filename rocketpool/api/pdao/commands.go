@@ -2,6 +2,7 @@ package pdao
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -267,6 +268,51 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "can-propose-settings-bundle",
+				Usage:     "Check whether the node can propose a bundle of PDAO setting changes",
+				UsageText: "rocketpool api pdao can-propose-settings-bundle contract-names setting-names values",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					contractNames := strings.Split(c.Args().Get(0), ",")
+					settingNames := strings.Split(c.Args().Get(1), ",")
+					values := strings.Split(c.Args().Get(2), ",")
+
+					// Run
+					api.PrintResponse(canProposeSettingsBundle(c, contractNames, settingNames, values))
+					return nil
+
+				},
+			},
+			{
+				Name:      "propose-settings-bundle",
+				Usage:     "Propose a bundle of PDAO setting changes (use can-propose-settings-bundle to get the pollard)",
+				UsageText: "rocketpool api pdao propose-settings-bundle contract-names setting-names values block-number",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 4); err != nil {
+						return err
+					}
+					contractNames := strings.Split(c.Args().Get(0), ",")
+					settingNames := strings.Split(c.Args().Get(1), ",")
+					values := strings.Split(c.Args().Get(2), ",")
+					blockNumber, err := cliutils.ValidatePositiveUint32("block-number", c.Args().Get(3))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(proposeSettingsBundle(c, contractNames, settingNames, values, blockNumber))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "get-rewards-percentages",
 				Usage:     "Get the allocation percentages of RPL rewards for the Oracle DAO, the Protocol DAO, and the node operators",
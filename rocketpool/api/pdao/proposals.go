@@ -6,6 +6,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/network"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
@@ -160,6 +161,12 @@ func getProposal(c *cli.Context, id uint64) (*api.PDAOProposalResponse, error) {
 	}
 	response.Proposal = augmentedProp
 
+	// Decode the payload; payloads that can't be decoded are left to the raw payload string
+	decodedPayload, err := proposals.DecodeProposalPayload(rp, proposals.ProtocolDaoProposalsContractName, proposal.Payload, nil)
+	if err == nil {
+		response.DecodedPayload = decodedPayload
+	}
+
 	// Return response
 	return &response, nil
 
@@ -0,0 +1,355 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	node131 "github.com/rocket-pool/smartnode/bindings/legacy/v1.3.1/node"
+	"github.com/rocket-pool/smartnode/bindings/node"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	psettings "github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	updateCheck "github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+)
+
+// A validated bundle of setting changes, in the form expected by a multi-setting proposal
+type settingsBundle struct {
+	contractNames []string
+	settingPaths  []string
+	settingTypes  []types.ProposalSettingType
+	units         []api.ProposalSettingUnit
+	values        []any
+}
+
+func canProposeSettingsBundle(c *cli.Context, contractNames []string, settingPaths []string, values []string) (*api.CanProposePDAOSettingsBundleResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the bundle
+	bundle, err := parseSettingsBundle(contractNames, settingPaths, values)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if Saturn is already deployed
+	saturnDeployed, err := updateCheck.IsSaturnDeployed(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanProposePDAOSettingsBundleResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var stakedRpl *big.Int
+	var lockedRpl *big.Int
+	var proposalBond *big.Int
+	var isRplLockingAllowed bool
+	var wg errgroup.Group
+
+	if saturnDeployed {
+		// Get the node's RPL stake
+		wg.Go(func() error {
+			var err error
+			stakedRpl, err = node.GetNodeStakedRPL(rp, nodeAccount.Address, nil)
+			return err
+		})
+
+		// Get the node's locked RPL
+		wg.Go(func() error {
+			var err error
+			lockedRpl, err = node.GetNodeLockedRPL(rp, nodeAccount.Address, nil)
+			return err
+		})
+
+	} else {
+		// Get the node's RPL stake
+		wg.Go(func() error {
+			var err error
+			stakedRpl, err = node131.GetNodeRPLStake(rp, nodeAccount.Address, nil)
+			return err
+		})
+
+		// Get the node's locked RPL
+		wg.Go(func() error {
+			var err error
+			lockedRpl, err = node131.GetNodeRPLLocked(rp, nodeAccount.Address, nil)
+			return err
+		})
+	}
+
+	// Get the proposal bond
+	wg.Go(func() error {
+		var err error
+		proposalBond, err = psettings.GetProposalBond(rp, nil)
+		return err
+	})
+
+	// Get is RPL locking allowed
+	wg.Go(func() error {
+		var err error
+		isRplLockingAllowed, err = node.GetRPLLockedAllowed(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Get the current value of each setting
+	response.Changes = make([]api.ProposalSettingChange, len(bundle.settingPaths))
+	for i := range bundle.settingPaths {
+		wg.Go(func() error {
+			currentValue, err := getSettingValue(rp, bundle.contractNames[i], bundle.settingPaths[i], bundle.settingTypes[i])
+			if err != nil {
+				return err
+			}
+			response.Changes[i] = proposals.NewSettingChange(bundle.contractNames[i], bundle.settingPaths[i], bundle.units[i], currentValue, bundle.values[i])
+			return nil
+		})
+	}
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	response.StakedRpl = stakedRpl
+	response.LockedRpl = lockedRpl
+	response.ProposalBond = proposalBond
+	response.IsRplLockingDisallowed = !isRplLockingAllowed
+
+	freeRpl := big.NewInt(0).Sub(stakedRpl, lockedRpl)
+	response.InsufficientRpl = (freeRpl.Cmp(proposalBond) < 0)
+
+	// return if proposing is not possible
+	response.CanPropose = !(response.InsufficientRpl || response.IsRplLockingDisallowed)
+	if !response.CanPropose {
+		return &response, nil
+	}
+
+	// Get the latest finalized block number and corresponding pollard
+	blockNumber, pollard, err := createPollard(rp, cfg, bc)
+	if err != nil {
+		return nil, fmt.Errorf("error creating pollard: %w", err)
+	}
+	response.BlockNumber = blockNumber
+
+	// Get the account transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Estimate the gas; this simulates executing the proposal so a bundle that would revert is caught here
+	response.GasInfo, err = protocol.EstimateProposeSetMultiGas(rp, bundle.getMessage(), bundle.contractNames, bundle.settingPaths, bundle.settingTypes, bundle.values, blockNumber, pollard, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error estimating gas for proposing the settings bundle: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func proposeSettingsBundle(c *cli.Context, contractNames []string, settingPaths []string, values []string, blockNumber uint32) (*api.ProposePDAOSettingResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the bundle
+	bundle, err := parseSettingsBundle(contractNames, settingPaths, values)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ProposePDAOSettingResponse{}
+
+	// Decode the pollard
+	pollard, err := getPollard(rp, cfg, bc, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("error regenerating pollard: %w", err)
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Submit the proposal
+	proposalID, hash, err := protocol.ProposeSetMulti(rp, bundle.getMessage(), bundle.contractNames, bundle.settingPaths, bundle.settingTypes, bundle.values, blockNumber, pollard, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error proposing the settings bundle: %w", err)
+	}
+
+	// Update & return response
+	response.ProposalId = proposalID
+	response.TxHash = hash
+	return &response, nil
+
+}
+
+// Check every change in a bundle against the known settings and parse its value, which is given in the setting's units
+func parseSettingsBundle(contractNames []string, settingPaths []string, values []string) (*settingsBundle, error) {
+
+	if len(contractNames) == 0 {
+		return nil, fmt.Errorf("the settings bundle is empty")
+	}
+	if len(settingPaths) != len(contractNames) || len(values) != len(contractNames) {
+		return nil, fmt.Errorf("the settings bundle has %d contracts, %d settings and %d values; each change needs one of each", len(contractNames), len(settingPaths), len(values))
+	}
+
+	bundle := &settingsBundle{
+		contractNames: contractNames,
+		settingPaths:  settingPaths,
+		settingTypes:  make([]types.ProposalSettingType, len(contractNames)),
+		units:         make([]api.ProposalSettingUnit, len(contractNames)),
+		values:        make([]any, len(contractNames)),
+	}
+	seen := map[string]bool{}
+	for i, contractName := range contractNames {
+		settingPath := settingPaths[i]
+		key := contractName + "/" + settingPath
+		if seen[key] {
+			return nil, fmt.Errorf("[%s - %s] is in the settings bundle more than once", contractName, settingPath)
+		}
+		seen[key] = true
+
+		setting, exists := proposals.GetProtocolDaoSetting(contractName, settingPath)
+		if !exists {
+			return nil, fmt.Errorf("[%s - %s] is not a valid PDAO contract and setting name combo", contractName, settingPath)
+		}
+		value, err := parseSettingValue(setting, fmt.Sprintf("value of %s", settingPath), values[i])
+		if err != nil {
+			return nil, err
+		}
+		bundle.settingTypes[i] = setting.Type()
+		bundle.units[i] = setting.Unit
+		bundle.values[i] = value
+	}
+	return bundle, nil
+
+}
+
+// Parse a setting value the same way the matching `pdao propose setting` command does, converting it into the form the contract stores
+func parseSettingValue(setting proposals.ProtocolDaoSetting, name string, value string) (any, error) {
+	switch setting.Unit {
+	case api.ProposalSettingUnit_Bool:
+		return cliutils.ValidateBool(name, value)
+	case api.ProposalSettingUnit_Address:
+		return cliutils.ValidateAddress(name, value)
+	case api.ProposalSettingUnit_Percent:
+		if !setting.IsUnbounded {
+			fraction, err := cliutils.ValidateFraction(name, value)
+			if err != nil {
+				return nil, err
+			}
+			return eth.EthToWei(fraction), nil
+		}
+		fallthrough
+	case api.ProposalSettingUnit_Eth, api.ProposalSettingUnit_Rpl:
+		amount, err := cliutils.ValidateEthAmount(name, value)
+		if err != nil {
+			return nil, err
+		}
+		if amount < 0 {
+			return nil, fmt.Errorf("Invalid %s '%s' - must not be negative", name, value)
+		}
+		return eth.EthToWei(amount), nil
+	case api.ProposalSettingUnit_Duration:
+		duration, err := cliutils.ValidateDuration(name, value)
+		if err != nil {
+			return nil, err
+		}
+		if duration < 0 {
+			return nil, fmt.Errorf("Invalid %s '%s' - must not be negative", name, value)
+		}
+		return new(big.Int).SetUint64(uint64(duration.Seconds())), nil
+	}
+	count, err := cliutils.ValidatePositiveUint(name, value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetUint64(count), nil
+}
+
+// Get the message the bundle's proposal is submitted with
+func (b *settingsBundle) getMessage() string {
+	return fmt.Sprintf("set %s", strings.Join(b.settingPaths, ", "))
+}
+
+// Get the current value of a setting
+func getSettingValue(rp *rocketpool.RocketPool, contractName string, settingPath string, settingType types.ProposalSettingType) (any, error) {
+	switch settingType {
+	case types.ProposalSettingType_Uint256:
+		return psettings.GetSettingUint(rp, contractName, settingPath, nil)
+	case types.ProposalSettingType_Bool:
+		return psettings.GetSettingBool(rp, contractName, settingPath, nil)
+	case types.ProposalSettingType_Address:
+		return psettings.GetSettingAddress(rp, contractName, settingPath, nil)
+	}
+	return nil, fmt.Errorf("unsupported type %d for setting %s", settingType, settingPath)
+}
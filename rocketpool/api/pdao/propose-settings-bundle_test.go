@@ -0,0 +1,100 @@
+package pdao
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	psettings "github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestParseSettingsBundle(t *testing.T) {
+	bundle, err := parseSettingsBundle(
+		[]string{
+			psettings.AuctionSettingsContractName,
+			psettings.NetworkSettingsContractName,
+			psettings.NetworkSettingsContractName,
+			psettings.NodeSettingsContractName,
+			psettings.ProposalsSettingsContractName,
+			psettings.ProposalsSettingsContractName,
+			psettings.DepositSettingsContractName,
+			psettings.ProposalsSettingsContractName,
+		},
+		[]string{
+			psettings.LotMinimumEthValueSettingPath,
+			psettings.MinimumNodeFeeSettingPath,
+			psettings.SubmitBalancesEnabledSettingPath,
+			psettings.MinimumPerMinipoolStakeSettingPath,
+			psettings.VotePhase1TimeSettingPath,
+			psettings.ProposalBondSettingPath,
+			psettings.MaximumDepositAssignmentsSettingPath,
+			psettings.ProposalMaxBlockAgeSettingPath,
+		},
+		[]string{"1.5", "0.05", "yes", "1.5", "72h", "100", "90", "40000"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		settingType types.ProposalSettingType
+		unit        api.ProposalSettingUnit
+		value       string
+	}{
+		{types.ProposalSettingType_Uint256, api.ProposalSettingUnit_Eth, eth.EthToWei(1.5).String()},
+		{types.ProposalSettingType_Uint256, api.ProposalSettingUnit_Percent, eth.EthToWei(0.05).String()},
+		{types.ProposalSettingType_Bool, api.ProposalSettingUnit_Bool, "true"},
+		{types.ProposalSettingType_Uint256, api.ProposalSettingUnit_Percent, eth.EthToWei(1.5).String()},
+		{types.ProposalSettingType_Uint256, api.ProposalSettingUnit_Duration, "259200"},
+		{types.ProposalSettingType_Uint256, api.ProposalSettingUnit_Rpl, eth.EthToWei(100).String()},
+		{types.ProposalSettingType_Uint256, api.ProposalSettingUnit_Count, "90"},
+		{types.ProposalSettingType_Uint256, api.ProposalSettingUnit_Blocks, "40000"},
+	}
+	for i, change := range expected {
+		value := fmt.Sprint(bundle.values[i])
+		if bundle.settingTypes[i] != change.settingType || bundle.units[i] != change.unit || value != change.value {
+			t.Errorf("expected %s to be parsed as %s (type %d, %s), got %s (type %d, %s)", bundle.settingPaths[i], change.value, change.settingType, change.unit, value, bundle.settingTypes[i], bundle.units[i])
+		}
+	}
+	if message := bundle.getMessage(); !strings.HasPrefix(message, "set "+psettings.LotMinimumEthValueSettingPath+", ") {
+		t.Errorf("expected the message to list the settings, got %q", message)
+	}
+}
+
+func TestParseSettingsBundleErrors(t *testing.T) {
+	network := psettings.NetworkSettingsContractName
+	tests := []struct {
+		name          string
+		contractNames []string
+		settingPaths  []string
+		values        []string
+		err           string
+	}{
+		{"empty", nil, nil, nil, "is empty"},
+		{"mismatched lengths", []string{network, network}, []string{psettings.MinimumNodeFeeSettingPath}, []string{"0.05"}, "each change needs one of each"},
+		{"duplicates", []string{network, network}, []string{psettings.MinimumNodeFeeSettingPath, psettings.MinimumNodeFeeSettingPath}, []string{"0.05", "0.06"}, "more than once"},
+		{"unknown setting", []string{network}, []string{"network.unknown"}, []string{"1"}, "not a valid PDAO contract and setting name combo"},
+		{"setting in another contract", []string{psettings.NodeSettingsContractName}, []string{psettings.MinimumNodeFeeSettingPath}, []string{"0.05"}, "not a valid PDAO contract and setting name combo"},
+		{"address list", []string{network}, []string{psettings.NetworkAllowListedControllersPath}, []string{"0x1234567890123456789012345678901234567890"}, "not a valid PDAO contract and setting name combo"},
+		{"percentage over 100%", []string{network}, []string{psettings.MinimumNodeFeeSettingPath}, []string{"1.5"}, "between 0 and 1"},
+		{"raw wei percentage", []string{network}, []string{psettings.MinimumNodeFeeSettingPath}, []string{"50000000000000000"}, "between 0 and 1"},
+		{"negative amount", []string{network}, []string{psettings.NodeFeeDemandRangeSettingPath}, []string{"-1"}, "must not be negative"},
+		{"bad bool", []string{network}, []string{psettings.SubmitBalancesEnabledSettingPath}, []string{"maybe"}, "Invalid"},
+		{"bad duration", []string{network}, []string{psettings.SubmitBalancesFrequencySettingPath}, []string{"3600"}, "Invalid"},
+		{"zero count", []string{psettings.DepositSettingsContractName}, []string{psettings.MaximumDepositAssignmentsSettingPath}, []string{"0"}, "must be greater than 0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseSettingsBundle(test.contractNames, test.settingPaths, test.values)
+			if err == nil {
+				t.Fatalf("expected an error containing %q", test.err)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("expected an error containing %q, got %q", test.err, err.Error())
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -83,6 +84,12 @@ func getProposal(c *cli.Context, id uint64) (*api.SecurityProposalResponse, erro
 
 	response.Proposal = proposal
 
+	// Decode the payload; payloads that can't be decoded are left to the raw payload string
+	decodedPayload, err := proposals.DecodeProposalPayload(rp, proposal.DAO, proposal.Payload, nil)
+	if err == nil {
+		response.DecodedPayload = decodedPayload
+	}
+
 	// Return response
 	return &response, nil
 
@@ -0,0 +1,270 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared"
+	apitypes "github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+const (
+	serveCommandName string = "serve"
+	socketFileMode          = 0600
+)
+
+// A long-running HTTP server that keeps the API's services warm between commands
+type apiServer struct {
+	app            *cli.App
+	apiCommandName string
+	settings       string
+	routes         map[string]bool
+
+	// The API's services aren't built for concurrent use, so commands are run one at a time
+	lock sync.Mutex
+}
+
+// Run the API as a persistent HTTP server
+func runServer(c *cli.Context, app *cli.App, apiCommandName string) error {
+
+	// Get the listener
+	socketPath := c.String("socket")
+	address := c.String("address")
+	if (socketPath == "") == (address == "") {
+		return errors.New("Exactly one of --socket or --address must be provided")
+	}
+	var listener net.Listener
+	var err error
+	if socketPath != "" {
+		listener, err = listenOnSocket(socketPath)
+	} else {
+		// The server has no authentication, so it can't be reachable from other machines
+		if err := checkLoopbackAddress(address); err != nil {
+			return err
+		}
+		listener, err = net.Listen("tcp", address)
+	}
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	// Build the route table from the registered API commands
+	apiCommand := app.Command(apiCommandName)
+	if apiCommand == nil {
+		return errors.New("Could not find the API command")
+	}
+	server := &apiServer{
+		app:            app,
+		apiCommandName: apiCommandName,
+		settings:       c.GlobalString("settings"),
+		routes:         map[string]bool{},
+	}
+	server.addRoutes(apitypes.ApiServerRoutePrefix, apiCommand.Subcommands)
+
+	fmt.Fprintf(os.Stderr, "Starting API server v%s on %s with %d routes.\n", shared.RocketPoolVersion(), listener.Addr().String(), len(server.routes))
+	mux := http.NewServeMux()
+	mux.HandleFunc(apitypes.ApiServerRoutePrefix, server.handleRoutes)
+	mux.HandleFunc(apitypes.ApiServerRoutePrefix+"/", server.handleCommand)
+	err = http.Serve(listener, mux)
+	if err != nil {
+		return fmt.Errorf("Error running API server: %w", err)
+	}
+	return nil
+
+}
+
+// Make sure an address only binds to the loopback interface
+func checkLoopbackAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("Invalid address [%s]: %w", address, err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("The API server can only listen on a loopback address such as 127.0.0.1, not [%s]; use --socket to share it with other users or containers", address)
+	}
+	return nil
+}
+
+// Create a unix socket listener that only the owner of the socket's folder can use
+func listenOnSocket(socketPath string) (net.Listener, error) {
+
+	// Remove the socket left behind by a previous run
+	err := os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("Error removing old API socket [%s]: %w", socketPath, err)
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating API socket [%s]: %w", socketPath, err)
+	}
+	err = os.Chmod(socketPath, socketFileMode)
+	if err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("Error setting permissions on API socket [%s]: %w", socketPath, err)
+	}
+
+	// When running in Docker the socket belongs to the container's user, so hand it to the owner of the folder on the host
+	info, err := os.Stat(filepath.Dir(socketPath))
+	if err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("Error checking API socket folder: %w", err)
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok && int(stat.Uid) != os.Getuid() {
+		err = os.Chown(socketPath, int(stat.Uid), int(stat.Gid))
+		if err != nil {
+			listener.Close()
+			return nil, fmt.Errorf("Error setting the owner of API socket [%s]: %w", socketPath, err)
+		}
+	}
+
+	return listener, nil
+
+}
+
+// Add a route for every runnable command in the tree
+func (s *apiServer) addRoutes(prefix string, commands []cli.Command) {
+	for _, command := range commands {
+		if command.Name == serveCommandName {
+			continue
+		}
+		route := prefix + "/" + command.Name
+		if len(command.Subcommands) > 0 {
+			s.addRoutes(route, command.Subcommands)
+		} else {
+			s.routes[route] = true
+		}
+	}
+}
+
+// List the available routes
+func (s *apiServer) handleRoutes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	response := apitypes.ApiServerRoutesResponse{
+		Status:  "success",
+		Version: shared.RocketPoolVersion(),
+		Routes:  make([]string, 0, len(s.routes)),
+	}
+	for route := range s.routes {
+		response.Routes = append(response.Routes, route)
+	}
+	sort.Strings(response.Routes)
+
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(bytes)
+}
+
+// Run the command for a route and return its response
+func (s *apiServer) handleCommand(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	route := strings.TrimSuffix(r.URL.Path, "/")
+	if !s.routes[route] {
+		http.Error(w, fmt.Sprintf("Unknown route [%s]", route), http.StatusNotFound)
+		return
+	}
+
+	// Parse the request
+	var request apitypes.ApiServerRequest
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Error reading request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &request); err != nil {
+			http.Error(w, fmt.Sprintf("Error decoding request body: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+	}
+
+	command := strings.Split(strings.TrimPrefix(route, apitypes.ApiServerRoutePrefix+"/"), "/")
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(s.runCommand(command, request))
+}
+
+// Run an API command in-process and capture its response
+func (s *apiServer) runCommand(command []string, request apitypes.ApiServerRequest) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Build the arguments as if the command came from the CLI
+	args := []string{s.app.Name, "--settings", s.settings}
+	if request.MaxFee != 0 {
+		args = append(args, "--maxFee", strconv.FormatFloat(request.MaxFee, 'f', -1, 64))
+	}
+	if request.MaxPrioFee != 0 {
+		args = append(args, "--maxPrioFee", strconv.FormatFloat(request.MaxPrioFee, 'f', -1, 64))
+	}
+	if request.GasLimit != 0 {
+		args = append(args, "--gasLimit", strconv.FormatUint(request.GasLimit, 10))
+	}
+	if request.Nonce != "" {
+		args = append(args, "--nonce", request.Nonce)
+	}
+	if request.OfflineTx {
+		args = append(args, "--offline-tx")
+	}
+	if request.Node != "" {
+		args = append(args, "--node", request.Node)
+	}
+	if request.IgnoreSyncCheck {
+		args = append(args, "--ignore-sync-check")
+	}
+	if request.ForceFallbacks {
+		args = append(args, "--force-fallbacks")
+	}
+	args = append(args, s.apiCommandName)
+	args = append(args, command...)
+	args = append(args, request.Args...)
+
+	// Capture the response
+	buffer := new(bytes.Buffer)
+	previousOutput := api.SetOutput(buffer)
+	defer api.SetOutput(previousOutput)
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				buffer.Reset()
+				api.PrintErrorResponse(fmt.Errorf("API command panicked: %v", r))
+			}
+		}()
+		if err := s.app.Run(args); err != nil {
+			buffer.Reset()
+			api.PrintErrorResponse(err)
+		}
+	}()
+
+	return buffer.Bytes()
+}
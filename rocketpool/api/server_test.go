@@ -0,0 +1,32 @@
+package api
+
+import "testing"
+
+func TestCheckLoopbackAddress(t *testing.T) {
+	tests := []struct {
+		address     string
+		expectError bool
+	}{
+		{"127.0.0.1:8280", false},
+		{"127.0.0.2:8280", false},
+		{"localhost:8280", false},
+		{"[::1]:8280", false},
+		{":8280", true},
+		{"0.0.0.0:8280", true},
+		{"[::]:8280", true},
+		{"192.168.1.10:8280", true},
+		{"example.com:8280", true},
+		{"127.0.0.1", true},
+	}
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			err := checkLoopbackAddress(test.address)
+			if test.expectError && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !test.expectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -34,6 +35,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "nodes",
+				Usage:     "List the nodes this installation manages and their wallets",
+				UsageText: "rocketpool api wallet nodes",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getNodes(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "set-password",
 				Aliases:   []string{"p"},
@@ -172,6 +191,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "unload-validator-keys",
+				Usage:     "Remove the node's validator keys from the Validator Client through its keymanager API",
+				UsageText: "rocketpool api wallet unload-validator-keys",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(unloadValidatorKeys(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "test-recovery",
 				Aliases:   []string{"r"},
@@ -262,6 +299,68 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-validator-keys",
+				Usage:     "Export validator keys as EIP-2335 keystores along with an EIP-3076 slashing protection file",
+				UsageText: "rocketpool api wallet export-validator-keys pubkeys",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					pubkeys := []types.ValidatorPubkey{}
+					if c.Args().Get(0) != "all" {
+						var err error
+						pubkeys, err = cliutils.ValidatePubkeys("pubkeys", c.Args().Get(0))
+						if err != nil {
+							return err
+						}
+					}
+
+					// Run
+					api.PrintResponse(exportValidatorKeys(c, pubkeys))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "import-validator-key",
+				Usage:     "Import a validator key from an EIP-2335 keystore",
+				UsageText: "rocketpool api wallet import-validator-key keystore password",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(importValidatorKey(c, c.Args().Get(0), c.Args().Get(1)))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "sign-offline",
+				Usage:     "Sign transactions that were exported for offline signing",
+				UsageText: "rocketpool api wallet sign-offline transactions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(signOfflineTransactions(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "estimate-gas-set-ens-name",
 				Usage:     "Estimate the gas required to set the name for the node wallet's ENS reverse record",
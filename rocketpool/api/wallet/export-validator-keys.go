@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
+)
+
+func exportValidatorKeys(c *cli.Context, pubkeys []types.ValidatorPubkey) (*api.ExportValidatorKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ExportValidatorKeysResponse{}
+
+	// Default to all of the node's validators
+	exportAll := len(pubkeys) == 0
+	if exportAll {
+		nodeAccount, err := w.GetNodeAccount()
+		if err != nil {
+			return nil, err
+		}
+		pubkeys, err = walletutils.GetNodeValidatorPubkeys(rp, nodeAccount.Address)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting the node's validator pubkeys: %w", err)
+		}
+	}
+
+	// Every keystore in the export shares one password
+	response.Password, err = keystore.GenerateRandomPassword()
+	if err != nil {
+		return nil, fmt.Errorf("Error generating keystore password: %w", err)
+	}
+
+	// Encrypt the keys
+	for _, pubkey := range pubkeys {
+		key, err := w.LoadValidatorKey(pubkey)
+		if err != nil {
+			// Keys for the node's other validators may live somewhere else, but explicitly requested ones must be present
+			if exportAll {
+				response.MissingKeys = append(response.MissingKeys, pubkey)
+				continue
+			}
+			return nil, err
+		}
+		validatorKeystore, err := keystore.EncryptValidatorKey(key, "", response.Password)
+		if err != nil {
+			return nil, err
+		}
+		keystoreBytes, err := json.Marshal(validatorKeystore)
+		if err != nil {
+			return nil, fmt.Errorf("Error serializing keystore for validator %s: %w", pubkey.Hex(), err)
+		}
+		response.Keystores = append(response.Keystores, api.ExportedValidatorKey{
+			Pubkey:   pubkey,
+			Keystore: string(keystoreBytes),
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
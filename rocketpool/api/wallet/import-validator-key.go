@@ -0,0 +1,33 @@
+package wallet
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func importValidatorKey(c *cli.Context, keystore string, password string) (*api.ImportValidatorKeyResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ImportValidatorKeyResponse{}
+
+	// Import the key into every client's keystore
+	response.Pubkey, err = w.ImportValidatorKey([]byte(keystore), password)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,47 @@
+package wallet
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getNodes(c *cli.Context) (*api.WalletNodesResponse, error) {
+
+	// Get the nodes this installation manages
+	nodes, err := services.GetNodeNames(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.WalletNodesResponse{
+		Nodes: []api.NodeWalletDetails{},
+	}
+
+	// Get each node's wallet
+	for _, node := range nodes {
+		w, err := services.GetWallet(services.NewNodeContext(c, node))
+		if err != nil {
+			return nil, err
+		}
+		details := api.NodeWalletDetails{
+			Name:              services.GetNodeDisplayName(node),
+			WalletInitialized: w.IsInitialized(),
+			IsMasquerading:    w.IsNodeMasquerading(),
+		}
+		if details.WalletInitialized || details.IsMasquerading {
+			nodeAccount, err := w.GetNodeAccount()
+			if err != nil {
+				return nil, err
+			}
+			details.AccountAddress = nodeAccount.Address
+		}
+		response.Nodes = append(response.Nodes, details)
+	}
+
+	// Return response
+	return &response, nil
+
+}
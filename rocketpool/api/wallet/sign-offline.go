@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func signOfflineTransactions(c *cli.Context, blob string) (*api.SignOfflineTransactionsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetHdWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SignOfflineTransactionsResponse{}
+
+	// Decode the bundle
+	bundle, err := wallet.DecodeOfflineTransactionBundle(blob)
+	if err != nil {
+		return nil, err
+	}
+	chainID := w.GetChainID()
+	if bundle.ChainID != chainID.Uint64() {
+		return nil, fmt.Errorf("The transactions are for chain %d, but this node is configured for chain %d.", bundle.ChainID, chainID.Uint64())
+	}
+
+	// Sign the transactions with the node key
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	if err := bundle.Sign(opts.Signer); err != nil {
+		return nil, err
+	}
+	response.SignedTransactions, err = bundle.Encode()
+	if err != nil {
+		return nil, err
+	}
+	response.TransactionCount = len(bundle.Transactions)
+
+	// Return response
+	return &response, nil
+
+}
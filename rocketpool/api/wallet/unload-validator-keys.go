@@ -0,0 +1,58 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
+)
+
+func unloadValidatorKeys(c *cli.Context) (*api.UnloadValidatorKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	km, err := services.GetKeymanager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.UnloadValidatorKeysResponse{}
+	if km == nil {
+		response.KeymanagerDisabled = true
+		return &response, nil
+	}
+
+	// Get the node's validators; the VC may also have keys that don't belong to the node, which are left alone
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	pubkeys, err := walletutils.GetNodeValidatorPubkeys(rp, nodeAccount.Address)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting validator pubkeys: %w", err)
+	}
+
+	// Remove them from the VC
+	_, response.RemovedCount, err = km.DeleteValidators(pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("Error removing validator keys from the Validator Client: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
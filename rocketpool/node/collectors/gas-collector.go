@@ -0,0 +1,90 @@
+package collectors
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/gas/oracle"
+)
+
+// Represents the collector for the gas oracle's fee suggestions
+type GasCollector struct {
+	// The base fee of the next block, in gwei
+	baseFee *prometheus.Desc
+
+	// The suggested max fee for each speed, in gwei
+	maxFee *prometheus.Desc
+
+	// The suggested priority fee for each speed, in gwei
+	priorityFee *prometheus.Desc
+
+	// The oracle providing the suggestions
+	oracle oracle.Oracle
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new GasCollector instance
+func NewGasCollector(cfg *config.RocketPoolConfig, ec oracle.FeeHistoryClient) *GasCollector {
+	subsystem := "gas"
+	return &GasCollector{
+		baseFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "base_fee_gwei"),
+			"The base fee of the next block, in gwei",
+			[]string{"source"}, nil,
+		),
+		maxFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "suggested_max_fee_gwei"),
+			"The suggested max fee for each speed, in gwei",
+			[]string{"source", "speed"}, nil,
+		),
+		priorityFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "suggested_priority_fee_gwei"),
+			"The suggested priority fee for each speed, in gwei",
+			[]string{"source", "speed"}, nil,
+		),
+		oracle:    oracle.NewOracle(cfg, ec),
+		logPrefix: "Gas Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *GasCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.baseFee
+	channel <- collector.maxFee
+	channel <- collector.priorityFee
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *GasCollector) Collect(channel chan<- prometheus.Metric) {
+	suggestions, err := collector.oracle.GetSuggestions()
+	if err != nil {
+		collector.logError(fmt.Errorf("error getting gas suggestions: %w", err))
+		return
+	}
+
+	// External oracles don't report the base fee or priority fees
+	if suggestions.BaseFee != nil {
+		channel <- prometheus.MustNewConstMetric(
+			collector.baseFee, prometheus.GaugeValue, eth.WeiToGwei(suggestions.BaseFee), suggestions.Source)
+	}
+	for speed, suggestion := range map[string]oracle.FeeSuggestion{
+		"slow":     suggestions.Slow,
+		"standard": suggestions.Standard,
+		"fast":     suggestions.Fast,
+	} {
+		if suggestion.MaxFee != nil {
+			channel <- prometheus.MustNewConstMetric(
+				collector.maxFee, prometheus.GaugeValue, eth.WeiToGwei(suggestion.MaxFee), suggestions.Source, speed)
+		}
+		if suggestion.PriorityFee != nil {
+			channel <- prometheus.MustNewConstMetric(
+				collector.priorityFee, prometheus.GaugeValue, eth.WeiToGwei(suggestion.PriorityFee), suggestions.Source, speed)
+		}
+	}
+}
+
+// Log error messages
+func (collector *GasCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
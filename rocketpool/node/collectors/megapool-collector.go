@@ -0,0 +1,337 @@
+package collectors
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Megapool validator amounts are stored in milliether
+	milliEtherPerEth float64 = 1000
+
+	// The withdrawable epoch of validators that haven't exited
+	farFutureEpoch uint64 = 0xffffffffffffffff
+)
+
+// Represents the collector for the user's megapool
+type MegapoolCollector struct {
+	// The number of validators in the megapool, by status
+	validatorCount *prometheus.Desc
+
+	// The status of each megapool validator
+	validatorStatus *prometheus.Desc
+
+	// The position of each megapool validator in the deposit queue
+	validatorQueuePosition *prometheus.Desc
+
+	// The ETH bond of each megapool validator
+	validatorBond *prometheus.Desc
+
+	// The time each prestaked validator can be dissolved if it hasn't been staked
+	validatorDissolveDeadline *prometheus.Desc
+
+	// The time each validator's exit must be notified by before it can be challenged
+	validatorExitDeadline *prometheus.Desc
+
+	// The amount of ETH the node owes to the megapool
+	nodeDebt *prometheus.Desc
+
+	// The amount of ETH waiting to be refunded to the node
+	refundValue *prometheus.Desc
+
+	// The total ETH bonded by the node in the megapool
+	nodeBond *prometheus.Desc
+
+	// The ETH borrowed from the protocol by the megapool
+	userCapital *prometheus.Desc
+
+	// The split of the megapool's pending rewards
+	pendingRewards *prometheus.Desc
+
+	// The number of express queue tickets the node has
+	expressTickets *prometheus.Desc
+
+	// The Rocket Pool contract manager
+	rp *rocketpool.RocketPool
+
+	// The beacon client
+	bc *services.BeaconClientManager
+
+	// The node's address
+	nodeAddress common.Address
+
+	// The thread-safe locker for the network state
+	stateLocker *StateLocker
+
+	// Cached megapool details, refreshed when the network state moves to a new block
+	cacheLock          *sync.Mutex
+	cachedBlock        uint64
+	cachedDetails      *api.MegapoolDetails
+	timeBeforeDissolve uint64
+	notifyThreshold    uint64
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new MegapoolCollector instance
+func NewMegapoolCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManager, nodeAddress common.Address, stateLocker *StateLocker) *MegapoolCollector {
+	subsystem := "megapool"
+	return &MegapoolCollector{
+		validatorCount: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "validator_count"),
+			"The number of validators in the megapool, by status",
+			[]string{"status"}, nil,
+		),
+		validatorStatus: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "validator_status"),
+			"The status of each megapool validator",
+			[]string{"validator_id", "pubkey", "status"}, nil,
+		),
+		validatorQueuePosition: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "validator_queue_position"),
+			"The position of each megapool validator in the deposit queue",
+			[]string{"validator_id", "express"}, nil,
+		),
+		validatorBond: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "validator_bond"),
+			"The ETH bond of each megapool validator",
+			[]string{"validator_id"}, nil,
+		),
+		validatorDissolveDeadline: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "validator_dissolve_deadline"),
+			"The time (in seconds since the epoch) each prestaked validator can be dissolved if it hasn't been staked",
+			[]string{"validator_id"}, nil,
+		),
+		validatorExitDeadline: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "validator_exit_deadline"),
+			"The time (in seconds since the epoch) each withdrawing validator's exit must be notified by before it can be challenged",
+			[]string{"validator_id"}, nil,
+		),
+		nodeDebt: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "node_debt"),
+			"The amount of ETH the node owes to the megapool",
+			nil, nil,
+		),
+		refundValue: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "refund_value"),
+			"The amount of ETH waiting to be refunded to the node",
+			nil, nil,
+		),
+		nodeBond: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "node_bond"),
+			"The total ETH bonded by the node in the megapool",
+			nil, nil,
+		),
+		userCapital: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "user_capital"),
+			"The ETH borrowed from the protocol by the megapool",
+			nil, nil,
+		),
+		pendingRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "pending_rewards"),
+			"The split of the megapool's pending rewards",
+			[]string{"recipient"}, nil,
+		),
+		expressTickets: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "express_tickets"),
+			"The number of express queue tickets the node has",
+			nil, nil,
+		),
+		rp:          rp,
+		bc:          bc,
+		nodeAddress: nodeAddress,
+		stateLocker: stateLocker,
+		cacheLock:   &sync.Mutex{},
+		logPrefix:   "Megapool Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *MegapoolCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.validatorCount
+	channel <- collector.validatorStatus
+	channel <- collector.validatorQueuePosition
+	channel <- collector.validatorBond
+	channel <- collector.validatorDissolveDeadline
+	channel <- collector.validatorExitDeadline
+	channel <- collector.nodeDebt
+	channel <- collector.refundValue
+	channel <- collector.nodeBond
+	channel <- collector.userCapital
+	channel <- collector.pendingRewards
+	channel <- collector.expressTickets
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *MegapoolCollector) Collect(channel chan<- prometheus.Metric) {
+	// Get the latest state
+	state := collector.stateLocker.GetState()
+	if state == nil || !state.IsSaturnDeployed {
+		return
+	}
+	nd, exists := state.NodeDetailsByAddress[collector.nodeAddress]
+	if !exists || !nd.MegapoolDeployed {
+		return
+	}
+
+	// Get the megapool details
+	details, timeBeforeDissolve, notifyThreshold, err := collector.getMegapoolDetails(state.ElBlockNumber)
+	if err != nil {
+		collector.logError(err)
+		return
+	}
+	if details.DelegateExpired {
+		// The details aren't loaded for megapools with expired delegates
+		return
+	}
+
+	// Megapool metrics
+	channel <- prometheus.MustNewConstMetric(
+		collector.nodeDebt, prometheus.GaugeValue, weiToEth(details.NodeDebt))
+	channel <- prometheus.MustNewConstMetric(
+		collector.refundValue, prometheus.GaugeValue, weiToEth(details.RefundValue))
+	channel <- prometheus.MustNewConstMetric(
+		collector.nodeBond, prometheus.GaugeValue, weiToEth(details.NodeBond))
+	channel <- prometheus.MustNewConstMetric(
+		collector.userCapital, prometheus.GaugeValue, weiToEth(details.UserCapital))
+	channel <- prometheus.MustNewConstMetric(
+		collector.expressTickets, prometheus.GaugeValue, float64(details.NodeExpressTicketCount))
+	channel <- prometheus.MustNewConstMetric(
+		collector.pendingRewards, prometheus.GaugeValue, weiToEth(details.PendingRewardSplit.NodeRewards), "node")
+	channel <- prometheus.MustNewConstMetric(
+		collector.pendingRewards, prometheus.GaugeValue, weiToEth(details.PendingRewardSplit.VoterRewards), "voter")
+	channel <- prometheus.MustNewConstMetric(
+		collector.pendingRewards, prometheus.GaugeValue, weiToEth(details.PendingRewardSplit.ProtocolDAORewards), "pdao")
+	channel <- prometheus.MustNewConstMetric(
+		collector.pendingRewards, prometheus.GaugeValue, weiToEth(details.PendingRewardSplit.RethRewards), "reth")
+
+	// Validator metrics
+	secondsPerEpoch := state.BeaconConfig.SecondsPerSlot * state.BeaconConfig.SlotsPerEpoch
+	notifyThresholdInEpochs := notifyThreshold / secondsPerEpoch
+	statusCounts := map[string]float64{}
+	for _, validator := range details.Validators {
+		id := fmt.Sprint(validator.ValidatorId)
+		status := getMegapoolValidatorStatus(validator)
+		statusCounts[status]++
+
+		channel <- prometheus.MustNewConstMetric(
+			collector.validatorStatus, prometheus.GaugeValue, 1, id, validator.PubKey.Hex(), status)
+		channel <- prometheus.MustNewConstMetric(
+			collector.validatorBond, prometheus.GaugeValue, float64(validator.LastRequestedBond)/milliEtherPerEth, id)
+
+		if validator.InQueue && validator.QueuePosition != nil {
+			channel <- prometheus.MustNewConstMetric(
+				collector.validatorQueuePosition, prometheus.GaugeValue, float64(validator.QueuePosition.Uint64()), id, fmt.Sprint(validator.ExpressUsed))
+		}
+
+		if validator.InPrestake {
+			deadline := validator.LastAssignmentTime.Unix() + int64(timeBeforeDissolve)
+			channel <- prometheus.MustNewConstMetric(
+				collector.validatorDissolveDeadline, prometheus.GaugeValue, float64(deadline), id)
+		}
+
+		if validator.Activated && validator.WithdrawableEpoch < farFutureEpoch && validator.Staked && !validator.Exited && !validator.Exiting {
+			deadlineEpoch := uint64(0)
+			if validator.WithdrawableEpoch > notifyThresholdInEpochs {
+				deadlineEpoch = validator.WithdrawableEpoch - notifyThresholdInEpochs
+			}
+			deadline := state.BeaconConfig.GenesisTime + deadlineEpoch*secondsPerEpoch
+			channel <- prometheus.MustNewConstMetric(
+				collector.validatorExitDeadline, prometheus.GaugeValue, float64(deadline), id)
+		}
+	}
+	for status, count := range statusCounts {
+		channel <- prometheus.MustNewConstMetric(
+			collector.validatorCount, prometheus.GaugeValue, count, status)
+	}
+}
+
+// Get the megapool details and the settings used for its deadlines, reloading them if the state has moved to a new block
+func (collector *MegapoolCollector) getMegapoolDetails(blockNumber uint64) (*api.MegapoolDetails, uint64, uint64, error) {
+	collector.cacheLock.Lock()
+	defer collector.cacheLock.Unlock()
+
+	if collector.cachedDetails != nil && collector.cachedBlock == blockNumber {
+		return collector.cachedDetails, collector.timeBeforeDissolve, collector.notifyThreshold, nil
+	}
+
+	// Sync
+	var wg errgroup.Group
+	var details api.MegapoolDetails
+	var timeBeforeDissolve uint64
+	var notifyThreshold uint64
+
+	wg.Go(func() error {
+		var err error
+		details, err = services.GetNodeMegapoolDetails(collector.rp, collector.bc, collector.nodeAddress)
+		if err != nil {
+			return fmt.Errorf("Error getting megapool details: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		timeBeforeDissolve, err = protocol.GetMegapoolTimeBeforeDissolve(collector.rp, nil)
+		if err != nil {
+			return fmt.Errorf("Error getting megapool dissolve timeout: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		notifyThreshold, err = protocol.GetNotifyThreshold(collector.rp, nil)
+		if err != nil {
+			return fmt.Errorf("Error getting megapool notify threshold: %w", err)
+		}
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, 0, 0, err
+	}
+
+	collector.cachedDetails = &details
+	collector.cachedBlock = blockNumber
+	collector.timeBeforeDissolve = timeBeforeDissolve
+	collector.notifyThreshold = notifyThreshold
+	return &details, timeBeforeDissolve, notifyThreshold, nil
+}
+
+// Get a label for the status of a megapool validator
+func getMegapoolValidatorStatus(validator api.MegapoolValidatorDetails) string {
+	switch {
+	case validator.Dissolved:
+		return "dissolved"
+	case validator.Exited:
+		return "exited"
+	case validator.Locked:
+		return "locked"
+	case validator.Exiting:
+		return "exiting"
+	case validator.InQueue:
+		return "in_queue"
+	case validator.InPrestake:
+		return "prestaked"
+	case validator.Staked && validator.BeaconStatus.Status == beacon.ValidatorState_ActiveOngoing:
+		return "active"
+	case validator.Staked:
+		return "staked"
+	default:
+		return "initialized"
+	}
+}
+
+// Convert a wei amount to ETH, treating nil as zero
+func weiToEth(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+	return eth.WeiToEth(amount)
+}
+
+// Log error messages
+func (collector *MegapoolCollector) logError(err error) {
+	log.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
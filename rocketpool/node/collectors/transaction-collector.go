@@ -0,0 +1,102 @@
+package collectors
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+)
+
+// Represents the collector for the transaction manager's queue
+type TransactionCollector struct {
+	// The number of transactions waiting to be included in a block
+	pendingCount *prometheus.Desc
+
+	// How long each pending transaction has been waiting, in seconds
+	pendingAge *prometheus.Desc
+
+	// The number of times each pending transaction has been sped up
+	pendingReplacements *prometheus.Desc
+
+	// The current max fee of each pending transaction, in gwei
+	pendingMaxFee *prometheus.Desc
+
+	// The number of transactions that finished, by status
+	completedTotal *prometheus.Desc
+
+	// The number of replacement transactions sent to speed up stuck ones
+	replacementsTotal *prometheus.Desc
+
+	// The transaction manager
+	txm *txmanager.TxManager
+}
+
+// Create a new TransactionCollector instance
+func NewTransactionCollector(txm *txmanager.TxManager) *TransactionCollector {
+	subsystem := "transactions"
+	return &TransactionCollector{
+		pendingCount: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "pending_count"),
+			"The number of transactions waiting to be included in a block",
+			nil, nil,
+		),
+		pendingAge: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "pending_age_seconds"),
+			"How long each pending transaction has been waiting, in seconds",
+			[]string{"key", "nonce"}, nil,
+		),
+		pendingReplacements: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "pending_replacements"),
+			"The number of times each pending transaction has been sped up",
+			[]string{"key", "nonce"}, nil,
+		),
+		pendingMaxFee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "pending_max_fee_gwei"),
+			"The current max fee of each pending transaction, in gwei",
+			[]string{"key", "nonce"}, nil,
+		),
+		completedTotal: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "completed_total"),
+			"The number of transactions that finished, by status",
+			[]string{"status"}, nil,
+		),
+		replacementsTotal: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "replacements_total"),
+			"The number of replacement transactions sent to speed up stuck ones",
+			nil, nil,
+		),
+		txm: txm,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *TransactionCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.pendingCount
+	channel <- collector.pendingAge
+	channel <- collector.pendingReplacements
+	channel <- collector.pendingMaxFee
+	channel <- collector.completedTotal
+	channel <- collector.replacementsTotal
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *TransactionCollector) Collect(channel chan<- prometheus.Metric) {
+	queue := collector.txm.GetQueue()
+
+	channel <- prometheus.MustNewConstMetric(
+		collector.pendingCount, prometheus.GaugeValue, float64(len(queue.Pending)))
+	for _, tx := range queue.Pending {
+		nonce := strconv.FormatUint(tx.Nonce, 10)
+		channel <- prometheus.MustNewConstMetric(
+			collector.pendingAge, prometheus.GaugeValue, time.Since(tx.SubmittedTime).Seconds(), tx.Key, nonce)
+		channel <- prometheus.MustNewConstMetric(
+			collector.pendingReplacements, prometheus.GaugeValue, float64(tx.ReplacementCount()), tx.Key, nonce)
+		channel <- prometheus.MustNewConstMetric(
+			collector.pendingMaxFee, prometheus.GaugeValue, eth.WeiToGwei(tx.GasFeeCap), tx.Key, nonce)
+	}
+
+	channel <- prometheus.MustNewConstMetric(
+		collector.completedTotal, prometheus.CounterValue, float64(queue.ConfirmedCount), string(txmanager.TransactionStatus_Confirmed))
+	channel <- prometheus.MustNewConstMetric(
+		collector.completedTotal, prometheus.CounterValue, float64(queue.RevertedCount), string(txmanager.TransactionStatus_Reverted))
+	channel <- prometheus.MustNewConstMetric(
+		collector.completedTotal, prometheus.CounterValue, float64(queue.DroppedCount), string(txmanager.TransactionStatus_Dropped))
+	channel <- prometheus.MustNewConstMetric(
+		collector.replacementsTotal, prometheus.CounterValue, float64(queue.ReplacementCount))
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
-	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -17,6 +16,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -64,24 +64,8 @@ func newDefendChallengeExit(c *cli.Context, logger log.ColorLogger) (*defendChal
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
-	// Get the user-requested max fee
-	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
-	var maxFee *big.Int
-	if maxFeeGwei == 0 {
-		maxFee = nil
-	} else {
-		maxFee = eth.GweiToWei(maxFeeGwei)
-	}
-
-	// Get the user-requested max fee
-	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
-	var priorityFee *big.Int
-	if priorityFeeGwei == 0 {
-		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
-		priorityFee = eth.GweiToWei(2)
-	} else {
-		priorityFee = eth.GweiToWei(priorityFeeGwei)
-	}
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
 
 	// Return task
 	return &defendChallengeExit{
@@ -151,6 +135,9 @@ func (t *defendChallengeExit) run(state *state.NetworkState) error {
 		return err
 	}
 
+	validatorIds := []uint32{}
+	validatorPubkeys := []types.ValidatorPubkey{}
+	validatorsExiting := []bool{}
 	for i := uint32(0); i < uint32(validatorCount); i++ {
 		exiting := false
 		if validatorInfo[i].Locked {
@@ -161,17 +148,39 @@ func (t *defendChallengeExit) run(state *state.NetworkState) error {
 				t.log.Printlnf("The validator %d was incorrectly challenged and needs a not-exiting proof", validatorInfo[i].ValidatorId)
 			}
 
-			t.defendChallenge(t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), exiting, opts)
+			validatorIds = append(validatorIds, validatorInfo[i].ValidatorId)
+			validatorPubkeys = append(validatorPubkeys, types.ValidatorPubkey(validatorInfo[i].PubKey))
+			validatorsExiting = append(validatorsExiting, exiting)
 		}
 
 	}
+	if len(validatorIds) == 0 {
+		return nil
+	}
+
+	// Prove all of the validators from the same beacon state
+	t.log.Printlnf("[STARTED] Crafting validator proofs. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
+	proofs, proofErrs, err := services.GetValidatorProofs(t.c, validatorPubkeys)
+	if err != nil {
+		t.log.Printlnf("[ERROR] There was an error during the proof creation process: %s", err.Error())
+		return err
+	}
+	t.log.Printlnf("[FINISHED] The beacon state proofs have been successfully created.")
+
+	for i, validatorId := range validatorIds {
+		if proofErrs[i] != nil {
+			t.log.Printlnf("[ERROR] Couldn't create a proof for validator %d: %s", validatorId, proofErrs[i].Error())
+			continue
+		}
+		t.defendChallenge(t.rp, mp, validatorId, state, proofs[i], validatorsExiting[i], opts)
+	}
 
 	// Return
 	return nil
 
 }
 
-func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, exiting bool, callopts *bind.CallOpts) error {
+func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, proof megapool.ValidatorProof, exiting bool, callopts *bind.CallOpts) error {
 
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
@@ -179,15 +188,6 @@ func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp mega
 		return err
 	}
 
-	t.log.Printlnf("[STARTED] Crafting a validator proof. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
-
-	proof, err := services.GetValidatorProof(t.c, t.w, state.BeaconConfig, mp.GetAddress(), validatorPubkey)
-	if err != nil {
-		t.log.Printlnf("[ERROR] There was an error during the proof creation process: %w", err)
-		return err
-	}
-
-	t.log.Printlnf("[FINISHED] The beacon state proof has been successfully created.")
 	var gasInfo rocketpool.GasInfo
 
 	if !exiting {
@@ -207,7 +207,7 @@ func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp mega
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -3,6 +3,7 @@ package node
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -20,11 +21,16 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The prefix of the transaction manager keys for distributing minipool balances
+const distributeMinipoolTxKeyPrefix string = "distribute-minipool-"
+
 // Distribute minipools task
 type distributeMinipools struct {
 	c                   *cli.Context
@@ -41,10 +47,11 @@ type distributeMinipools struct {
 	maxFee              *big.Int
 	maxPriorityFee      *big.Int
 	gasLimit            uint64
+	txm                 *txmanager.TxManager
 }
 
 // Create distribute minipools task
-func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distributeMinipools, error) {
+func newDistributeMinipools(c *cli.Context, logger log.ColorLogger, txm *txmanager.TxManager) (*distributeMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -86,24 +93,14 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 		}
 	}
 
-	// Get the user-requested max fee
-	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
-	var maxFee *big.Int
-	if maxFeeGwei == 0 {
-		maxFee = nil
-	} else {
-		maxFee = eth.GweiToWei(maxFeeGwei)
-	}
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
 
-	// Get the user-requested max fee
-	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
-	var priorityFee *big.Int
-	if priorityFeeGwei == 0 {
-		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
-		priorityFee = eth.GweiToWei(2)
-	} else {
-		priorityFee = eth.GweiToWei(priorityFeeGwei)
-	}
+	// Alert once the distribution transaction is included in a block or dropped, rather than when it's submitted
+	txm.OnCompletion(distributeMinipoolTxKeyPrefix, func(tx txmanager.TrackedTransaction) {
+		minipoolAddress := common.HexToAddress(strings.TrimPrefix(tx.Key, distributeMinipoolTxKeyPrefix))
+		alerting.AlertMinipoolBalanceDistributed(cfg, minipoolAddress, tx.Status == txmanager.TransactionStatus_Confirmed)
+	})
 
 	// Return task
 	return &distributeMinipools{
@@ -121,6 +118,7 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 		maxFee:              maxFee,
 		maxPriorityFee:      priorityFee,
 		gasLimit:            0,
+		txm:                 txm,
 	}, nil
 
 }
@@ -162,9 +160,13 @@ func (t *distributeMinipools) run(state *state.NetworkState) error {
 	// Distribute minipools
 	successCount := 0
 	for _, mpd := range minipools {
+		if t.txm.IsPending(getDistributeMinipoolTxKey(mpd)) {
+			t.log.Printlnf("Minipool %s already has a pending distribution transaction.", mpd.MinipoolAddress.Hex())
+			continue
+		}
 		success, err := t.distributeMinipool(mpd, opts)
-		alerting.AlertMinipoolBalanceDistributed(t.cfg, mpd.MinipoolAddress, err == nil)
 		if err != nil {
+			alerting.AlertMinipoolBalanceDistributed(t.cfg, mpd.MinipoolAddress, false)
 			t.log.Println(fmt.Errorf("Could not distribute balance of minipool %s: %w", mpd.MinipoolAddress.Hex(), err))
 			return err
 		}
@@ -242,7 +244,7 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
@@ -258,21 +260,26 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	opts.GasLimit = gas.Uint64()
 
 	// Distribute minipool
-	hash, err := mpv3.DistributeBalance(true, opts)
+	tx, err := t.txm.Submit(getDistributeMinipoolTxKey(mpd), fmt.Sprintf("distribute the balance of minipool %s", mpd.MinipoolAddress.Hex()), opts, func(opts *bind.TransactOpts) error {
+		_, err := mpv3.DistributeBalance(true, opts)
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
 
-	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
-	if err != nil {
-		return false, err
-	}
+	// Print TX info; the transaction manager tracks it from here
+	api.PrintTransactionHash(t.cfg, tx.LatestHash(), &t.log)
 
 	// Log
-	t.log.Printlnf("Successfully distributed balance of minipool %s.", mp.GetAddress().Hex())
+	t.log.Printlnf("Successfully submitted the distribution of minipool %s.", mp.GetAddress().Hex())
 
 	// Return
 	return true, nil
 
 }
+
+// Get the transaction manager key for distributing a minipool's balance
+func getDistributeMinipoolTxKey(mpd *rpstate.NativeMinipoolDetails) string {
+	return distributeMinipoolTxKeyPrefix + mpd.MinipoolAddress.Hex()
+}
@@ -6,18 +6,21 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	rptypes "github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	rpsvc "github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
+	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
 )
 
 // Manage fee recipient task
@@ -29,6 +32,10 @@ type manageFeeRecipient struct {
 	rp  *rocketpool.RocketPool
 	d   *client.Client
 	bc  beacon.Client
+	km  *keymanager.Client
+
+	// The name of the node this task manages, which is empty for the primary node
+	node string
 }
 
 // Create manage fee recipient task
@@ -55,16 +62,26 @@ func newManageFeeRecipient(c *cli.Context, logger log.ColorLogger) (*manageFeeRe
 	if err != nil {
 		return nil, err
 	}
+	km, err := services.GetKeymanager(c)
+	if err != nil {
+		return nil, err
+	}
+	node, err := services.GetNodeName(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return task
 	return &manageFeeRecipient{
-		c:   c,
-		log: logger,
-		cfg: cfg,
-		w:   w,
-		rp:  rp,
-		d:   d,
-		bc:  bc,
+		c:    c,
+		log:  logger,
+		cfg:  cfg,
+		w:    w,
+		rp:   rp,
+		d:    d,
+		bc:   bc,
+		km:   km,
+		node: node,
 	}, nil
 
 }
@@ -100,6 +117,31 @@ func (m *manageFeeRecipient) run(state *state.NetworkState) error {
 		correctFeeRecipient = feeRecipientInfo.FeeDistributorAddress
 	}
 
+	// The keymanager API can only change the fee recipients of the node's own validators, since the VC may also validate for
+	// other nodes or for validators outside of Rocket Pool
+	var pubkeys []rptypes.ValidatorPubkey
+	if m.km != nil {
+		pubkeys, err = walletutils.GetNodeValidatorPubkeys(m.rp, nodeAccount.Address)
+		if err != nil {
+			return fmt.Errorf("error getting validator pubkeys: %w", err)
+		}
+	}
+
+	// The fee recipient files belong to the primary node, so additional nodes rely on the keymanager API alone
+	if m.node != "" {
+		if m.km == nil {
+			return fmt.Errorf("the keymanager API must be enabled to set the fee recipients of an additional node's validators")
+		}
+		updated, err := validator.SetFeeRecipientWithKeymanager(m.km, correctFeeRecipient, pubkeys)
+		if err != nil {
+			return fmt.Errorf("error setting fee recipients through the keymanager API: %w", err)
+		}
+		if updated > 0 {
+			m.log.Printlnf("Set the fee recipient of %d validator(s) to %s through the keymanager API.", updated, correctFeeRecipient.Hex())
+		}
+		return nil
+	}
+
 	// Check if the VC is using the correct fee recipient
 	fileExists, correctAddress, err := rpsvc.CheckFeeRecipientFile(correctFeeRecipient, m.cfg)
 	if err != nil {
@@ -111,7 +153,15 @@ func (m *manageFeeRecipient) run(state *state.NetworkState) error {
 	} else if !correctAddress {
 		m.log.Printlnf("WARNING: Fee recipient files did not contain the correct fee recipient of %s, regenerating...", correctFeeRecipient.Hex())
 	} else {
-		// Files are all correct, return.
+		// Files are all correct, so just make sure keys loaded since the VC started use the same address
+		if m.km != nil {
+			updated, err := validator.SetFeeRecipientWithKeymanager(m.km, correctFeeRecipient, pubkeys)
+			if err != nil {
+				m.log.Printlnf("WARNING: Couldn't check the fee recipients in the validator client's keymanager API: %s", err.Error())
+			} else if updated > 0 {
+				m.log.Printlnf("Set the fee recipient of %d validator(s) to %s through the keymanager API.", updated, correctFeeRecipient.Hex())
+			}
+		}
 		return nil
 	}
 
@@ -130,6 +180,16 @@ func (m *manageFeeRecipient) run(state *state.NetworkState) error {
 		return nil
 	}
 
+	// Update the running VC without a restart if possible
+	if m.km != nil {
+		_, err = validator.SetFeeRecipientWithKeymanager(m.km, correctFeeRecipient, pubkeys)
+		if err == nil {
+			m.log.Println("Fee recipient files and validator client updated successfully, you are now validating safely.")
+			return nil
+		}
+		m.log.Printlnf("WARNING: Couldn't update the fee recipients through the validator client's keymanager API: %s", err.Error())
+	}
+
 	// Restart the VC
 	m.log.Println("Fee recipient files updated successfully! Restarting validator client...")
 	err = validator.RestartValidator(m.cfg, m.bc, &m.log, m.d)
@@ -12,21 +12,18 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collectors.StateLocker) error {
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, daemons []*nodeDaemon) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return err
 	}
-	w, err := services.GetHdWallet(c)
-	if err != nil {
-		return err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return err
@@ -53,22 +50,16 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 		}
 	}
 
-	nodeAccount, err := w.GetNodeAccount()
-	if err != nil {
-		return fmt.Errorf("Error getting node account: %w", err)
-	}
-
-	// Create the collectors
+	// Create the network collectors, which use the primary node's copy of the network state
+	stateLocker := daemons[0].stateLocker
 	demandCollector := collectors.NewDemandCollector(rp, stateLocker)
 	performanceCollector := collectors.NewPerformanceCollector(rp, stateLocker)
 	supplyCollector := collectors.NewSupplyCollector(rp, stateLocker)
 	rplCollector := collectors.NewRplCollector(rp, cfg, stateLocker)
 	odaoCollector := collectors.NewOdaoCollector(rp, stateLocker)
-	nodeCollector := collectors.NewNodeCollector(rp, bc, ec, nodeAccount.Address, cfg, stateLocker)
-	trustedNodeCollector := collectors.NewTrustedNodeCollector(rp, bc, nodeAccount.Address, cfg, stateLocker)
-	beaconCollector := collectors.NewBeaconCollector(rp, bc, ec, nodeAccount.Address, stateLocker)
 	smoothingPoolCollector := collectors.NewSmoothingPoolCollector(rp, ec, stateLocker)
 	governanceCollector := collectors.NewGovernanceCollector(rp)
+	gasCollector := collectors.NewGasCollector(cfg, ec)
 
 	// Set up Prometheus
 	registry := prometheus.NewRegistry()
@@ -77,23 +68,31 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	registry.MustRegister(supplyCollector)
 	registry.MustRegister(rplCollector)
 	registry.MustRegister(odaoCollector)
-	registry.MustRegister(nodeCollector)
-	registry.MustRegister(trustedNodeCollector)
-	registry.MustRegister(beaconCollector)
 	registry.MustRegister(smoothingPoolCollector)
 	registry.MustRegister(governanceCollector)
+	registry.MustRegister(gasCollector)
 
-	// Set up snapshot checking if enabled
-	if cfg.Smartnode.GetRocketSignerRegistryAddress() != "" {
-		signallingAddress, err := reg.NodeToSigner(&bind.CallOpts{}, nodeAccount.Address)
-		if err != nil {
-			logger.Printlnf("Error getting the signalling address: %w", err)
-			// Set signallingAddress to blank address instead of erroring out of the task loop.
-			signallingAddress = common.Address{}
-		}
-		snapshotCollector := collectors.NewSnapshotCollector(rp, cfg, ec, bc, reg, nodeAccount.Address, signallingAddress)
-		registry.MustRegister(snapshotCollector)
+	// Create the collectors for each node, labelled with the node's name so their metrics can be told apart
+	for _, d := range daemons {
+		nodeRegistry := prometheus.WrapRegistererWith(prometheus.Labels{"node": services.GetNodeDisplayName(d.node)}, registry)
+		nodeRegistry.MustRegister(collectors.NewNodeCollector(rp, bc, ec, d.nodeAddress, cfg, d.stateLocker))
+		nodeRegistry.MustRegister(collectors.NewTrustedNodeCollector(rp, bc, d.nodeAddress, cfg, d.stateLocker))
+		nodeRegistry.MustRegister(collectors.NewBeaconCollector(rp, bc, ec, d.nodeAddress, d.stateLocker))
+		nodeRegistry.MustRegister(collectors.NewMegapoolCollector(rp, bc, d.nodeAddress, d.stateLocker))
+		nodeRegistry.MustRegister(collectors.NewTransactionCollector(d.txm))
+		nodeRegistry.MustRegister(tasks.NewTaskCollector(d.scheduler, tasks.GetNodeDaemonName(d.node)))
 
+		// Set up snapshot checking if enabled
+		if cfg.Smartnode.GetRocketSignerRegistryAddress() != "" {
+			signallingAddress, err := reg.NodeToSigner(&bind.CallOpts{}, d.nodeAddress)
+			if err != nil {
+				logger.Printlnf("Error getting the signalling address: %w", err)
+				// Set signallingAddress to blank address instead of erroring out of the task loop.
+				signallingAddress = common.Address{}
+			}
+			snapshotCollector := collectors.NewSnapshotCollector(rp, cfg, ec, bc, reg, d.nodeAddress, signallingAddress)
+			nodeRegistry.MustRegister(snapshotCollector)
+		}
 	}
 
 	// Start the HTTP server
@@ -1,6 +1,8 @@
 package node
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -16,7 +18,12 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/dryrun"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
@@ -28,6 +35,8 @@ import (
 var tasksInterval, _ = time.ParseDuration("5m")
 var taskCooldown, _ = time.ParseDuration("10s")
 
+const keymanagerTokenLength = 32
+
 const (
 	MaxConcurrentEth1Requests = 200
 
@@ -39,6 +48,7 @@ const (
 	ReduceBondAmountColor          = color.FgHiBlue
 	DefendPdaoPropsColor           = color.FgYellow
 	VerifyPdaoPropsColor           = color.FgYellow
+	VotePdaoPropsColor             = color.FgHiCyan
 	DistributeMinipoolsColor       = color.FgHiGreen
 	ErrorColor                     = color.FgRed
 	WarningColor                   = color.FgYellow
@@ -47,6 +57,9 @@ const (
 	StakeMegapoolValidatorColor    = color.FgHiBlue
 	NotifyValidatorExitColor       = color.FgHiYellow
 	DefendChallengeExitColor       = color.FgHiGreen
+	TxManagerColor                 = color.FgHiMagenta
+	DryRunColor                    = color.FgHiYellow
+	RemoveExitedKeysColor          = color.FgHiBlack
 )
 
 // Register node command
@@ -76,14 +89,15 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	// Configure
-	configureHTTP()
-
-	// Wait until the node wallet stored on disk is registered
-	if err := services.WaitNodeRegistered(c, true); err != nil {
+	// Create the keymanager API token if the VC needs one
+	err = deployKeymanagerToken(c)
+	if err != nil {
 		return err
 	}
 
+	// Configure
+	configureHTTP()
+
 	// Get services
 	cfg, err := services.GetConfig(c)
 	if err != nil {
@@ -93,11 +107,11 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	w, err := services.GetHdWallet(c)
+	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return err
 	}
-	bc, err := services.GetBeaconClient(c)
+	nodes, err := services.GetNodeNames(c)
 	if err != nil {
 		return err
 	}
@@ -108,218 +122,328 @@ func run(c *cli.Context) error {
 	} else {
 		fmt.Println("Starting node daemon in Docker Mode.")
 	}
-
-	nodeAccount, err := w.GetNodeAccount()
-	if err != nil {
-		return fmt.Errorf("error getting node account: %w", err)
+	if cfg.Smartnode.DryRun.Value == true {
+		fmt.Println("Dry-run mode is enabled; automatic transactions will be simulated instead of sent.")
+	}
+	if len(nodes) > 1 {
+		fmt.Printf("Managing %d nodes.\n", len(nodes))
 	}
 
 	// Initialize loggers
 	errorLog := log.NewColorLogger(ErrorColor)
 	updateLog := log.NewColorLogger(UpdateColor)
 
-	// Create the state manager
+	// Create the state manager, which every node's task loop shares
 	m := state.NewNetworkStateManager(rp, cfg.Smartnode.GetStateManagerContracts(), bc, &updateLog)
-	stateLocker := collectors.NewStateLocker()
 
-	// Initialize tasks
-	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
+	// Set up the tasks for each node; the primary node must be registered first, like it was before additional nodes were supported
+	daemons := []*nodeDaemon{}
+	for _, node := range nodes {
+		nodeCtx := services.NewNodeContext(c, node)
+		if node == "" {
+			if err := services.WaitNodeRegistered(nodeCtx, true); err != nil {
+				return err
+			}
+		} else if err := services.RequireNodeRegistered(nodeCtx); err != nil {
+			errorLog.Printlnf("Skipping node [%s] until the daemon is restarted: %s", node, err.Error())
+			continue
+		}
+		daemon, err := newNodeDaemon(nodeCtx, node)
+		if err != nil {
+			return fmt.Errorf("error setting up node [%s]: %w", services.GetNodeDisplayName(node), err)
+		}
+		daemons = append(daemons, daemon)
+	}
+
+	// Load the network state for every node at once, so task loops that wake up for the same slot share it
+	nodeAddresses := make([]common.Address, len(daemons))
+	for i, daemon := range daemons {
+		nodeAddresses[i] = daemon.nodeAddress
+	}
+	networkState := &sharedNetworkState{
+		m:             m,
+		nodeAddresses: nodeAddresses,
+	}
+
+	// Wake the task loops when the Beacon node reports a relevant event, using one stream for all of them
+	eventHub, err := services.GetBeaconEventHub(c, &updateLog)
 	if err != nil {
 		return err
 	}
-	defendChallengeExit, err := newDefendChallengeExit(c, log.NewColorLogger(DefendChallengeExitColor))
+	for _, daemon := range daemons {
+		eventHub.Listen(daemon.scheduler)
+	}
+
+	// Wait group to handle the various threads
+	wg := new(sync.WaitGroup)
+	wg.Add(len(daemons) + 1)
+
+	// Run each node's task loop
+	for _, daemon := range daemons {
+		go func() {
+			daemon.run(networkState)
+			wg.Done()
+		}()
+	}
+
+	// Run metrics loop
+	go func() {
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), daemons)
+		if err != nil {
+			errorLog.Println(err)
+		}
+		wg.Done()
+	}()
+
+	// Wait for all of the threads to stop
+	wg.Wait()
+	return nil
+
+}
+
+// The tasks and services the daemon runs for one of its nodes
+type nodeDaemon struct {
+	c           *cli.Context
+	node        string
+	cfg         *config.RocketPoolConfig
+	nodeAddress common.Address
+	errorLog    log.ColorLogger
+	updateLog   log.ColorLogger
+	stateLocker *collectors.StateLocker
+	txm         *txmanager.TxManager
+	scheduler   *tasks.Scheduler
+}
+
+// Create the tasks for a node, using a context that selects it
+func newNodeDaemon(c *cli.Context, node string) (*nodeDaemon, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	distributeMinipools, err := newDistributeMinipools(c, log.NewColorLogger(DistributeMinipoolsColor))
+	rp, err := services.GetRocketPool(c)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	daemonName := tasks.GetNodeDaemonName(node)
+
+	// Label each additional node's logs so they can be told apart from the primary node's
+	newLogger := func(attr color.Attribute) log.ColorLogger {
+		logger := log.NewColorLogger(attr)
+		if node != "" {
+			return logger.WithPrefix(fmt.Sprintf("[%s] ", node))
+		}
+		return logger
+	}
+
+	// Simulate automatic transactions instead of sending them if the user enabled dry-run mode
+	var dryRunRecorder *dryrun.Recorder
+	if cfg.Smartnode.DryRun.Value == true {
+		dryRunLog := newLogger(DryRunColor)
+		dryRunRecorder = dryrun.NewRecorder(cfg, rp.Client, daemonName, &dryRunLog)
+		if err := services.EnableDryRun(c, dryRunRecorder); err != nil {
+			return nil, err
+		}
 	}
-	stakePrelaunchMinipools, err := newStakePrelaunchMinipools(c, log.NewColorLogger(StakePrelaunchMinipoolsColor))
+
+	w, err := services.GetHdWallet(c)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	stakeMegapoolValidators, err := newStakeMegapoolValidator(c, log.NewColorLogger(StakeMegapoolValidatorColor))
+	nodeAccount, err := w.GetNodeAccount()
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("error getting node account: %w", err)
 	}
-	notifyValidatorExit, err := newNotifyValidatorExit(c, log.NewColorLogger(NotifyValidatorExitColor))
+
+	// Create the transaction manager
+	txLog := newLogger(TxManagerColor)
+	txm, err := txmanager.NewTxManager(cfg, w, rp.Client, &txLog, daemonName)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("error creating transaction manager: %w", err)
+	}
+
+	// Initialize tasks
+	manageFeeRecipient, err := newManageFeeRecipient(c, newLogger(ManageFeeRecipientColor))
+	if err != nil {
+		return nil, err
 	}
-	promoteMinipools, err := newPromoteMinipools(c, log.NewColorLogger(PromoteMinipoolsColor))
+	defendChallengeExit, err := newDefendChallengeExit(c, newLogger(DefendChallengeExitColor))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	downloadRewardsTrees, err := newDownloadRewardsTrees(c, log.NewColorLogger(DownloadRewardsTreesColor))
+	distributeMinipools, err := newDistributeMinipools(c, newLogger(DistributeMinipoolsColor), txm)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	reduceBonds, err := newReduceBonds(c, log.NewColorLogger(ReduceBondAmountColor))
+	stakePrelaunchMinipools, err := newStakePrelaunchMinipools(c, newLogger(StakePrelaunchMinipoolsColor), txm)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defendPdaoProps, err := newDefendPdaoProps(c, log.NewColorLogger(DefendPdaoPropsColor))
+	stakeMegapoolValidators, err := newStakeMegapoolValidator(c, newLogger(StakeMegapoolValidatorColor), txm)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	notifyValidatorExit, err := newNotifyValidatorExit(c, newLogger(NotifyValidatorExitColor))
+	if err != nil {
+		return nil, err
+	}
+	promoteMinipools, err := newPromoteMinipools(c, newLogger(PromoteMinipoolsColor), txm)
+	if err != nil {
+		return nil, err
+	}
+	downloadRewardsTrees, err := newDownloadRewardsTrees(c, newLogger(DownloadRewardsTreesColor))
+	if err != nil {
+		return nil, err
+	}
+	reduceBonds, err := newReduceBonds(c, newLogger(ReduceBondAmountColor))
+	if err != nil {
+		return nil, err
 	}
+	defendPdaoProps, err := newDefendPdaoProps(c, newLogger(DefendPdaoPropsColor))
+	if err != nil {
+		return nil, err
+	}
+
+	// Rewards trees and pDAO proposal challenges aren't specific to a node, so only the primary node handles them
+	isPrimary := node == ""
 	var verifyPdaoProps *verifyPdaoProps
 	// Make sure the user opted into this duty
-	verifyEnabled := cfg.Smartnode.VerifyProposals.Value.(bool)
+	verifyEnabled := isPrimary && cfg.Smartnode.VerifyProposals.Value.(bool)
 	if verifyEnabled {
-		verifyPdaoProps, err = newVerifyPdaoProps(c, log.NewColorLogger(VerifyPdaoPropsColor))
+		verifyPdaoProps, err = newVerifyPdaoProps(c, newLogger(VerifyPdaoPropsColor))
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	var prestakeMegapoolValidator *prestakeMegapoolValidator
-	prestakeMegapoolValidator, err = newPrestakeMegapoolValidator(c, log.NewColorLogger(PrestakeMegapoolValidatorColor))
-	if err != nil {
-		return err
+	// Voting is done with each node's own voting power, so it runs for every node that opted into it
+	var votePdaoProps *votePdaoProps
+	voteEnabled := cfg.Smartnode.AutoVoteProposals.Value.(bool)
+	if voteEnabled {
+		votePdaoProps, err = newVotePdaoProps(c, newLogger(VotePdaoPropsColor), txm)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Wait group to handle the various threads
-	wg := new(sync.WaitGroup)
-	wg.Add(2)
-
-	// Run task loop
-	go func() {
-		// we assume clients are synced on startup so that we don't send unnecessary alerts
-		wasExecutionClientSynced := true
-		wasBeaconClientSynced := true
-		for {
-			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
-			if err != nil {
-				wasExecutionClientSynced = false
-				errorLog.Printlnf("Execution client not synced: %s. Waiting for sync...", err.Error())
-				time.Sleep(taskCooldown)
-				continue
-			}
-
-			if !wasExecutionClientSynced {
-				updateLog.Println("Execution client is now synced.")
-				wasExecutionClientSynced = true
-				alerting.AlertExecutionClientSyncComplete(cfg)
-			}
-
-			// Check the BC status
-			err = services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
-			if err != nil {
-				// NOTE: if not synced, it returns an error - so there isn't necessarily an underlying issue
-				wasBeaconClientSynced = false
-				errorLog.Printlnf("Beacon client not synced: %s. Waiting for sync...", err.Error())
-				time.Sleep(taskCooldown)
-				continue
-			}
-
-			if !wasBeaconClientSynced {
-				updateLog.Println("Beacon client is now synced.")
-				wasBeaconClientSynced = true
-				alerting.AlertBeaconClientSyncComplete(cfg)
-			}
-
-			// Update the network state
-			state, err := updateNetworkState(m, &updateLog, nodeAccount.Address)
-			if err != nil {
-				errorLog.Println(err)
-				time.Sleep(taskCooldown)
-				continue
-			}
-			stateLocker.UpdateState(state)
-
-			// Manage the fee recipient for the node
-			if err := manageFeeRecipient.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the defend challenge exit task
-			if err := defendChallengeExit.run(state); err != nil {
-				errorLog.Println(err)
-			}
+	prestakeMegapoolValidator, err := newPrestakeMegapoolValidator(c, newLogger(PrestakeMegapoolValidatorColor))
+	if err != nil {
+		return nil, err
+	}
+	removeExitedValidatorKeys, err := newRemoveExitedValidatorKeys(c, newLogger(RemoveExitedKeysColor))
+	if err != nil {
+		return nil, err
+	}
 
-			// Run the rewards download check
-			if err := downloadRewardsTrees.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
+	// Schedule the tasks, in the order they should run when they're due at the same time
+	errorLog := newLogger(ErrorColor)
+	updateLog := newLogger(UpdateColor)
+	scheduler, err := tasks.NewScheduler(cfg, daemonName, taskCooldown, 0, &errorLog)
+	if err != nil {
+		return nil, err
+	}
+	if dryRunRecorder != nil {
+		dryRunRecorder.SetTaskSource(scheduler.CurrentTask)
+	}
+	scheduler.Add("manage-fee-recipient", tasksInterval, true, manageFeeRecipient.run)
+	scheduler.Add("defend-challenge-exit", tasksInterval, true, defendChallengeExit.run)
+	scheduler.Add("download-rewards-trees", tasksInterval, isPrimary, downloadRewardsTrees.run)
+	scheduler.Add("defend-pdao-props", tasksInterval, true, defendPdaoProps.run)
+	scheduler.Add("verify-pdao-props", tasksInterval, verifyEnabled, verifyPdaoProps.run)
+	scheduler.Add("vote-pdao-props", tasksInterval, voteEnabled, votePdaoProps.run)
+	scheduler.Add("prestake-megapool-validator", tasksInterval, true, prestakeMegapoolValidator.run)
+	scheduler.Add("stake-prelaunch-minipools", tasksInterval, true, stakePrelaunchMinipools.run)
+	scheduler.Add("stake-megapool-validators", tasksInterval, true, stakeMegapoolValidators.run)
+	scheduler.Add("notify-validator-exit", tasksInterval, true, notifyValidatorExit.run)
+	scheduler.Add("distribute-minipools", tasksInterval, true, distributeMinipools.run)
+	scheduler.Add("reduce-bonds", tasksInterval, true, reduceBonds.run)
+	scheduler.Add("promote-minipools", tasksInterval, true, promoteMinipools.run)
+	scheduler.Add("remove-exited-validator-keys", tasksInterval, true, removeExitedValidatorKeys.run)
+
+	// React to Beacon events for time-sensitive duties instead of waiting for their next interval
+	scheduler.RunOnEvents("defend-challenge-exit", beacon.EventTopic_Head)
+	scheduler.RunOnEvents("notify-validator-exit", beacon.EventTopic_Head, beacon.EventTopic_VoluntaryExit)
+
+	return &nodeDaemon{
+		c:           c,
+		node:        node,
+		cfg:         cfg,
+		nodeAddress: nodeAccount.Address,
+		errorLog:    errorLog,
+		updateLog:   updateLog,
+		stateLocker: collectors.NewStateLocker(),
+		txm:         txm,
+		scheduler:   scheduler,
+	}, nil
 
-			// Run the pDAO proposal defender
-			if err := defendPdaoProps.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
+}
 
-			// Run the pDAO proposal verifier
-			if verifyPdaoProps != nil {
-				if err := verifyPdaoProps.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-			}
+// Run the node's task loop
+func (d *nodeDaemon) run(networkState *sharedNetworkState) {
+	errorLog := &d.errorLog
+	updateLog := &d.updateLog
 
-			// Run the megapool prestake check
-			if prestakeMegapoolValidator != nil {
-				if err := prestakeMegapoolValidator.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-			}
+	// Only the primary node sends the client sync alerts so they aren't repeated for every node
+	sendAlerts := d.node == ""
 
-			// Run the minipool stake check
-			if err := stakePrelaunchMinipools.run(state); err != nil {
-				errorLog.Println(err)
-			}
+	// we assume clients are synced on startup so that we don't send unnecessary alerts
+	wasExecutionClientSynced := true
+	wasBeaconClientSynced := true
+	for {
+		// Check the EC status
+		err := services.WaitEthClientSynced(d.c, false) // Force refresh the primary / fallback EC status
+		if err != nil {
+			wasExecutionClientSynced = false
+			errorLog.Printlnf("Execution client not synced: %s. Waiting for sync...", err.Error())
 			time.Sleep(taskCooldown)
+			continue
+		}
 
-			// Run the megapool stake check
-			if err := stakeMegapoolValidators.run(state); err != nil {
-				errorLog.Println(err)
+		if !wasExecutionClientSynced {
+			updateLog.Println("Execution client is now synced.")
+			wasExecutionClientSynced = true
+			if sendAlerts {
+				alerting.AlertExecutionClientSyncComplete(d.cfg)
 			}
-			time.Sleep(taskCooldown)
+		}
 
-			// Run the megapool notify validator exit check
-			if err := notifyValidatorExit.run(state); err != nil {
-				errorLog.Println(err)
-			}
+		// Check the BC status
+		err = services.WaitBeaconClientSynced(d.c, false) // Force refresh the primary / fallback BC status
+		if err != nil {
+			// NOTE: if not synced, it returns an error - so there isn't necessarily an underlying issue
+			wasBeaconClientSynced = false
+			errorLog.Printlnf("Beacon client not synced: %s. Waiting for sync...", err.Error())
 			time.Sleep(taskCooldown)
+			continue
+		}
 
-			// Run the balance distribution check
-			if err := distributeMinipools.run(state); err != nil {
-				errorLog.Println(err)
+		if !wasBeaconClientSynced {
+			updateLog.Println("Beacon client is now synced.")
+			wasBeaconClientSynced = true
+			if sendAlerts {
+				alerting.AlertBeaconClientSyncComplete(d.cfg)
 			}
-			time.Sleep(taskCooldown)
+		}
 
-			// Run the reduce bond check
-			if err := reduceBonds.run(state); err != nil {
-				errorLog.Println(err)
-			}
+		// Update the network state
+		state, err := networkState.get()
+		if err != nil {
+			errorLog.Println(err)
 			time.Sleep(taskCooldown)
-
-			// Run the minipool promotion check
-			if err := promoteMinipools.run(state); err != nil {
-				errorLog.Println(err)
-			}
-
-			time.Sleep(tasksInterval)
+			continue
 		}
-		wg.Done()
-	}()
+		d.stateLocker.UpdateState(state)
 
-	// Run metrics loop
-	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), stateLocker)
-		if err != nil {
+		// Check on the pending transactions
+		if err := d.txm.Update(); err != nil {
 			errorLog.Println(err)
 		}
-		wg.Done()
-	}()
 
-	// Wait for both threads to stop
-	wg.Wait()
-	return nil
+		// Run the tasks that are due and wait for the next one
+		d.scheduler.RunDueTasks(state)
+		d.scheduler.WaitForNextRun()
+	}
 
 }
 
@@ -372,6 +496,45 @@ func deployDefaultFeeRecipientFile(c *cli.Context) error {
 
 }
 
+// Create the bearer token for the Validator Client's keymanager API if it's enabled and doesn't have one yet
+func deployKeymanagerToken(c *cli.Context) error {
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	if cfg.IsNativeMode || !cfg.Smartnode.UseKeymanagerApi.Value.(bool) {
+		return nil
+	}
+
+	tokenPath := cfg.Smartnode.GetKeymanagerTokenPath()
+	_, err = os.Stat(tokenPath)
+	if !os.IsNotExist(err) {
+		if err != nil {
+			return fmt.Errorf("Error checking keymanager API token status: %w", err)
+		}
+		return nil
+	}
+
+	// Make sure the validators dir is created
+	err = os.MkdirAll(filepath.Dir(tokenPath), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create validators directory: %w", err)
+	}
+
+	// Create the token
+	token := make([]byte, keymanagerTokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return fmt.Errorf("could not generate keymanager API token: %w", err)
+	}
+	err = os.WriteFile(tokenPath, []byte(hex.EncodeToString(token)), 0600)
+	if err != nil {
+		return fmt.Errorf("could not write keymanager API token to %s: %w", tokenPath, err)
+	}
+	return nil
+
+}
+
 // Remove the old fee recipient files that were created in v1.5.0
 func removeLegacyFeeRecipientFiles(c *cli.Context) error {
 
@@ -401,14 +564,34 @@ func removeLegacyFeeRecipientFiles(c *cli.Context) error {
 
 }
 
-// Update the latest network state at each cycle
-func updateNetworkState(m *state.NetworkStateManager, log *log.ColorLogger, nodeAddress common.Address) (*state.NetworkState, error) {
-	// Get the state of the network
-	state, err := m.GetHeadStateForNode(nodeAddress)
+// The network state of all of the daemon's nodes at the latest slot.
+// The tasks only read the state, so every node's task loop can use the same one.
+type sharedNetworkState struct {
+	m             *state.NetworkStateManager
+	nodeAddresses []common.Address
+	state         *state.NetworkState
+	lock          sync.Mutex
+}
+
+// Get the network state at the head slot, only loading it if no other task loop has yet
+func (s *sharedNetworkState) get() (*state.NetworkState, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	slot, err := s.m.GetHeadSlot()
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
+	}
+	if s.state != nil && s.state.BeaconSlotNumber == slot {
+		return s.state, nil
+	}
+
+	networkState, err := s.m.GetStateForNodes(slot, s.nodeAddresses)
 	if err != nil {
 		return nil, fmt.Errorf("error updating network state: %w", err)
 	}
-	return state, nil
+	s.state = networkState
+	return networkState, nil
 }
 
 // Checks if the user-inputted priorityFee is greater than the oracle based maxFee
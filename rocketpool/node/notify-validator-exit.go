@@ -8,7 +8,6 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
-	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -16,6 +15,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -65,24 +65,8 @@ func newNotifyValidatorExit(c *cli.Context, logger log.ColorLogger) (*notifyVali
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
-	// Get the user-requested max fee
-	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
-	var maxFee *big.Int
-	if maxFeeGwei == 0 {
-		maxFee = nil
-	} else {
-		maxFee = eth.GweiToWei(maxFeeGwei)
-	}
-
-	// Get the user-requested max fee
-	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
-	var priorityFee *big.Int
-	if priorityFeeGwei == 0 {
-		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
-		priorityFee = eth.GweiToWei(2)
-	} else {
-		priorityFee = eth.GweiToWei(priorityFeeGwei)
-	}
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
 
 	// Return task
 	return &notifyValidatorExit{
@@ -152,14 +136,35 @@ func (t *notifyValidatorExit) run(state *state.NetworkState) error {
 		return err
 	}
 
+	validatorIds := []uint32{}
+	validatorPubkeys := []types.ValidatorPubkey{}
 	for i := uint32(0); i < uint32(validatorCount); i++ {
 		if validatorInfo[i].Activated && validatorInfo[i].WithdrawableEpoch < FarFutureEpoch && validatorInfo[i].Staked && !validatorInfo[i].Exited && !validatorInfo[i].Exiting {
 			// Log
 			t.log.Printlnf("The validator ID %d needs an exit proof", validatorInfo[i].ValidatorId)
+			validatorIds = append(validatorIds, validatorInfo[i].ValidatorId)
+			validatorPubkeys = append(validatorPubkeys, types.ValidatorPubkey(validatorInfo[i].PubKey))
+		}
+	}
+	if len(validatorIds) == 0 {
+		return nil
+	}
 
-			// Call Stake
-			t.createExitProof(t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), opts)
+	// Prove all of the validators from the same beacon state
+	t.log.Printlnf("[STARTED] Crafting exit proofs. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
+	proofs, proofErrs, err := services.GetValidatorProofs(t.c, validatorPubkeys)
+	if err != nil {
+		t.log.Printlnf("[ERROR] There was an error during the proof creation process: %s", err.Error())
+		return err
+	}
+	t.log.Printlnf("[FINISHED] The validator exit proofs have been successfully created.")
+
+	for i, validatorId := range validatorIds {
+		if proofErrs[i] != nil {
+			t.log.Printlnf("[ERROR] Couldn't create a proof for validator %d: %s", validatorId, proofErrs[i].Error())
+			continue
 		}
+		t.notifyExit(t.rp, mp, validatorId, state, proofs[i], opts)
 	}
 
 	// Return
@@ -167,7 +172,7 @@ func (t *notifyValidatorExit) run(state *state.NetworkState) error {
 
 }
 
-func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, callopts *bind.CallOpts) error {
+func (t *notifyValidatorExit) notifyExit(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, proof megapool.ValidatorProof, callopts *bind.CallOpts) error {
 
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
@@ -175,16 +180,6 @@ func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, mp mega
 		return err
 	}
 
-	t.log.Printlnf("[STARTED] Crafting an exit proof. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
-
-	proof, err := services.GetValidatorProof(t.c, t.w, state.BeaconConfig, mp.GetAddress(), validatorPubkey)
-	if err != nil {
-		t.log.Printlnf("[ERROR] There was an error during the proof creation process: %w", err)
-		return err
-	}
-
-	t.log.Printlnf("[FINISHED] The validator exit proof has been successfully created.")
-
 	// Get the gas limit
 	gasInfo, err := megapool.EstimateNotifyExitGas(rp, mp.GetAddress(), validatorId, proof, opts)
 	if err != nil {
@@ -194,7 +189,7 @@ func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, mp mega
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
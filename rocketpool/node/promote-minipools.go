@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/docker/docker/client"
@@ -12,7 +13,6 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/minipool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
-	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	rpstate "github.com/rocket-pool/smartnode/bindings/utils/state"
 	"github.com/urfave/cli"
 
@@ -21,11 +21,16 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The prefix of the transaction manager keys for promoting minipools
+const promoteMinipoolTxKeyPrefix string = "promote-minipool-"
+
 // Promote minipools task
 type promoteMinipools struct {
 	c              *cli.Context
@@ -38,10 +43,11 @@ type promoteMinipools struct {
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+	txm            *txmanager.TxManager
 }
 
 // Create promote minipools task
-func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipools, error) {
+func newPromoteMinipools(c *cli.Context, logger log.ColorLogger, txm *txmanager.TxManager) (*promoteMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -63,24 +69,14 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
-	// Get the user-requested max fee
-	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
-	var maxFee *big.Int
-	if maxFeeGwei == 0 {
-		maxFee = nil
-	} else {
-		maxFee = eth.GweiToWei(maxFeeGwei)
-	}
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
 
-	// Get the user-requested max fee
-	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
-	var priorityFee *big.Int
-	if priorityFeeGwei == 0 {
-		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
-		priorityFee = eth.GweiToWei(2)
-	} else {
-		priorityFee = eth.GweiToWei(priorityFeeGwei)
-	}
+	// Alert once the promotion transaction is included in a block or dropped, rather than when it's submitted
+	txm.OnCompletion(promoteMinipoolTxKeyPrefix, func(tx txmanager.TrackedTransaction) {
+		minipoolAddress := common.HexToAddress(strings.TrimPrefix(tx.Key, promoteMinipoolTxKeyPrefix))
+		alerting.AlertMinipoolPromoted(cfg, minipoolAddress, tx.Status == txmanager.TransactionStatus_Confirmed)
+	})
 
 	// Return task
 	return &promoteMinipools{
@@ -94,6 +90,7 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
+		txm:            txm,
 	}, nil
 
 }
@@ -129,9 +126,13 @@ func (t *promoteMinipools) run(state *state.NetworkState) error {
 
 	// Promote minipools
 	for _, mpd := range minipools {
+		if t.txm.IsPending(getPromoteMinipoolTxKey(mpd)) {
+			t.log.Printlnf("Minipool %s already has a pending promotion transaction.", mpd.MinipoolAddress.Hex())
+			continue
+		}
 		_, err := t.promoteMinipool(mpd, opts)
-		alerting.AlertMinipoolPromoted(t.cfg, mpd.MinipoolAddress, err == nil)
 		if err != nil {
+			alerting.AlertMinipoolPromoted(t.cfg, mpd.MinipoolAddress, false)
 			t.log.Println(fmt.Errorf("Could not promote minipool %s: %w", mpd.MinipoolAddress.Hex(), err))
 			return err
 		}
@@ -213,7 +214,7 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
@@ -240,21 +241,26 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	opts.GasLimit = gas.Uint64()
 
 	// Promote minipool
-	hash, err := mpv3.Promote(opts)
+	tx, err := t.txm.Submit(getPromoteMinipoolTxKey(mpd), fmt.Sprintf("promote minipool %s", mpd.MinipoolAddress.Hex()), opts, func(opts *bind.TransactOpts) error {
+		_, err := mpv3.Promote(opts)
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
 
-	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
-	if err != nil {
-		return false, err
-	}
+	// Print TX info; the transaction manager tracks it from here
+	api.PrintTransactionHash(t.cfg, tx.LatestHash(), &t.log)
 
 	// Log
-	t.log.Printlnf("Successfully promoted minipool %s.", mpd.MinipoolAddress.Hex())
+	t.log.Printlnf("Successfully submitted the promotion of minipool %s.", mpd.MinipoolAddress.Hex())
 
 	// Return
 	return true, nil
 
 }
+
+// Get the transaction manager key for promoting a minipool
+func getPromoteMinipoolTxKey(mpd *rpstate.NativeMinipoolDetails) string {
+	return promoteMinipoolTxKeyPrefix + mpd.MinipoolAddress.Hex()
+}
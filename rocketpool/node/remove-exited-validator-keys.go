@@ -0,0 +1,89 @@
+package node
+
+import (
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Remove exited validator keys task
+type removeExitedValidatorKeys struct {
+	log log.ColorLogger
+	w   wallet.Wallet
+	km  *keymanager.Client
+}
+
+// Create remove exited validator keys task
+func newRemoveExitedValidatorKeys(c *cli.Context, logger log.ColorLogger) (*removeExitedValidatorKeys, error) {
+
+	// Get services
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	km, err := services.GetKeymanager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &removeExitedValidatorKeys{
+		log: logger,
+		w:   w,
+		km:  km,
+	}, nil
+
+}
+
+// Remove the keys of the node's validators that have exited from the VC, so it stops trying to sign for them.
+// Validators keep their keys until they reach their exit epoch, since they still have duties while they wait in the exit queue.
+func (t *removeExitedValidatorKeys) run(state *state.NetworkState) error {
+	if t.km == nil {
+		return tasks.ErrNotActive
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the node's validators that have exited
+	currentEpoch := state.BeaconSlotNumber / state.BeaconConfig.SlotsPerEpoch
+	exited := []types.ValidatorPubkey{}
+	for _, mpd := range state.MinipoolDetailsByNode[nodeAccount.Address] {
+		status := state.MinipoolValidatorDetails[mpd.Pubkey]
+		if status.Exists && status.ExitEpoch <= currentEpoch {
+			exited = append(exited, mpd.Pubkey)
+		}
+	}
+	nodeDetails, exists := state.NodeDetailsByAddress[nodeAccount.Address]
+	if exists && nodeDetails.MegapoolDeployed {
+		for _, pubkey := range state.MegapoolToPubkeysMap[nodeDetails.MegapoolAddress] {
+			status := state.MegapoolValidatorDetails[pubkey]
+			if status.Exists && status.ExitEpoch <= currentEpoch {
+				exited = append(exited, pubkey)
+			}
+		}
+	}
+	if len(exited) == 0 {
+		return nil
+	}
+
+	// Remove the ones the VC still has
+	_, removed, err := t.km.DeleteValidators(exited)
+	if err != nil {
+		return err
+	}
+	if removed > 0 {
+		t.log.Printlnf("Removed the keys of %d exited validator(s) from the Validator Client.", removed)
+	}
+	return nil
+
+}
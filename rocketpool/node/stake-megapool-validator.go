@@ -1,14 +1,15 @@
 package node
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
-	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -16,6 +17,8 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -34,10 +37,11 @@ type stakeMegapoolValidator struct {
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+	txm            *txmanager.TxManager
 }
 
 // Create stake megapool validator task
-func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*stakeMegapoolValidator, error) {
+func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger, txm *txmanager.TxManager) (*stakeMegapoolValidator, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -63,24 +67,8 @@ func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*stakeMe
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
-	// Get the user-requested max fee
-	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
-	var maxFee *big.Int
-	if maxFeeGwei == 0 {
-		maxFee = nil
-	} else {
-		maxFee = eth.GweiToWei(maxFeeGwei)
-	}
-
-	// Get the user-requested max fee
-	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
-	var priorityFee *big.Int
-	if priorityFeeGwei == 0 {
-		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
-		priorityFee = eth.GweiToWei(2)
-	} else {
-		priorityFee = eth.GweiToWei(priorityFeeGwei)
-	}
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
 
 	// Return task
 	return &stakeMegapoolValidator{
@@ -95,6 +83,7 @@ func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*stakeMe
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
+		txm:            txm,
 	}, nil
 
 }
@@ -150,14 +139,41 @@ func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
 		return err
 	}
 
+	validatorIds := []uint32{}
+	validatorPubkeys := []types.ValidatorPubkey{}
 	for i := uint32(0); i < uint32(validatorCount); i++ {
 		if validatorInfo[i].InPrestake && validatorInfo[i].BeaconStatus.Index != "" {
+			if t.txm.IsPending(getStakeMegapoolValidatorTxKey(megapoolAddress, validatorInfo[i].ValidatorId)) {
+				t.log.Printlnf("The validator %d already has a pending stake transaction.", validatorInfo[i].ValidatorId)
+				continue
+			}
+
 			// Log
 			t.log.Printlnf("The validator %d needs to be staked", validatorInfo[i].ValidatorId)
+			validatorIds = append(validatorIds, validatorInfo[i].ValidatorId)
+			validatorPubkeys = append(validatorPubkeys, types.ValidatorPubkey(validatorInfo[i].PubKey))
+		}
+	}
+	if len(validatorIds) == 0 {
+		return nil
+	}
+
+	// Prove all of the validators from the same beacon state
+	t.log.Printlnf("[STARTED] Crafting proofs that the correct credentials were used on the first beacon chain deposits. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
+	proofs, proofErrs, err := services.GetValidatorProofs(t.c, validatorPubkeys)
+	if err != nil {
+		t.log.Printlnf("[ERROR] There was an error during the proof creation process: %s", err.Error())
+		return err
+	}
+	t.log.Printlnf("[FINISHED] The beacon state proofs have been successfully created.")
 
-			// Call Stake
-			t.stakeValidator(t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), opts)
+	// Call Stake
+	for i, validatorId := range validatorIds {
+		if proofErrs[i] != nil {
+			t.log.Printlnf("[ERROR] Couldn't create a proof for validator %d: %s", validatorId, proofErrs[i].Error())
+			continue
 		}
+		t.stakeValidator(t.rp, mp, validatorId, state, proofs[i], opts)
 	}
 
 	// Return
@@ -165,7 +181,7 @@ func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
 
 }
 
-func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, callopts *bind.CallOpts) error {
+func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, proof megapool.ValidatorProof, callopts *bind.CallOpts) error {
 
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
@@ -173,16 +189,6 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp me
 		return err
 	}
 
-	t.log.Printlnf("[STARTED] Crafting a proof that the correct credentials were used on the first beacon chain deposit. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
-
-	proof, err := services.GetValidatorProof(t.c, t.w, state.BeaconConfig, mp.GetAddress(), validatorPubkey)
-	if err != nil {
-		t.log.Printlnf("[ERROR] There was an error during the proof creation process: %w", err)
-		return err
-	}
-
-	t.log.Printlnf("[FINISHED] The beacon state proof has been successfully created.")
-
 	// Get the gas limit
 	gasInfo, err := megapool.EstimateStakeGas(rp, mp.GetAddress(), validatorId, proof, opts)
 	if err != nil {
@@ -192,7 +198,7 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp me
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -208,20 +214,25 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp me
 	opts.GasLimit = gas.Uint64()
 
 	// Call stake
-	tx, err := megapool.Stake(rp, mp.GetAddress(), validatorId, proof, opts)
-	if err != nil {
+	tx, err := t.txm.Submit(getStakeMegapoolValidatorTxKey(mp.GetAddress(), validatorId), fmt.Sprintf("stake megapool validator %d", validatorId), opts, func(opts *bind.TransactOpts) error {
+		_, err := megapool.Stake(rp, mp.GetAddress(), validatorId, proof, opts)
 		return err
-	}
-
-	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	})
 	if err != nil {
 		return err
 	}
 
+	// Print TX info; the transaction manager tracks it from here
+	api.PrintTransactionHash(t.cfg, tx.LatestHash(), &t.log)
+
 	// Log
-	t.log.Printlnf("Successfully staked validator %d.", validatorId)
+	t.log.Printlnf("Successfully submitted the stake of validator %d.", validatorId)
 
 	// Return
 	return nil
 }
+
+// Get the transaction manager key for staking a megapool validator
+func getStakeMegapoolValidatorTxKey(megapoolAddress common.Address, validatorId uint32) string {
+	return fmt.Sprintf("stake-megapool-validator-%s-%d", megapoolAddress.Hex(), validatorId)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/docker/docker/client"
@@ -12,7 +13,6 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/minipool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
-	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	rpstate "github.com/rocket-pool/smartnode/bindings/utils/state"
 	"github.com/urfave/cli"
 
@@ -21,13 +21,21 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/services/web3signer"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
+// The prefix of the transaction manager keys for staking minipools
+const stakeMinipoolTxKeyPrefix string = "stake-minipool-"
+
 // Stake prelaunch minipools task
 type stakePrelaunchMinipools struct {
 	c              *cli.Context
@@ -37,14 +45,17 @@ type stakePrelaunchMinipools struct {
 	rp             *rocketpool.RocketPool
 	bc             beacon.Client
 	d              *client.Client
+	km             *keymanager.Client
+	signer         *web3signer.Client
 	gasThreshold   float64
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+	txm            *txmanager.TxManager
 }
 
 // Create stake prelaunch minipools task
-func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakePrelaunchMinipools, error) {
+func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger, txm *txmanager.TxManager) (*stakePrelaunchMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -67,27 +78,25 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 	if err != nil {
 		return nil, err
 	}
+	km, err := services.GetKeymanager(c)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := services.GetRemoteSigner(c)
+	if err != nil {
+		return nil, err
+	}
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
-	// Get the user-requested max fee
-	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
-	var maxFee *big.Int
-	if maxFeeGwei == 0 {
-		maxFee = nil
-	} else {
-		maxFee = eth.GweiToWei(maxFeeGwei)
-	}
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
 
-	// Get the user-requested priority fee
-	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
-	var priorityFee *big.Int
-	if priorityFeeGwei == 0 {
-		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
-		priorityFee = eth.GweiToWei(2)
-	} else {
-		priorityFee = eth.GweiToWei(priorityFeeGwei)
-	}
+	// Alert once the stake transaction is included in a block or dropped, rather than when it's submitted
+	txm.OnCompletion(stakeMinipoolTxKeyPrefix, func(tx txmanager.TrackedTransaction) {
+		minipoolAddress := common.HexToAddress(strings.TrimPrefix(tx.Key, stakeMinipoolTxKeyPrefix))
+		alerting.AlertMinipoolStaked(cfg, minipoolAddress, tx.Status == txmanager.TransactionStatus_Confirmed)
+	})
 
 	// Return task
 	return &stakePrelaunchMinipools{
@@ -98,10 +107,13 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 		rp:             rp,
 		bc:             bc,
 		d:              d,
+		km:             km,
+		signer:         signer,
 		gasThreshold:   gasThreshold,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
+		txm:            txm,
 	}, nil
 
 }
@@ -141,21 +153,25 @@ func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
 	t.log.Printlnf("%d minipool(s) are ready for staking...", len(minipools))
 
 	// Stake minipools
-	successCount := 0
+	stakedPubkeys := []rptypes.ValidatorPubkey{}
 	for _, mpd := range minipools {
+		if t.txm.IsPending(getStakeMinipoolTxKey(mpd)) {
+			t.log.Printlnf("Minipool %s already has a pending stake transaction.", mpd.MinipoolAddress.Hex())
+			continue
+		}
 		success, err := t.stakeMinipool(mpd, state, opts)
-		alerting.AlertMinipoolStaked(t.cfg, mpd.MinipoolAddress, success && err == nil)
 		if err != nil {
+			alerting.AlertMinipoolStaked(t.cfg, mpd.MinipoolAddress, false)
 			t.log.Println(fmt.Errorf("Could not stake minipool %s: %w", mpd.MinipoolAddress.Hex(), err))
 			return err
 		}
 		if success {
-			successCount++
+			stakedPubkeys = append(stakedPubkeys, mpd.Pubkey)
 		}
 	}
 
-	// Restart validator process if any minipools were staked successfully
-	if successCount > 0 {
+	// Restart validator process if any minipools were staked successfully and the VC hasn't loaded their keys already
+	if len(stakedPubkeys) > 0 && !t.areKeysLoaded(stakedPubkeys) {
 		if err := validator.RestartValidator(t.cfg, t.bc, &t.log, t.d); err != nil {
 			return err
 		}
@@ -166,6 +182,20 @@ func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
 
 }
 
+// Check if the running VC loaded all of the provided keys through its keymanager API when they were created
+func (t *stakePrelaunchMinipools) areKeysLoaded(pubkeys []rptypes.ValidatorPubkey) bool {
+	if t.km == nil {
+		return false
+	}
+	for _, pubkey := range pubkeys {
+		loaded, err := t.km.IsValidatorLoaded(pubkey)
+		if err != nil || !loaded {
+			return false
+		}
+	}
+	return true
+}
+
 // Get prelaunch minipools
 func (t *stakePrelaunchMinipools) getPrelaunchMinipools(nodeAddress common.Address, state *state.NetworkState, opts *bind.CallOpts) ([]*rpstate.NativeMinipoolDetails, error) {
 
@@ -216,12 +246,8 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	// Get minipool withdrawal credentials
 	withdrawalCredentials := mpd.WithdrawalCredentials
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey := mpd.Pubkey
-	validatorKey, err := t.w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return false, err
-	}
 
 	// Get the minipool type
 	depositType := mpd.DepositType
@@ -236,10 +262,23 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 		return false, fmt.Errorf("error staking minipool %s: unknown deposit type %d", mpd.MinipoolAddress.Hex(), depositType)
 	}
 
-	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, state.BeaconConfig, depositAmount)
-	if err != nil {
-		return false, err
+	// Get validator deposit data, signed by the remote signer if the validator keys are kept in one
+	var depositData generic.DepositData
+	var depositDataRoot common.Hash
+	if t.signer != nil {
+		depositData, depositDataRoot, err = validator.GetRemoteSignedDepositData(t.signer, validatorPubkey, withdrawalCredentials, state.BeaconConfig, depositAmount)
+		if err != nil {
+			return false, err
+		}
+	} else {
+		validatorKey, err := t.w.GetValidatorKeyByPubkey(validatorPubkey)
+		if err != nil {
+			return false, err
+		}
+		depositData, depositDataRoot, err = validator.GetDepositData(validatorKey, withdrawalCredentials, state.BeaconConfig, depositAmount)
+		if err != nil {
+			return false, err
+		}
 	}
 
 	// Get transactor
@@ -264,7 +303,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
@@ -291,25 +330,30 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	opts.GasLimit = gas.Uint64()
 
 	// Stake minipool
-	hash, err := mp.Stake(
-		signature,
-		depositDataRoot,
-		opts,
-	)
+	tx, err := t.txm.Submit(getStakeMinipoolTxKey(mpd), fmt.Sprintf("stake minipool %s", mpd.MinipoolAddress.Hex()), opts, func(opts *bind.TransactOpts) error {
+		_, err := mp.Stake(
+			signature,
+			depositDataRoot,
+			opts,
+		)
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
 
-	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
-	if err != nil {
-		return false, err
-	}
+	// Print TX info; the transaction manager tracks it from here
+	api.PrintTransactionHash(t.cfg, tx.LatestHash(), &t.log)
 
 	// Log
-	t.log.Printlnf("Successfully staked minipool %s.", mp.GetAddress().Hex())
+	t.log.Printlnf("Successfully submitted the stake of minipool %s.", mp.GetAddress().Hex())
 
 	// Return
 	return true, nil
 
 }
+
+// Get the transaction manager key for staking a minipool
+func getStakeMinipoolTxKey(mpd *rpstate.NativeMinipoolDetails) string {
+	return stakeMinipoolTxKeyPrefix + mpd.MinipoolAddress.Hex()
+}
@@ -10,13 +10,13 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
-	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -50,6 +50,7 @@ type verifyPdaoProps struct {
 	propMgr             *proposals.ProposalManager
 	lastScannedBlock    *big.Int
 	validPropCache      map[uint64]bool
+	loggedPropCache     map[uint64]bool
 	rootSubmissionCache map[uint64]map[uint64]*protocol.RootSubmitted
 
 	// Smartnode parameters
@@ -77,24 +78,8 @@ func newVerifyPdaoProps(c *cli.Context, logger log.ColorLogger) (*verifyPdaoProp
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
-	// Get the user-requested max fee
-	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
-	var maxFee *big.Int
-	if maxFeeGwei == 0 {
-		maxFee = nil
-	} else {
-		maxFee = eth.GweiToWei(maxFeeGwei)
-	}
-
-	// Get the user-requested priority fee
-	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
-	var priorityFee *big.Int
-	if priorityFeeGwei == 0 {
-		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
-		priorityFee = eth.GweiToWei(2)
-	} else {
-		priorityFee = eth.GweiToWei(priorityFeeGwei)
-	}
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
 
 	// Get the event interval size
 	intervalSize := big.NewInt(int64(cfg.Geth.EventLogInterval))
@@ -124,6 +109,7 @@ func newVerifyPdaoProps(c *cli.Context, logger log.ColorLogger) (*verifyPdaoProp
 		propMgr:             propMgr,
 		lastScannedBlock:    nil,
 		validPropCache:      map[uint64]bool{},
+		loggedPropCache:     map[uint64]bool{},
 		rootSubmissionCache: map[uint64]map[uint64]*protocol.RootSubmitted{},
 
 		intervalSize: intervalSize,
@@ -176,6 +162,7 @@ func (t *verifyPdaoProps) getChallengesandDefeats(state *state.NetworkState, opt
 		} else {
 			// Remove old proposals from the caches once they're out of scope
 			delete(t.validPropCache, prop.ID)
+			delete(t.loggedPropCache, prop.ID)
 			delete(t.rootSubmissionCache, prop.ID)
 		}
 	}
@@ -186,6 +173,12 @@ func (t *verifyPdaoProps) getChallengesandDefeats(state *state.NetworkState, opt
 	// Check which ones have a root hash mismatch and need to be processed further
 	mismatchingProps := []protocol.ProtocolDaoProposalDetails{}
 	for _, prop := range eligibleProps {
+		// Log what each new proposal would change
+		if !t.loggedPropCache[prop.ID] {
+			t.logProposalChanges(prop, opts)
+			t.loggedPropCache[prop.ID] = true
+		}
+
 		if t.validPropCache[prop.ID] {
 			// Ignore proposals that have already been cleared
 			continue
@@ -349,6 +342,19 @@ func (t *verifyPdaoProps) getChallengeOrDefeatForProposal(prop protocol.Protocol
 	}
 }
 
+// Log the changes a proposal would make if it passes
+func (t *verifyPdaoProps) logProposalChanges(prop protocol.ProtocolDaoProposalDetails, opts *bind.CallOpts) {
+	decoded, err := proposals.DecodeProposalPayload(t.rp, proposals.ProtocolDaoProposalsContractName, prop.Payload, opts)
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't decode the payload of proposal %d (%s): %s", prop.ID, prop.PayloadStr, err.Error())
+		return
+	}
+	t.log.Printlnf("Proposal %d ('%s') by %s would make the following changes:", prop.ID, prop.Message, prop.ProposerAddress.Hex())
+	for _, change := range proposals.FormatProposalPayload(decoded) {
+		t.log.Printlnf("\t%s", change)
+	}
+}
+
 // Submit a challenge against a proposal
 func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
 	propID := challenge.proposalID
@@ -371,7 +377,7 @@ func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -427,7 +433,7 @@ func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
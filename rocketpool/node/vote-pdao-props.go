@@ -0,0 +1,344 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/network"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+// Votes are sent regardless of the gas threshold once the voting phase is this close to ending
+const votePdaoPropsDeadlineBuffer = 24 * time.Hour
+
+type votePdaoProps struct {
+	c              *cli.Context
+	log            *log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              wallet.Wallet
+	rp             *rocketpool.RocketPool
+	bc             beacon.Client
+	txm            *txmanager.TxManager
+	gasThreshold   float64
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+	gasLimit       uint64
+	nodeAddress    common.Address
+	propMgr        *proposals.ProposalManager
+	policyPath     string
+
+	// The phase each proposal was last handled in, so it's only decided on once per phase
+	handledPropCache map[uint64]types.ProtocolDaoProposalState
+}
+
+func newVotePdaoProps(c *cli.Context, logger log.ColorLogger, txm *txmanager.TxManager) (*votePdaoProps, error) {
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetHdWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+
+	// Get the user-requested gas settings
+	maxFee, priorityFee := tasks.GetGasSettings(cfg, &logger)
+
+	// Get the node account
+	account, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, fmt.Errorf("error getting node account: %w", err)
+	}
+
+	// Make a proposal manager
+	propMgr, err := proposals.NewProposalManager(&logger, cfg, rp, bc)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proposal manager: %w", err)
+	}
+
+	// Return task
+	return &votePdaoProps{
+		c:                c,
+		log:              &logger,
+		cfg:              cfg,
+		w:                w,
+		rp:               rp,
+		bc:               bc,
+		txm:              txm,
+		gasThreshold:     gasThreshold,
+		maxFee:           maxFee,
+		maxPriorityFee:   priorityFee,
+		gasLimit:         0,
+		nodeAddress:      account.Address,
+		propMgr:          propMgr,
+		policyPath:       cfg.Smartnode.GetVotingPolicyPath(),
+		handledPropCache: map[uint64]types.ProtocolDaoProposalState{},
+	}, nil
+}
+
+// Vote on pDAO proposals with the node's voting policy
+func (t *votePdaoProps) run(state *state.NetworkState) error {
+	// Log
+	t.log.Println("Checking for Protocol DAO proposals to vote on...")
+
+	// Load the policy every time so operators can change it without restarting the daemon
+	policy, err := proposals.LoadVotingPolicy(t.policyPath)
+	if err != nil {
+		return err
+	}
+
+	// Get the latest state
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
+	}
+
+	for i := range state.ProtocolDaoProposalDetails {
+		prop := &state.ProtocolDaoProposalDetails[i]
+		if prop.State != types.ProtocolDaoProposalState_ActivePhase1 && prop.State != types.ProtocolDaoProposalState_ActivePhase2 {
+			continue
+		}
+		if handledState, exists := t.handledPropCache[prop.ID]; exists && handledState == prop.State {
+			continue
+		}
+		if t.txm.IsPending(getVotePdaoPropTxKey(prop.ID)) {
+			t.log.Printlnf("Proposal %d already has a pending vote transaction.", prop.ID)
+			continue
+		}
+
+		handled, err := t.handleProposal(policy, prop, opts)
+		if err != nil {
+			return fmt.Errorf("error voting on proposal %d: %w", prop.ID, err)
+		}
+		if handled {
+			t.handledPropCache[prop.ID] = prop.State
+		}
+	}
+
+	return nil
+}
+
+// Decide what to do with a proposal and act on it, returning true if it doesn't need to be checked again during its current phase.
+// Submitted votes aren't cached; once their transaction is done, the node's vote direction on chain shows whether they need to be sent again.
+func (t *votePdaoProps) handleProposal(policy *proposals.VotingPolicy, prop *protocol.ProtocolDaoProposalDetails, opts *bind.CallOpts) (bool, error) {
+	// Check if the node has already voted; this uses the latest block so a vote that was just confirmed isn't sent again
+	nodeDirection, err := protocol.GetAddressVoteDirection(t.rp, prop.ID, t.nodeAddress, nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting the node's vote direction: %w", err)
+	}
+	if nodeDirection != types.VoteDirection_NoVote {
+		t.log.Printlnf("The node has already voted %s on proposal %d.", types.VoteDirections[nodeDirection], prop.ID)
+		return true, nil
+	}
+
+	// Don't vote on changes that can't be read
+	decoded, err := proposals.DecodeProposalPayload(t.rp, proposals.ProtocolDaoProposalsContractName, prop.Payload, opts)
+	if err != nil {
+		t.reportDecision(prop, fmt.Sprintf("not voting because its payload could not be decoded (%s)", err.Error()), false)
+		return true, nil
+	}
+	decision := policy.Decide(prop, decoded)
+	if decision.Action == proposals.VotingPolicyAction_Skip {
+		t.reportDecision(prop, fmt.Sprintf("%s, not voting", decision), true)
+		return true, nil
+	}
+
+	// Copy the followed delegate's vote
+	if decision.Action == proposals.VotingPolicyAction_Follow {
+		decision.Direction, err = protocol.GetAddressVoteDirection(t.rp, prop.ID, decision.Delegate, opts)
+		if err != nil {
+			return false, fmt.Errorf("error getting the vote direction of %s: %w", decision.Delegate.Hex(), err)
+		}
+		if decision.Direction == types.VoteDirection_NoVote {
+			if prop.State == types.ProtocolDaoProposalState_ActivePhase1 {
+				t.log.Printlnf("Waiting for %s to vote on proposal %d.", decision.Delegate.Hex(), prop.ID)
+				return false, nil
+			}
+			t.reportDecision(prop, fmt.Sprintf("%s, not voting because %s did not vote", decision, decision.Delegate.Hex()), true)
+			return true, nil
+		}
+	}
+
+	if prop.State == types.ProtocolDaoProposalState_ActivePhase1 {
+		return t.voteOnProposal(prop, decision)
+	}
+	return t.overrideVote(prop, decision)
+}
+
+// Vote on a proposal during phase 1 with the voting power delegated to the node
+func (t *votePdaoProps) voteOnProposal(prop *protocol.ProtocolDaoProposalDetails, decision proposals.VotingPolicyDecision) (bool, error) {
+	// Get the voting artifacts
+	totalDelegatedVP, nodeIndex, proof, err := t.propMgr.GetArtifactsForVoting(prop.TargetBlock, t.nodeAddress)
+	if err != nil {
+		return false, fmt.Errorf("error getting voting artifacts: %w", err)
+	}
+	if totalDelegatedVP.Cmp(common.Big0) == 0 {
+		t.log.Printlnf("The node doesn't have any delegated voting power for proposal %d; it will override its delegate's vote during phase 2 if needed.", prop.ID)
+		return true, nil
+	}
+
+	// Get transactor
+	txOpts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return false, err
+	}
+
+	// Get the gas limit
+	gasInfo, err := protocol.EstimateVoteOnProposalGas(t.rp, prop.ID, decision.Direction, totalDelegatedVP, nodeIndex, proof, txOpts)
+	if err != nil {
+		return false, fmt.Errorf("error estimating the gas required to vote: %w", err)
+	}
+	if !t.assignGas(prop, gasInfo, prop.Phase1EndTime, txOpts) {
+		return false, nil
+	}
+
+	// Vote
+	tx, err := t.txm.Submit(getVotePdaoPropTxKey(prop.ID), fmt.Sprintf("vote on proposal %d", prop.ID), txOpts, func(opts *bind.TransactOpts) error {
+		_, err := protocol.VoteOnProposal(t.rp, prop.ID, decision.Direction, totalDelegatedVP, nodeIndex, proof, opts)
+		return err
+	})
+	if err != nil {
+		t.reportDecision(prop, fmt.Sprintf("%s, but the vote could not be submitted (%s)", decision, err.Error()), false)
+		return false, err
+	}
+
+	// Print TX info; the transaction manager tracks it from here, and the proposal is checked again once it's done in case the vote was dropped or reverted
+	api.PrintTransactionHash(t.cfg, tx.LatestHash(), t.log)
+	t.reportDecision(prop, fmt.Sprintf("%s, submitted a vote with %.6f delegated voting power", decision, eth.WeiToEth(totalDelegatedVP)), true)
+	return false, nil
+}
+
+// Override the vote of the node's delegate during phase 2 if it doesn't match the policy
+func (t *votePdaoProps) overrideVote(prop *protocol.ProtocolDaoProposalDetails, decision proposals.VotingPolicyDecision) (bool, error) {
+	// Check the node's own voting power and delegate
+	votingPower, err := network.GetVotingPower(t.rp, t.nodeAddress, prop.TargetBlock, nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting the node's voting power: %w", err)
+	}
+	if votingPower.Cmp(common.Big0) == 0 {
+		t.log.Printlnf("The node didn't have any voting power when proposal %d was created.", prop.ID)
+		return true, nil
+	}
+	delegate, err := network.GetVotingDelegate(t.rp, t.nodeAddress, prop.TargetBlock, nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting the node's voting delegate: %w", err)
+	}
+	if delegate == t.nodeAddress {
+		t.reportDecision(prop, fmt.Sprintf("%s, but the node is its own delegate and can only vote during phase 1", decision), false)
+		return true, nil
+	}
+	delegateDirection, err := protocol.GetAddressVoteDirection(t.rp, prop.ID, delegate, nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting the vote direction of %s: %w", delegate.Hex(), err)
+	}
+	if delegateDirection == decision.Direction {
+		t.reportDecision(prop, fmt.Sprintf("%s, not overriding since the node's delegate %s already voted %s", decision, delegate.Hex(), types.VoteDirections[delegateDirection]), true)
+		return true, nil
+	}
+
+	// Get transactor
+	txOpts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return false, err
+	}
+
+	// Get the gas limit
+	gasInfo, err := protocol.EstimateOverrideVoteGas(t.rp, prop.ID, decision.Direction, txOpts)
+	if err != nil {
+		return false, fmt.Errorf("error estimating the gas required to override the vote: %w", err)
+	}
+	if !t.assignGas(prop, gasInfo, prop.Phase2EndTime, txOpts) {
+		return false, nil
+	}
+
+	// Override the vote
+	tx, err := t.txm.Submit(getVotePdaoPropTxKey(prop.ID), fmt.Sprintf("override the vote on proposal %d", prop.ID), txOpts, func(opts *bind.TransactOpts) error {
+		_, err := protocol.OverrideVote(t.rp, prop.ID, decision.Direction, opts)
+		return err
+	})
+	if err != nil {
+		t.reportDecision(prop, fmt.Sprintf("%s, but the override vote could not be submitted (%s)", decision, err.Error()), false)
+		return false, err
+	}
+
+	// Print TX info; the transaction manager tracks it from here, and the proposal is checked again once it's done in case the override was dropped or reverted
+	api.PrintTransactionHash(t.cfg, tx.LatestHash(), t.log)
+	t.reportDecision(prop, fmt.Sprintf("%s, submitted an override of the vote of the node's delegate %s (%s) with %.6f voting power", decision, delegate.Hex(), types.VoteDirections[delegateDirection], eth.WeiToEth(votingPower)), true)
+	return false, nil
+}
+
+// Set the gas for a vote, returning false if it should wait for lower gas prices
+func (t *votePdaoProps) assignGas(prop *protocol.ProtocolDaoProposalDetails, gasInfo rocketpool.GasInfo, phaseEndTime time.Time, txOpts *bind.TransactOpts) bool {
+	var gas *big.Int
+	if t.gasLimit != 0 {
+		gas = new(big.Int).SetUint64(t.gasLimit)
+	} else {
+		gas = new(big.Int).SetUint64(gasInfo.SafeGasLimit)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		var err error
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
+		if err != nil {
+			t.log.Printlnf("Error getting the max fee for voting on proposal %d: %s", prop.ID, err.Error())
+			return false
+		}
+	}
+
+	// Print the gas info
+	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
+		timeLeft := time.Until(phaseEndTime)
+		if timeLeft > votePdaoPropsDeadlineBuffer {
+			t.log.Printlnf("Time until voting on proposal %d will be forced: %s", prop.ID, timeLeft-votePdaoPropsDeadlineBuffer)
+			return false
+		}
+		t.log.Printlnf("NOTICE: The voting phase for proposal %d ends in %s, so the vote will be sent at the current gas price.", prop.ID, timeLeft)
+	}
+
+	txOpts.GasFeeCap = maxFee
+	txOpts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
+	txOpts.GasLimit = gas.Uint64()
+	return true
+}
+
+// Log a decision about a proposal and send an alert for it
+func (t *votePdaoProps) reportDecision(prop *protocol.ProtocolDaoProposalDetails, decision string, succeeded bool) {
+	t.log.Printlnf("Proposal %d (%s): %s.", prop.ID, prop.Message, decision)
+	alerting.AlertPdaoVotingDecision(t.cfg, prop.ID, decision, succeeded)
+}
+
+// Get the transaction manager key for voting on a proposal
+func getVotePdaoPropTxKey(proposalID uint64) string {
+	return fmt.Sprintf("vote-pdao-prop-%d", proposalID)
+}
@@ -55,6 +55,14 @@ func main() {
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.BoolFlag{
+			Name:  "offline-tx",
+			Usage: "Export transactions to a file for signing on another machine instead of signing and sending them",
+		},
+		cli.StringFlag{
+			Name:  "node",
+			Usage: "The name of the node to run the command for, when this installation manages several nodes",
+		},
 		cli.StringFlag{
 			Name:  "metricsAddress, m",
 			Usage: "Address to serve metrics on if enabled",
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
@@ -94,6 +93,8 @@ func (t *dissolveInvalidCredentials) run(state *state.NetworkState) error {
 // Get megapool validators that can be dissolved due to using invalid credentials
 func (t *dissolveInvalidCredentials) dissolveInvalidCredentialValidators(state *state.NetworkState) error {
 
+	invalidValidators := []megapool.ValidatorInfoFromGlobalIndex{}
+	invalidValidatorPubkeys := []types.ValidatorPubkey{}
 	for _, validator := range state.MegapoolValidatorGlobalIndex {
 		if validator.ValidatorInfo.InPrestake {
 			expectedWithdrawalAddress := services.CalculateMegapoolWithdrawalCredentials(validator.MegapoolAddress)
@@ -105,15 +106,32 @@ func (t *dissolveInvalidCredentials) dissolveInvalidCredentialValidators(state *
 			}
 			if validatorFromState.Index != "" && !bytes.Equal(validatorFromState.WithdrawalCredentials.Bytes(), expectedWithdrawalAddress.Bytes()) {
 				t.log.Printlnf("Validator %d has an invalid credential %s while the expected is %s. Dissolving...", validator.ValidatorInfo.ValidatorIndex, validatorFromState.WithdrawalCredentials, expectedWithdrawalAddress.Bytes())
-				t.dissolveMegapoolValidator(validator, expectedWithdrawalAddress)
+				invalidValidators = append(invalidValidators, validator)
+				invalidValidatorPubkeys = append(invalidValidatorPubkeys, types.ValidatorPubkey(validator.Pubkey))
 			}
 
 		}
 	}
+	if len(invalidValidators) == 0 {
+		return nil
+	}
+
+	// Prove all of the validators from the same beacon state
+	proofs, proofErrs, err := services.GetValidatorProofs(t.c, invalidValidatorPubkeys)
+	if err != nil {
+		return fmt.Errorf("error getting validator proofs: %w", err)
+	}
+	for i, validator := range invalidValidators {
+		if proofErrs[i] != nil {
+			t.log.Printlnf("Error getting proof for megapool validator ID %d from megapool %s: %s", validator.ValidatorId, validator.MegapoolAddress, proofErrs[i].Error())
+			continue
+		}
+		t.dissolveMegapoolValidator(validator, proofs[i])
+	}
 	return nil
 }
 
-func (t *dissolveInvalidCredentials) dissolveMegapoolValidator(validator megapool.ValidatorInfoFromGlobalIndex, expectedWithdrawalCredentials common.Hash) error {
+func (t *dissolveInvalidCredentials) dissolveMegapoolValidator(validator megapool.ValidatorInfoFromGlobalIndex, proof megapool.ValidatorProof) error {
 	// Log
 	t.log.Printlnf("Dissolving megapool validator ID: %d from megapool %s...", validator.ValidatorId, validator.MegapoolAddress)
 
@@ -123,16 +141,6 @@ func (t *dissolveInvalidCredentials) dissolveMegapoolValidator(validator megapoo
 		return err
 	}
 
-	eth2Config, err := t.bc.GetEth2Config()
-	if err != nil {
-		return err
-	}
-
-	proof, err := services.GetValidatorProof(t.c, t.w, eth2Config, validator.MegapoolAddress, types.ValidatorPubkey(validator.Pubkey))
-	if err != nil {
-		return fmt.Errorf("error getting validator proof: %w", err)
-	}
-
 	// Get the gas limit
 	gasInfo, err := megapool.EstimateDissolveWithProof(t.rp, validator.MegapoolAddress, validator.ValidatorId, proof, opts)
 	if err != nil {
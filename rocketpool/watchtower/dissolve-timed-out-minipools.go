@@ -16,6 +16,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -32,10 +33,11 @@ type dissolveTimedOutMinipools struct {
 	w   wallet.Wallet
 	ec  rocketpool.ExecutionClient
 	rp  *rocketpool.RocketPool
+	txm *txmanager.TxManager
 }
 
 // Create dissolve timed out minipools task
-func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*dissolveTimedOutMinipools, error) {
+func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger, txm *txmanager.TxManager) (*dissolveTimedOutMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -63,6 +65,7 @@ func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*diss
 		w:   w,
 		ec:  ec,
 		rp:  rp,
+		txm: txm,
 	}, nil
 
 }
@@ -91,6 +94,10 @@ func (t *dissolveTimedOutMinipools) run(state *state.NetworkState) error {
 
 	// Dissolve minipools
 	for _, mp := range minipools {
+		if t.txm.IsPending(getDissolveMinipoolTxKey(mp)) {
+			t.log.Printlnf("Minipool %s already has a pending dissolve transaction.", mp.GetAddress().Hex())
+			continue
+		}
 		if err := t.dissolveMinipool(mp); err != nil {
 			t.log.Println(fmt.Errorf("Could not dissolve minipool %s: %w", mp.GetAddress().Hex(), err))
 		}
@@ -162,21 +169,26 @@ func (t *dissolveTimedOutMinipools) dissolveMinipool(mp minipool.Minipool) error
 	opts.GasLimit = gasInfo.SafeGasLimit
 
 	// Dissolve
-	hash, err := mp.Dissolve(opts)
-	if err != nil {
+	tx, err := t.txm.Submit(getDissolveMinipoolTxKey(mp), fmt.Sprintf("dissolve minipool %s", mp.GetAddress().Hex()), opts, func(opts *bind.TransactOpts) error {
+		_, err := mp.Dissolve(opts)
 		return err
-	}
-
-	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	})
 	if err != nil {
 		return err
 	}
 
+	// Print TX info; the transaction manager tracks it from here
+	api.PrintTransactionHash(t.cfg, tx.LatestHash(), &t.log)
+
 	// Log
-	t.log.Printlnf("Successfully dissolved minipool %s.", mp.GetAddress().Hex())
+	t.log.Printlnf("Successfully submitted the dissolution of minipool %s.", mp.GetAddress().Hex())
 
 	// Return
 	return nil
 
 }
+
+// Get the transaction manager key for dissolving a minipool
+func getDissolveMinipoolTxKey(mp minipool.Minipool) string {
+	return fmt.Sprintf("dissolve-minipool-%s", mp.GetAddress().Hex())
+}
@@ -10,11 +10,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *collectors.ScrubCollector, bondReductionCollector *collectors.BondReductionCollector, soloMigrationCollector *collectors.SoloMigrationCollector) error {
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *collectors.ScrubCollector, bondReductionCollector *collectors.BondReductionCollector, soloMigrationCollector *collectors.SoloMigrationCollector, scheduler *tasks.Scheduler) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -36,6 +37,7 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *co
 	registry.MustRegister(scrubCollector)
 	registry.MustRegister(bondReductionCollector)
 	registry.MustRegister(soloMigrationCollector)
+	registry.MustRegister(tasks.NewTaskCollector(scheduler, tasks.WatchtowerDaemonName))
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 
 	// Start the HTTP server
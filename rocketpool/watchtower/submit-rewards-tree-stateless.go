@@ -333,6 +333,14 @@ func (t *submitRewardsTree_Stateless) generateTreeImpl(rp *rocketpool.RocketPool
 	if err != nil {
 		return fmt.Errorf("Error creating Merkle tree generator: %w", err)
 	}
+	if t.cfg.Smartnode.UseRollingRecords.Value == true {
+		record, err := t.loadRollingRecord(rp, currentIndex, snapshotEnd, state)
+		if err != nil {
+			t.printMessage(fmt.Sprintf("WARNING: couldn't load the rolling record, the whole interval will be processed instead: %s", err.Error()))
+		} else {
+			treegen.SetRollingRecord(record)
+		}
+	}
 	treeResult, err := treegen.GenerateTree()
 	if err != nil {
 		return fmt.Errorf("Error generating Merkle tree: %w", err)
@@ -365,6 +373,27 @@ func (t *submitRewardsTree_Stateless) generateTreeImpl(rp *rocketpool.RocketPool
 
 }
 
+// Load the best rolling record for the interval that the watchtower has saved to disk
+func (t *submitRewardsTree_Stateless) loadRollingRecord(rp *rocketpool.RocketPool, currentIndex uint64, snapshotEnd *rprewards.SnapshotEnd, state *state.NetworkState) (*rprewards.RollingRecord, error) {
+	startSlot := uint64(0)
+	if currentIndex > 0 {
+		previousRewardsEvent, err := rprewards.NewRewardsExecutionClient(rp).GetRewardSnapshotEvent(t.cfg.Smartnode.GetPreviousRewardsPoolAddresses(), currentIndex-1, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting event for interval %d: %w", currentIndex-1, err)
+		}
+		startSlot, err = rprewards.GetStartSlotForInterval(previousRewardsEvent, t.bc, state.BeaconConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error getting start slot for interval %d: %w", currentIndex, err)
+		}
+	}
+
+	mgr, err := rprewards.NewRollingRecordManager(t.log, t.generationPrefix, t.bc, state.BeaconConfig, t.cfg.Smartnode.GetRecordsPath(), t.cfg.Smartnode.RecordCheckpointInterval.Value.(uint64), t.cfg.Smartnode.CheckpointRetentionLimit.Value.(uint64))
+	if err != nil {
+		return nil, fmt.Errorf("error creating rolling record manager: %w", err)
+	}
+	return mgr.LoadBestRecordFromDisk(startSlot, snapshotEnd.ConsensusBlock, currentIndex)
+}
+
 // Submit rewards info to the contracts
 func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, consensusBlock uint64, executionBlock uint64, rewardsFile rprewards.IRewardsFile, cid string, intervalsPassed *big.Int) error {
 
@@ -0,0 +1,224 @@
+package watchtower
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+// Update rolling record task
+type updateRollingRecord struct {
+	c         *cli.Context
+	log       *log.ColorLogger
+	errLog    *log.ColorLogger
+	cfg       *config.RocketPoolConfig
+	rp        *rocketpool.RocketPool
+	bc        beacon.Client
+	m         *state.NetworkStateManager
+	lock      *sync.Mutex
+	isRunning bool
+	logPrefix string
+
+	// Only used by the update goroutine
+	mgr            *rprewards.RollingRecordManager
+	record         *rprewards.RollingRecord
+	startSlot      uint64
+	startSlotIndex uint64
+	hasStartSlot   bool
+
+	// The state built for nodes that aren't on the Oracle DAO, and the finalized epoch it was built for
+	ownState               *state.NetworkState
+	ownStateFinalizedEpoch uint64
+}
+
+// Create update rolling record task
+func newUpdateRollingRecord(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, m *state.NetworkStateManager) (*updateRollingRecord, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := &sync.Mutex{}
+	return &updateRollingRecord{
+		c:         c,
+		log:       &logger,
+		errLog:    &errorLogger,
+		cfg:       cfg,
+		rp:        rp,
+		bc:        bc,
+		m:         m,
+		lock:      lock,
+		isRunning: false,
+		logPrefix: "[Rolling Record]",
+	}, nil
+
+}
+
+// Update the rolling record for the current rewards interval
+func (t *updateRollingRecord) run(nodeTrusted bool, state *state.NetworkState, beaconSlot uint64) error {
+
+	// Rolling records are only useful to nodes that generate trees
+	if t.cfg.Smartnode.UseRollingRecords.Value != true {
+		return nil
+	}
+	if !nodeTrusted && t.cfg.Smartnode.RewardsTreeMode.Value.(cfgtypes.RewardsMode) != cfgtypes.RewardsMode_Generate {
+		return nil
+	}
+
+	// Check if the record is already being updated
+	t.lock.Lock()
+	if t.isRunning {
+		t.log.Printlnf("%s Record update is already running in the background.", t.logPrefix)
+		t.lock.Unlock()
+		return nil
+	}
+	t.isRunning = true
+	t.lock.Unlock()
+
+	go func() {
+		err := t.updateRecord(state, beaconSlot)
+		if err != nil {
+			t.errLog.Println(fmt.Errorf("%s %w", t.logPrefix, err))
+			t.errLog.Printlnf("%s *** Rolling record update failed. ***", t.logPrefix)
+		}
+
+		t.lock.Lock()
+		t.isRunning = false
+		t.lock.Unlock()
+	}()
+
+	return nil
+
+}
+
+// Update the record up to the latest finalized epoch, or up to the end of the interval if that comes first
+func (t *updateRollingRecord) updateRecord(state *state.NetworkState, beaconSlot uint64) error {
+
+	// Create the manager on the first run
+	if t.mgr == nil {
+		beaconCfg, err := t.bc.GetEth2Config()
+		if err != nil {
+			return fmt.Errorf("error getting Beacon config: %w", err)
+		}
+		mgr, err := rprewards.NewRollingRecordManager(t.log, t.logPrefix, t.bc, beaconCfg, t.cfg.Smartnode.GetRecordsPath(), t.cfg.Smartnode.RecordCheckpointInterval.Value.(uint64), t.cfg.Smartnode.CheckpointRetentionLimit.Value.(uint64))
+		if err != nil {
+			return fmt.Errorf("error creating rolling record manager: %w", err)
+		}
+		t.mgr = mgr
+	}
+
+	// Only process finalized epochs so the record never includes a block that gets reorged out
+	beaconHead, err := t.bc.GetBeaconHead()
+	if err != nil {
+		return fmt.Errorf("error getting Beacon head: %w", err)
+	}
+
+	// Non-Oracle DAO nodes don't build a state for the other tasks, so build one here.
+	// The record only moves when a new epoch is finalized, so it's reused until then.
+	if state == nil {
+		if t.ownState == nil || (t.ownState.BeaconSlotNumber != beaconSlot && t.ownStateFinalizedEpoch != beaconHead.FinalizedEpoch) {
+			t.ownState, err = t.m.GetStateForSlot(beaconSlot)
+			if err != nil {
+				return fmt.Errorf("error getting state for beacon slot %d: %w", beaconSlot, err)
+			}
+			t.ownStateFinalizedEpoch = beaconHead.FinalizedEpoch
+		}
+		state = t.ownState
+	} else {
+		t.ownState = nil
+	}
+
+	// Get the start slot of the current interval
+	index := state.NetworkDetails.RewardIndex
+	startSlot, err := t.getStartSlot(index, state.BeaconConfig)
+	if err != nil {
+		return fmt.Errorf("error getting start slot for interval %d: %w", index, err)
+	}
+
+	// Stop before the epoch the interval ends in, since that's where the tree generator takes over
+	slotsPerEpoch := state.BeaconConfig.SlotsPerEpoch
+	genesisTime := time.Unix(int64(state.BeaconConfig.GenesisTime), 0)
+	endTime := state.NetworkDetails.IntervalStart.Add(state.NetworkDetails.IntervalDuration)
+	endSlot := uint64(math.Ceil(endTime.Sub(genesisTime).Seconds() / float64(state.BeaconConfig.SecondsPerSlot)))
+	intervalEndSlot := (endSlot/slotsPerEpoch)*slotsPerEpoch - 1
+
+	targetSlot := (beaconHead.FinalizedEpoch+1)*slotsPerEpoch - 1
+	if targetSlot > intervalEndSlot {
+		targetSlot = intervalEndSlot
+	}
+	if targetSlot < startSlot {
+		return nil
+	}
+
+	// Load the best record for this interval if the current one isn't for it
+	if t.record == nil || t.record.RewardsInterval != index || t.record.StartSlot != startSlot {
+		t.record, err = t.mgr.LoadBestRecordFromDisk(startSlot, targetSlot, index)
+		if err != nil {
+			return fmt.Errorf("error loading rolling record: %w", err)
+		}
+	}
+
+	// Update it
+	previousSlot := t.record.LastDutiesSlot
+	err = t.mgr.UpdateRecord(t.record, targetSlot, state)
+	if err != nil {
+		return fmt.Errorf("error updating rolling record: %w", err)
+	}
+
+	// Save the final update for the interval so the tree generator can use it
+	if t.record.LastDutiesSlot != previousSlot && t.record.LastDutiesSlot == intervalEndSlot {
+		err = t.mgr.SaveRecordToFile(t.record)
+		if err != nil {
+			return fmt.Errorf("error saving rolling record: %w", err)
+		}
+		t.log.Printlnf("%s Record is complete for interval %d.", t.logPrefix, index)
+	}
+
+	return nil
+
+}
+
+// Get the start slot for the provided interval, caching it since it won't change until the next interval
+func (t *updateRollingRecord) getStartSlot(index uint64, beaconConfig beacon.Eth2Config) (uint64, error) {
+	if t.hasStartSlot && t.startSlotIndex == index {
+		return t.startSlot, nil
+	}
+
+	startSlot := uint64(0)
+	if index > 0 {
+		previousRewardsEvent, err := rprewards.NewRewardsExecutionClient(t.rp).GetRewardSnapshotEvent(t.cfg.Smartnode.GetPreviousRewardsPoolAddresses(), index-1, nil)
+		if err != nil {
+			return 0, fmt.Errorf("error getting event for interval %d: %w", index-1, err)
+		}
+		startSlot, err = rprewards.GetStartSlotForInterval(previousRewardsEvent, t.bc, beaconConfig)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	t.startSlot = startSlot
+	t.startSlotIndex = index
+	t.hasStartSlot = true
+	return startSlot, nil
+}
@@ -3,7 +3,6 @@ package watchtower
 import (
 	"fmt"
 	"math/big"
-	"math/rand"
 	"net/http"
 	"sync"
 	"time"
@@ -18,7 +17,10 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/dryrun"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
@@ -48,6 +50,8 @@ const (
 	CheckSoloMigrationsColor        = color.FgCyan
 	FinalizeProposalsColor          = color.FgMagenta
 	UpdateColor                     = color.FgHiWhite
+	TxManagerColor                  = color.FgHiBlack
+	DryRunColor                     = color.FgHiYellow
 )
 
 // Register watchtower command
@@ -82,6 +86,17 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+
+	// Simulate automatic transactions instead of sending them if the user enabled dry-run mode
+	var dryRunRecorder *dryrun.Recorder
+	if cfg.Smartnode.DryRun.Value == true {
+		dryRunLog := log.NewColorLogger(DryRunColor)
+		dryRunRecorder = dryrun.NewRecorder(cfg, rp.Client, tasks.WatchtowerDaemonName, &dryRunLog)
+		if err := services.EnableDryRun(c, dryRunRecorder); err != nil {
+			return err
+		}
+	}
+
 	w, err := services.GetHdWallet(c)
 	if err != nil {
 		return err
@@ -97,6 +112,9 @@ func run(c *cli.Context) error {
 	} else {
 		fmt.Println("Starting watchtower daemon in Docker Mode.")
 	}
+	if dryRunRecorder != nil {
+		fmt.Println("Dry-run mode is enabled; automatic transactions will be simulated instead of sent.")
+	}
 
 	// Initialize the metrics reporters
 	scrubCollector := collectors.NewScrubCollector()
@@ -116,6 +134,13 @@ func run(c *cli.Context) error {
 		return fmt.Errorf("error getting node account: %w", err)
 	}
 
+	// Create the transaction manager
+	txLog := log.NewColorLogger(TxManagerColor)
+	txm, err := txmanager.NewTxManager(cfg, w, rp.Client, &txLog, txmanager.WatchtowerDaemonName)
+	if err != nil {
+		return fmt.Errorf("error creating transaction manager: %w", err)
+	}
+
 	// Initialize tasks
 	respondChallenges, err := newRespondChallenges(c, log.NewColorLogger(RespondChallengesColor), m)
 	if err != nil {
@@ -129,7 +154,7 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during network balances check: %w", err)
 	}
-	dissolveTimedOutMinipools, err := newDissolveTimedOutMinipools(c, log.NewColorLogger(DissolveTimedOutMinipoolsColor))
+	dissolveTimedOutMinipools, err := newDissolveTimedOutMinipools(c, log.NewColorLogger(DissolveTimedOutMinipoolsColor), txm)
 	if err != nil {
 		return fmt.Errorf("error during timed-out minipools check: %w", err)
 	}
@@ -154,6 +179,10 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during stateless rewards tree check: %w", err)
 	}
+	updateRollingRecord, err := newUpdateRollingRecord(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m)
+	if err != nil {
+		return fmt.Errorf("error during rolling record update check: %w", err)
+	}
 	/*processPenalties, err := newProcessPenalties(c, log.NewColorLogger(ProcessPenaltiesColor), errorLog)
 	if err != nil {
 		return fmt.Errorf("error during penalties check: %w", err)
@@ -175,8 +204,62 @@ func run(c *cli.Context) error {
 		return fmt.Errorf("error creating finalize-pdao-proposals task: %w", err)
 	}
 
-	intervalDelta := maxTasksInterval - minTasksInterval
-	secondsDelta := intervalDelta.Seconds()
+	// Schedule the tasks, in the order they should run when they're due at the same time.
+	// Most of them are Oracle DAO duties, which don't run while the node isn't a member.
+	scheduler, err := tasks.NewScheduler(cfg, tasks.WatchtowerDaemonName, taskCooldown, maxTasksInterval-minTasksInterval, &errorLog)
+	if err != nil {
+		return err
+	}
+	if dryRunRecorder != nil {
+		dryRunRecorder.SetTaskSource(scheduler.CurrentTask)
+	}
+	var isOnOdao bool
+	var latestBlock beacon.BeaconBlock
+	odaoOnly := func(run tasks.Runner) tasks.Runner {
+		return func(state *state.NetworkState) error {
+			if !isOnOdao {
+				return tasks.ErrNotActive
+			}
+			return run(state)
+		}
+	}
+	scheduler.Add("generate-rewards-tree", minTasksInterval, true, func(state *state.NetworkState) error {
+		return generateRewardsTree.run()
+	})
+	scheduler.Add("respond-challenges", minTasksInterval, true, odaoOnly(func(state *state.NetworkState) error {
+		return respondChallenges.run()
+	}))
+	scheduler.Add("challenge-validators-exiting", minTasksInterval, true, odaoOnly(challengeValidatorsExiting.run))
+	scheduler.Add("dissolve-timed-out-megapool-validators", minTasksInterval, true, odaoOnly(dissolveTimedOutMegapoolValidators.run))
+	scheduler.Add("dissolve-invalid-credentials", minTasksInterval, true, odaoOnly(dissolveInvalidCredentials.run))
+	scheduler.Add("submit-network-balances", minTasksInterval, true, odaoOnly(submitNetworkBalances.run))
+	scheduler.Add("update-rolling-record", minTasksInterval, true, func(state *state.NetworkState) error {
+		return updateRollingRecord.run(isOnOdao, state, latestBlock.Slot)
+	})
+	scheduler.Add("submit-rewards-tree", minTasksInterval, true, func(state *state.NetworkState) error {
+		return submitRewardsTree_Stateless.Run(isOnOdao, state, latestBlock.Slot)
+	})
+	scheduler.Add("submit-rpl-price", minTasksInterval, true, odaoOnly(submitRplPrice.run))
+	scheduler.Add("dissolve-timed-out-minipools", minTasksInterval, true, odaoOnly(dissolveTimedOutMinipools.run))
+	scheduler.Add("finalize-pdao-proposals", minTasksInterval, true, odaoOnly(finalizePdaoProposals.run))
+	scheduler.Add("submit-scrub-minipools", minTasksInterval, true, odaoOnly(submitScrubMinipools.run))
+	scheduler.Add("cancel-bond-reductions", minTasksInterval, true, odaoOnly(cancelBondReductions.run))
+	scheduler.Add("check-solo-migrations", minTasksInterval, true, odaoOnly(checkSoloMigrations.run))
+	// The fee recipient penalty check is DISABLED until MEV-Boost can support it
+
+	// React to Beacon events for time-sensitive duties instead of waiting for their next interval
+	scheduler.RunOnEvents("challenge-validators-exiting", beacon.EventTopic_Head, beacon.EventTopic_VoluntaryExit)
+	scheduler.RunOnEvents("dissolve-invalid-credentials", beacon.EventTopic_Head)
+	scheduler.RunOnEvents("submit-scrub-minipools", beacon.EventTopic_Head)
+	scheduler.RunOnEvents("update-rolling-record", beacon.EventTopic_FinalizedCheckpoint)
+	scheduler.RunWithoutState("generate-rewards-tree", "respond-challenges")
+
+	// Wake the task loop when the Beacon node reports a relevant event, sharing the stream with any other daemon in this process
+	eventHub, err := services.GetBeaconEventHub(c, &updateLog)
+	if err != nil {
+		return err
+	}
+	eventHub.Listen(scheduler)
 
 	// Wait group to handle the various threads
 	wg := new(sync.WaitGroup)
@@ -184,11 +267,8 @@ func run(c *cli.Context) error {
 
 	// Run task loop
 	go func() {
+		var networkState *state.NetworkState
 		for {
-			// Randomize the next interval
-			randomSeconds := rand.Intn(int(secondsDelta))
-			interval := time.Duration(randomSeconds)*time.Second + minTasksInterval
-
 			// Check the EC status
 			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
@@ -207,7 +287,7 @@ func run(c *cli.Context) error {
 
 			// Get the Beacon block
 			//latestBlock, err := m.GetLatestFinalizedBeaconBlock()
-			latestBlock, err := m.GetLatestBeaconBlock()
+			latestBlock, err = m.GetLatestBeaconBlock()
 			if err != nil {
 				errorLog.Println(fmt.Errorf("error getting latest Beacon block: %w", err))
 				time.Sleep(taskCooldown)
@@ -215,120 +295,40 @@ func run(c *cli.Context) error {
 			}
 
 			// Check if on the Oracle DAO
-			isOnOdao, err := isOnOracleDAO(rp, nodeAccount.Address, latestBlock)
+			isOnOdao, err = isOnOracleDAO(rp, nodeAccount.Address, latestBlock)
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
 				continue
 			}
 
-			// Run the manual rewards tree generation
-			if err := generateRewardsTree.run(); err != nil {
+			// Check on the pending transactions
+			if err := txm.Update(); err != nil {
 				errorLog.Println(err)
 			}
-			time.Sleep(taskCooldown)
 
-			if isOnOdao {
-				// Run the challenge check
-				if err := respondChallenges.run(); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Update the network state
-				state, err := updateNetworkState(m, &updateLog, latestBlock)
+			// Update the network state; only the Oracle DAO duties need it, so it's only rebuilt when the chain has moved and one of them is due
+			if !isOnOdao {
+				networkState = nil
+			} else if networkState == nil || (networkState.BeaconSlotNumber != latestBlock.Slot && scheduler.DueTasksNeedState()) {
+				networkState, err = updateNetworkState(m, &updateLog, latestBlock)
 				if err != nil {
 					errorLog.Println(err)
 					time.Sleep(taskCooldown)
 					continue
 				}
-
-				// Flag validators that are exiting and didn't notify the exit
-				if err := challengeValidatorsExiting.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the megapool validator dissolve check
-				if err := dissolveTimedOutMegapoolValidators.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the invalid credentials dissolve check
-				if err := dissolveInvalidCredentials.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the network balance submission check
-				if err := submitNetworkBalances.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the rewards tree submission check
-				if err := submitRewardsTree_Stateless.Run(isOnOdao, state, latestBlock.Slot); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the price submission check
-				if err := submitRplPrice.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the minipool dissolve check
-				if err := dissolveTimedOutMinipools.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the finalize proposals check
-				if err := finalizePdaoProposals.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the minipool scrub check
-				if err := submitScrubMinipools.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the bond cancel check
-				if err := cancelBondReductions.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the solo migration check
-				if err := checkSoloMigrations.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				/*time.Sleep(taskCooldown)
-
-				// Run the fee recipient penalty check
-				if err := processPenalties.run(); err != nil {
-					errorLog.Println(err)
-				}*/
-				// DISABLED until MEV-Boost can support it
-			} else {
-				// Run the rewards tree submission check
-				if err := submitRewardsTree_Stateless.Run(isOnOdao, nil, latestBlock.Slot); err != nil {
-					errorLog.Println(err)
-				}
 			}
 
-			time.Sleep(interval)
+			// Run the tasks that are due and wait for the next one
+			scheduler.RunDueTasks(networkState)
+			scheduler.WaitForNextRun()
 		}
 		wg.Done()
 	}()
 
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector, bondReductionCollector, soloMigrationCollector)
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector, bondReductionCollector, soloMigrationCollector, scheduler)
 		if err != nil {
 			errorLog.Println(err)
 		}
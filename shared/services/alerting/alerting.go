@@ -3,12 +3,12 @@ package alerting
 import (
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-openapi/strfmt"
 	apiclient "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client"
-	apialert "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client/alert"
 	"github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/models"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 )
@@ -38,9 +38,9 @@ func FetchAlerts(cfg *config.RocketPoolConfig) ([]*models.GettableAlert, error)
 }
 
 // Sends an alert when the node automatically changed a node's fee recipient or attempted to (success or failure).
-// If alerting/metrics are disabled, this function does nothing.
+// If no notification sinks are enabled, this function does nothing.
 func AlertFeeRecipientChanged(cfg *config.RocketPoolConfig, newFeeRecipient common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
+	if !isNotifyingEnabled(cfg) {
 		logMessage("alerting is disabled, not sending AlertFeeRecipientChanged.")
 		return nil
 	}
@@ -64,9 +64,9 @@ func AlertFeeRecipientChanged(cfg *config.RocketPoolConfig, newFeeRecipient comm
 }
 
 // Sends an alert when the node automatically reduced a minipool's bond or attempted to (success or failure).
-// If alerting/metrics are disabled, this function does nothing.
+// If no notification sinks are enabled, this function does nothing.
 func AlertMinipoolBondReduced(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
+	if !isNotifyingEnabled(cfg) {
 		logMessage("alerting is disabled, not sending AlertMinipoolBondReduced.")
 		return nil
 	}
@@ -76,6 +76,10 @@ func AlertMinipoolBondReduced(cfg *config.RocketPoolConfig, minipoolAddress comm
 		return nil
 	}
 
+	if isDryRun(cfg, "AlertMinipoolBondReduced") {
+		return nil
+	}
+
 	// prepare the alert information:
 	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
 	alert := createAlert(
@@ -93,9 +97,9 @@ func AlertMinipoolBondReduced(cfg *config.RocketPoolConfig, minipoolAddress comm
 }
 
 // Sends an alert when the node automatically distributes a minipool's balance (success or failure).
-// If alerting/metrics are disabled, this function does nothing.
+// If no notification sinks are enabled, this function does nothing.
 func AlertMinipoolBalanceDistributed(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
+	if !isNotifyingEnabled(cfg) {
 		logMessage("alerting is disabled, not sending AlertMinipoolBalanceDistributed.")
 		return nil
 	}
@@ -105,6 +109,10 @@ func AlertMinipoolBalanceDistributed(cfg *config.RocketPoolConfig, minipoolAddre
 		return nil
 	}
 
+	if isDryRun(cfg, "AlertMinipoolBalanceDistributed") {
+		return nil
+	}
+
 	// prepare the alert information:
 	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
 	alert := createAlert(
@@ -121,9 +129,9 @@ func AlertMinipoolBalanceDistributed(cfg *config.RocketPoolConfig, minipoolAddre
 }
 
 // Sends an alert when the node automatically prompted a minipool or attempted to (success or failure).
-// If alerting/metrics are disabled, this function does nothing.
+// If no notification sinks are enabled, this function does nothing.
 func AlertMinipoolPromoted(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
+	if !isNotifyingEnabled(cfg) {
 		logMessage("alerting is disabled, not sending AlertMinipoolPromoted.")
 		return nil
 	}
@@ -133,6 +141,10 @@ func AlertMinipoolPromoted(cfg *config.RocketPoolConfig, minipoolAddress common.
 		return nil
 	}
 
+	if isDryRun(cfg, "AlertMinipoolPromoted") {
+		return nil
+	}
+
 	// prepare the alert information:
 	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
 	alert := createAlert(
@@ -149,9 +161,9 @@ func AlertMinipoolPromoted(cfg *config.RocketPoolConfig, minipoolAddress common.
 }
 
 // Sends an alert when the node automatically staked a minipool or attempted to (success or failure).
-// If alerting/metrics are disabled, this function does nothing.
+// If no notification sinks are enabled, this function does nothing.
 func AlertMinipoolStaked(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
+	if !isNotifyingEnabled(cfg) {
 		logMessage("alerting is disabled, not sending AlertMinipoolStaked.")
 		return nil
 	}
@@ -161,6 +173,10 @@ func AlertMinipoolStaked(cfg *config.RocketPoolConfig, minipoolAddress common.Ad
 		return nil
 	}
 
+	if isDryRun(cfg, "AlertMinipoolStaked") {
+		return nil
+	}
+
 	// prepare the alert information:
 	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
 
@@ -177,13 +193,120 @@ func AlertMinipoolStaked(cfg *config.RocketPoolConfig, minipoolAddress common.Ad
 	return sendAlert(alert, cfg)
 }
 
+// Sends an alert when a daemon simulated a transaction in dry-run mode instead of sending it.
+// If no notification sinks are enabled, this function does nothing.
+func AlertDryRunTransaction(cfg *config.RocketPoolConfig, daemonName string, task string, to common.Address, hash common.Hash, simulationError string) error {
+	if !isNotifyingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertDryRunTransaction.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_DryRunTransaction.Value != true {
+		logMessage("alert for DryRunTransaction is disabled, not sending.")
+		return nil
+	}
+
+	// prepare the alert information:
+	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(simulationError == "")
+	description := fmt.Sprintf("The %s daemon's %s task would have sent a transaction to %s (%s). Its simulation %s.", daemonName, task, to.Hex(), hash.Hex(), succeededOrFailedText)
+	if simulationError != "" {
+		description = fmt.Sprintf("%s The transaction would revert: %s", description, simulationError)
+	}
+
+	// The hash changes every time the task runs, so the alert is named after what the transaction does instead.
+	// That way a task that's simulated on every cycle only alerts again once the previous alert has ended.
+	alert := createAlert(
+		fmt.Sprintf("DryRunTransaction-%s-%s-%s-%s", succeededOrFailedText, daemonName, task, to.Hex()),
+		fmt.Sprintf("Dry run: %s would have sent a transaction", task),
+		description,
+		severity,
+		endsAt,
+		map[string]string{
+			"daemon": daemonName,
+			"task":   task,
+			"to":     to.Hex(),
+		},
+	)
+	if !activeAlerts.add(alert) {
+		logMessage("alert %s is still active, not sending it again.", alert.Name)
+		return nil
+	}
+	return sendAlert(alert, cfg)
+}
+
+// Check if the daemon is only simulating transactions. The outcome of a simulated transaction was already
+// reported by AlertDryRunTransaction, so the alert for the real transaction isn't sent.
+func isDryRun(cfg *config.RocketPoolConfig, alertName string) bool {
+	if cfg.Smartnode.DryRun.Value != true {
+		return false
+	}
+	logMessage("dry run is enabled, not sending %s.", alertName)
+	return true
+}
+
+// The alerts that have been sent and haven't ended yet, by name
+type alertTracker struct {
+	endTimes map[string]time.Time
+	lock     sync.Mutex
+}
+
+var activeAlerts = &alertTracker{
+	endTimes: map[string]time.Time{},
+}
+
+// Track an alert until it ends, returning false if an alert with the same name is already active
+func (t *alertTracker) add(alert *Alert) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	for name, endsAt := range t.endTimes {
+		if !endsAt.After(now) {
+			delete(t.endTimes, name)
+		}
+	}
+	if _, exists := t.endTimes[alert.Name]; exists {
+		return false
+	}
+	t.endTimes[alert.Name] = alert.EndsAt
+	return true
+}
+
+// Sends an alert when the node's voting policy decided what to do with a Protocol DAO proposal, and whether acting on it succeeded.
+// If no notification sinks are enabled, this function does nothing.
+func AlertPdaoVotingDecision(cfg *config.RocketPoolConfig, proposalID uint64, decision string, succeeded bool) error {
+	if !isNotifyingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertPdaoVotingDecision.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_PdaoVotingDecision.Value != true {
+		logMessage("alert for PdaoVotingDecision is disabled, not sending.")
+		return nil
+	}
+
+	// prepare the alert information:
+	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
+	alert := createAlert(
+		fmt.Sprintf("PdaoVotingDecision-%s-%d", succeededOrFailedText, proposalID),
+		fmt.Sprintf("Protocol DAO proposal %d voting decision %s", proposalID, succeededOrFailedText),
+		fmt.Sprintf("The voting policy decided what to do with Protocol DAO proposal %d with status %s: %s", proposalID, succeededOrFailedText, decision),
+		severity,
+		endsAt,
+		map[string]string{
+			"proposal": fmt.Sprint(proposalID),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
 // Gets various settings for an alert based on whether a process succeeded or failed.
-func getAlertSettingsForEvent(succeeded bool) (strfmt.DateTime, Severity, string) {
-	endsAt := strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityInfo))
+func getAlertSettingsForEvent(succeeded bool) (time.Time, Severity, string) {
+	endsAt := time.Now().Add(DefaultEndsAtDurationForSeverityInfo)
 	severity := SeverityInfo
 	if !succeeded {
 		severity = SeverityCritical
-		endsAt = strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical))
+		endsAt = time.Now().Add(DefaultEndsAtDurationForSeverityCritical)
 	}
 	succeededOrFailedText := "failed"
 	if succeeded {
@@ -217,7 +340,7 @@ const (
 
 func alertClientSyncComplete(cfg *config.RocketPoolConfig, client ClientKind) error {
 	alertName := fmt.Sprintf("%sClientSyncComplete", client)
-	if !isAlertingEnabled(cfg) {
+	if !isNotifyingEnabled(cfg) {
 		logMessage("alerting is disabled, not sending %s.", alertName)
 		return nil
 	}
@@ -227,24 +350,12 @@ func alertClientSyncComplete(cfg *config.RocketPoolConfig, client ClientKind) er
 		fmt.Sprintf("%s Client Sync Complete", client),
 		fmt.Sprintf("The %s client has completed syncing.", client),
 		SeverityInfo,
-		strfmt.DateTime(time.Now().Add(time.Minute*1)),
+		time.Now().Add(time.Minute*1),
 		nil,
 	)
 	return sendAlert(alert, cfg)
 }
 
-func sendAlert(alert *models.PostableAlert, cfg *config.RocketPoolConfig) error {
-	logMessage("sending alert for %s: %s", alert.Labels["alertname"], alert.Annotations["summary"])
-
-	params := apialert.NewPostAlertsParams().WithDefaults().WithAlerts(models.PostableAlerts{alert})
-	client := createClient(cfg)
-	_, err := client.Alert.PostAlerts(params)
-	if err != nil {
-		return fmt.Errorf("error posting alert: %s", err.Error())
-	}
-	return nil
-}
-
 type Severity string
 
 const (
@@ -257,20 +368,15 @@ func isAlertingEnabled(cfg *config.RocketPoolConfig) bool {
 	return cfg.Alertmanager.EnableAlerting.Value == true
 }
 
-// Creates a uniform alert with the basic labels and annotations we expect.
-func createAlert(uniqueName string, summary string, description string, severity Severity, endsAt strfmt.DateTime, extraLabels map[string]string) *models.PostableAlert {
-	alert := &models.PostableAlert{
-		Annotations: map[string]string{
-			"description": description,
-			"summary":     summary,
-		},
-		Alert: models.Alert{
-			Labels: map[string]string{
-				"alertname": uniqueName,
-				"severity":  string(severity),
-			},
-		},
-		EndsAt: endsAt,
+// Creates a uniform alert with the basic labels we expect.
+func createAlert(uniqueName string, summary string, description string, severity Severity, endsAt time.Time, extraLabels map[string]string) *Alert {
+	alert := &Alert{
+		Name:        uniqueName,
+		Summary:     summary,
+		Description: description,
+		Severity:    severity,
+		EndsAt:      endsAt,
+		Labels:      map[string]string{},
 	}
 
 	for k, v := range extraLabels {
@@ -0,0 +1,34 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlertTracker(t *testing.T) {
+	tracker := &alertTracker{
+		endTimes: map[string]time.Time{},
+	}
+	newAlert := func(name string, endsAt time.Time) *Alert {
+		return &Alert{Name: name, EndsAt: endsAt}
+	}
+	later := time.Now().Add(time.Hour)
+
+	if !tracker.add(newAlert("DryRunTransaction-a", later)) {
+		t.Fatal("expected the first alert to be sent")
+	}
+	if tracker.add(newAlert("DryRunTransaction-a", later)) {
+		t.Fatal("expected a repeat of an active alert to be skipped")
+	}
+	if !tracker.add(newAlert("DryRunTransaction-b", later)) {
+		t.Fatal("expected an alert with a different name to be sent")
+	}
+
+	// Once an alert has ended, it can be sent again
+	if !tracker.add(newAlert("DryRunTransaction-c", time.Now().Add(-time.Second))) {
+		t.Fatal("expected the first alert to be sent")
+	}
+	if !tracker.add(newAlert("DryRunTransaction-c", later)) {
+		t.Fatal("expected an alert that has ended to be sent again")
+	}
+}
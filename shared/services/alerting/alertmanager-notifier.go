@@ -0,0 +1,52 @@
+package alerting
+
+import (
+	"github.com/go-openapi/strfmt"
+	apialert "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client/alert"
+	"github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/models"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// Sends alerts to the Alertmanager container/application
+type alertmanagerNotifier struct {
+	cfg *config.RocketPoolConfig
+}
+
+func newAlertmanagerNotifier(cfg *config.RocketPoolConfig) *alertmanagerNotifier {
+	return &alertmanagerNotifier{
+		cfg: cfg,
+	}
+}
+
+func (n *alertmanagerNotifier) Name() string {
+	return "alertmanager"
+}
+
+func (n *alertmanagerNotifier) Notify(alert *Alert) error {
+	params := apialert.NewPostAlertsParams().WithDefaults().WithAlerts(models.PostableAlerts{createPostableAlert(alert)})
+	client := createClient(n.cfg)
+	_, err := client.Alert.PostAlerts(params)
+	return err
+}
+
+// Converts an alert into the form Alertmanager expects, with the basic labels and annotations it uses.
+func createPostableAlert(alert *Alert) *models.PostableAlert {
+	postableAlert := &models.PostableAlert{
+		Annotations: map[string]string{
+			"description": alert.Description,
+			"summary":     alert.Summary,
+		},
+		Alert: models.Alert{
+			Labels: map[string]string{
+				"alertname": alert.Name,
+				"severity":  string(alert.Severity),
+			},
+		},
+		EndsAt: strfmt.DateTime(alert.EndsAt),
+	}
+
+	for k, v := range alert.Labels {
+		postableAlert.Labels[k] = v
+	}
+	return postableAlert
+}
@@ -0,0 +1,27 @@
+package alerting
+
+// Sends alerts to a Discord webhook
+type discordNotifier struct {
+	url string
+}
+
+// The body of a Discord webhook request
+type discordMessage struct {
+	Content string `json:"content"`
+}
+
+func newDiscordNotifier(url string) *discordNotifier {
+	return &discordNotifier{
+		url: url,
+	}
+}
+
+func (n *discordNotifier) Name() string {
+	return "discord"
+}
+
+func (n *discordNotifier) Notify(alert *Alert) error {
+	return postJson(n.url, discordMessage{
+		Content: formatAlertText(alert),
+	})
+}
@@ -0,0 +1,175 @@
+package alerting
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+const (
+	notifierRequestTimeout = time.Second * 15
+)
+
+// An alert raised by the node, independent of the sink it gets delivered to
+type Alert struct {
+	Name        string
+	Summary     string
+	Description string
+	Severity    Severity
+	EndsAt      time.Time
+	Labels      map[string]string
+}
+
+// A destination that alerts can be delivered to
+type Notifier interface {
+	// The name of the sink, used for logging
+	Name() string
+
+	// Deliver an alert to the sink
+	Notify(alert *Alert) error
+}
+
+// A notifier that only receives alerts at or above a minimum severity
+type filteredNotifier struct {
+	notifier    Notifier
+	minSeverity Severity
+}
+
+// Get the rank of a severity so they can be compared
+func (s Severity) rank() int {
+	switch s {
+	case SeverityCritical:
+		return 2
+	case SeverityWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Get all of the notification sinks that are enabled in the config; none are while alerting is disabled
+func getNotifiers(cfg *config.RocketPoolConfig) []filteredNotifier {
+	notifiers := []filteredNotifier{}
+	if !isAlertingEnabled(cfg) {
+		return notifiers
+	}
+	amCfg := cfg.Alertmanager
+
+	notifiers = append(notifiers, filteredNotifier{
+		notifier:    newAlertmanagerNotifier(cfg),
+		minSeverity: SeverityInfo,
+	})
+
+	if url := amCfg.NotifierWebhookURL.Value.(string); url != "" {
+		notifiers = append(notifiers, filteredNotifier{
+			notifier:    newWebhookNotifier(url),
+			minSeverity: getMinSeverity(&amCfg.NotifierWebhookMinSeverity),
+		})
+	}
+
+	if host := amCfg.NotifierSmtpHost.Value.(string); host != "" {
+		notifiers = append(notifiers, filteredNotifier{
+			notifier: newSmtpNotifier(
+				host,
+				amCfg.NotifierSmtpPort.Value.(uint16),
+				amCfg.NotifierSmtpUsername.Value.(string),
+				amCfg.NotifierSmtpPassword.Value.(string),
+				amCfg.NotifierSmtpFrom.Value.(string),
+				amCfg.NotifierSmtpTo.Value.(string),
+			),
+			minSeverity: getMinSeverity(&amCfg.NotifierSmtpMinSeverity),
+		})
+	}
+
+	if token := amCfg.NotifierTelegramBotToken.Value.(string); token != "" {
+		notifiers = append(notifiers, filteredNotifier{
+			notifier:    newTelegramNotifier(token, amCfg.NotifierTelegramChatID.Value.(string)),
+			minSeverity: getMinSeverity(&amCfg.NotifierTelegramMinSeverity),
+		})
+	}
+
+	if url := amCfg.NotifierDiscordWebhookURL.Value.(string); url != "" {
+		notifiers = append(notifiers, filteredNotifier{
+			notifier:    newDiscordNotifier(url),
+			minSeverity: getMinSeverity(&amCfg.NotifierDiscordMinSeverity),
+		})
+	}
+
+	return notifiers
+}
+
+// Get the minimum severity from a sink's severity filter setting
+func getMinSeverity(param *cfgtypes.Parameter) Severity {
+	severity, ok := param.Value.(cfgtypes.AlertSeverity)
+	if !ok {
+		return SeverityInfo
+	}
+	return Severity(severity)
+}
+
+// Check if any notification sinks are enabled
+func isNotifyingEnabled(cfg *config.RocketPoolConfig) bool {
+	return len(getNotifiers(cfg)) > 0
+}
+
+// Send an alert to every enabled sink that accepts its severity
+func sendAlert(alert *Alert, cfg *config.RocketPoolConfig) error {
+	logMessage("sending alert for %s: %s", alert.Name, alert.Summary)
+	return notifyAll(alert, getNotifiers(cfg))
+}
+
+// Send an alert to each of the provided sinks that accepts its severity
+func notifyAll(alert *Alert, sinks []filteredNotifier) error {
+	errs := []error{}
+	for _, sink := range sinks {
+		if alert.Severity.rank() < sink.minSeverity.rank() {
+			continue
+		}
+		err := sink.notifier.Notify(alert)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error sending alert to %s: %w", sink.notifier.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Format an alert as a short plain-text message for chat and email sinks
+func formatAlertText(alert *Alert) string {
+	return fmt.Sprintf("[%s] %s\n%s", alert.Severity, alert.Summary, alert.Description)
+}
+
+// POST a JSON body to a URL, treating any non-2xx response as an error
+func postJson(url string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error serializing request body: %w", err)
+	}
+
+	client := http.Client{
+		Timeout: notifierRequestTimeout,
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		// Webhook and bot URLs contain secrets, so don't include them in the error
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("error sending request: %w", urlErr.Err)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("request failed with code %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package alerting
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// A notifier that records the alerts it was sent
+type testNotifier struct {
+	name   string
+	alerts []string
+	err    error
+}
+
+func (n *testNotifier) Name() string {
+	return n.name
+}
+
+func (n *testNotifier) Notify(alert *Alert) error {
+	n.alerts = append(n.alerts, alert.Name)
+	return n.err
+}
+
+func TestNotifyAllFiltersBySeverity(t *testing.T) {
+	all := &testNotifier{name: "all"}
+	warnings := &testNotifier{name: "warnings"}
+	critical := &testNotifier{name: "critical", err: errors.New("unreachable")}
+	sinks := []filteredNotifier{
+		{notifier: all, minSeverity: SeverityInfo},
+		{notifier: warnings, minSeverity: SeverityWarning},
+		{notifier: critical, minSeverity: SeverityCritical},
+	}
+
+	for _, alert := range []*Alert{
+		{Name: "info", Severity: SeverityInfo},
+		{Name: "warning", Severity: SeverityWarning},
+	} {
+		if err := notifyAll(alert, sinks); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// A failing sink doesn't stop the others from getting the alert
+	err := notifyAll(&Alert{Name: "critical", Severity: SeverityCritical}, sinks)
+	if err == nil || !strings.Contains(err.Error(), "error sending alert to critical: unreachable") {
+		t.Fatalf("expected the critical sink's error, got %v", err)
+	}
+
+	if !slices.Equal(all.alerts, []string{"info", "warning", "critical"}) {
+		t.Fatalf("expected every alert to reach the unfiltered sink, got %v", all.alerts)
+	}
+	if !slices.Equal(warnings.alerts, []string{"warning", "critical"}) {
+		t.Fatalf("expected warnings and above to reach the warning sink, got %v", warnings.alerts)
+	}
+	if !slices.Equal(critical.alerts, []string{"critical"}) {
+		t.Fatalf("expected only critical alerts to reach the critical sink, got %v", critical.alerts)
+	}
+}
+
+func TestPostJson(t *testing.T) {
+	var contentType, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		bytes, _ := io.ReadAll(r.Body)
+		body = string(bytes)
+		if strings.HasSuffix(r.URL.Path, "/fail") {
+			http.Error(w, strings.Repeat("x", 4096), http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	if err := postJson(server.URL+"/ok", map[string]string{"text": "hello"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if contentType != "application/json" || body != `{"text":"hello"}` {
+		t.Fatalf("expected a JSON body, got %q (%s)", body, contentType)
+	}
+
+	// Error responses are reported with a truncated body
+	err := postJson(server.URL+"/fail", nil)
+	if err == nil || !strings.Contains(err.Error(), "code 400") {
+		t.Fatalf("expected a 400 error, got %v", err)
+	}
+	if len(err.Error()) > 1100 {
+		t.Fatalf("expected the response body to be truncated, got %d bytes", len(err.Error()))
+	}
+}
+
+func TestPostJsonRedactsUrl(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL + "/bot123456:secret-token/sendMessage"
+	server.Close()
+
+	err := postJson(url, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Fatalf("expected the URL to be left out of the error, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package alerting
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// The port used for SMTP over implicit TLS (SMTPS)
+	smtpImplicitTlsPort uint16 = 465
+)
+
+// Sends alerts as emails through an SMTP server
+type smtpNotifier struct {
+	host     string
+	port     uint16
+	username string
+	password string
+	from     string
+	to       []string
+
+	// The TLS config for implicit TLS connections, only overridden by tests
+	tlsConfig *tls.Config
+}
+
+func newSmtpNotifier(host string, port uint16, username string, password string, from string, to string) *smtpNotifier {
+	recipients := []string{}
+	for _, recipient := range strings.Split(to, ";") {
+		recipient = strings.TrimSpace(recipient)
+		if recipient != "" {
+			recipients = append(recipients, recipient)
+		}
+	}
+
+	return &smtpNotifier{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+		to:       recipients,
+		tlsConfig: &tls.Config{
+			ServerName: host,
+		},
+	}
+}
+
+func (n *smtpNotifier) Name() string {
+	return "smtp"
+}
+
+func (n *smtpNotifier) Notify(alert *Alert) error {
+	if n.from == "" {
+		return fmt.Errorf("no sender address is set")
+	}
+	if len(n.to) == 0 {
+		return fmt.Errorf("no recipient addresses are set")
+	}
+
+	auth := n.getAuth()
+	addr := net.JoinHostPort(n.host, strconv.FormatUint(uint64(n.port), 10))
+	message := n.buildMessage(alert)
+	if n.port == smtpImplicitTlsPort {
+		return n.sendMailImplicitTls(addr, auth, message)
+	}
+	return smtp.SendMail(addr, auth, n.from, n.to, message)
+}
+
+// Get the auth for the server. It's only used if credentials were provided; net/smtp refuses to send them without TLS.
+func (n *smtpNotifier) getAuth() smtp.Auth {
+	if n.username == "" {
+		return nil
+	}
+	return smtp.PlainAuth("", n.username, n.password, n.host)
+}
+
+// Build the email for an alert
+func (n *smtpNotifier) buildMessage(alert *Alert) []byte {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("From: %s\r\n", n.from))
+	builder.WriteString(fmt.Sprintf("To: %s\r\n", strings.Join(n.to, ", ")))
+	builder.WriteString(fmt.Sprintf("Subject: [Rocket Pool] [%s] %s\r\n", alert.Severity, alert.Summary))
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(alert.Description)
+	builder.WriteString("\r\n")
+	return []byte(builder.String())
+}
+
+// Send an email over a connection that uses TLS from the start, which smtp.SendMail doesn't support
+func (n *smtpNotifier) sendMailImplicitTls(addr string, auth smtp.Auth, message []byte) error {
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: notifierRequestTimeout},
+		Config:    n.tlsConfig,
+	}
+	conn, err := dialer.Dial("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("error connecting to SMTP server: %w", err)
+	}
+	if err := conn.SetDeadline(time.Now().Add(notifierRequestTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error starting SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("error authenticating with SMTP server: %w", err)
+		}
+	}
+	if err := client.Mail(n.from); err != nil {
+		return err
+	}
+	for _, recipient := range n.to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,127 @@
+package alerting
+
+import (
+	"bufio"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSmtpBuildMessage(t *testing.T) {
+	notifier := newSmtpNotifier("smtp.example.com", 587, "", "", "node@example.com", " ops@example.com; ; oncall@example.com ")
+	if len(notifier.to) != 2 {
+		t.Fatalf("expected 2 recipients, got %v", notifier.to)
+	}
+
+	message := string(notifier.buildMessage(&Alert{
+		Summary:     "Low ETH balance",
+		Description: "Your node is running low on ETH.",
+		Severity:    SeverityWarning,
+	}))
+	for _, expected := range []string{
+		"From: node@example.com\r\n",
+		"To: ops@example.com, oncall@example.com\r\n",
+		"Subject: [Rocket Pool] [warning] Low ETH balance\r\n",
+		"\r\n\r\nYour node is running low on ETH.\r\n",
+	} {
+		if !strings.Contains(message, expected) {
+			t.Fatalf("expected the message to contain %q, got %q", expected, message)
+		}
+	}
+}
+
+func TestSmtpRequiresAddresses(t *testing.T) {
+	if err := newSmtpNotifier("localhost", 25, "", "", "", "ops@example.com").Notify(&Alert{}); err == nil {
+		t.Fatal("expected an error without a sender")
+	}
+	if err := newSmtpNotifier("localhost", 25, "", "", "node@example.com", "").Notify(&Alert{}); err == nil {
+		t.Fatal("expected an error without recipients")
+	}
+}
+
+// Run a minimal SMTP server over TLS that records the commands and message it receives
+func runTestSmtpsServer(listener net.Listener, commands chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimSpace(line)
+		commands <- line
+		switch {
+		case strings.HasPrefix(line, "EHLO"):
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case strings.HasPrefix(line, "AUTH"):
+			reply("235 Authenticated")
+		case strings.HasPrefix(line, "DATA"):
+			reply("354 Go ahead")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			commands <- data.String()
+			reply("250 Queued")
+		case strings.HasPrefix(line, "QUIT"):
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSmtpImplicitTls(t *testing.T) {
+	// Borrow the test server's certificate, which is valid for 127.0.0.1
+	certServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer certServer.Close()
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", certServer.TLS)
+	if err != nil {
+		t.Fatalf("error starting listener: %v", err)
+	}
+	defer listener.Close()
+
+	commands := make(chan string, 32)
+	go runTestSmtpsServer(listener, commands)
+
+	notifier := newSmtpNotifier("127.0.0.1", 0, "user", "password", "node@example.com", "ops@example.com")
+	notifier.tlsConfig = certServer.Client().Transport.(*http.Transport).TLSClientConfig
+	addr := listener.Addr().String()
+	err = notifier.sendMailImplicitTls(addr, notifier.getAuth(), notifier.buildMessage(&Alert{Summary: "Test", Description: "Body", Severity: SeverityInfo}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	close(commands)
+
+	received := []string{}
+	for command := range commands {
+		received = append(received, command)
+	}
+	transcript := strings.Join(received, "\n")
+	for _, expected := range []string{"AUTH PLAIN", "MAIL FROM:<node@example.com>", "RCPT TO:<ops@example.com>", "Subject: [Rocket Pool] [info] Test", "QUIT"} {
+		if !strings.Contains(transcript, expected) {
+			t.Fatalf("expected the server to receive %q, got %q", expected, transcript)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package alerting
+
+import "fmt"
+
+const (
+	telegramSendMessageUrlFormat = "https://api.telegram.org/bot%s/sendMessage"
+)
+
+// Sends alerts to a Telegram chat through a bot
+type telegramNotifier struct {
+	token  string
+	chatID string
+}
+
+// The body of a Telegram sendMessage request
+type telegramMessage struct {
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+func newTelegramNotifier(token string, chatID string) *telegramNotifier {
+	return &telegramNotifier{
+		token:  token,
+		chatID: chatID,
+	}
+}
+
+func (n *telegramNotifier) Name() string {
+	return "telegram"
+}
+
+func (n *telegramNotifier) Notify(alert *Alert) error {
+	if n.chatID == "" {
+		return fmt.Errorf("no chat ID is set")
+	}
+	return postJson(fmt.Sprintf(telegramSendMessageUrlFormat, n.token), telegramMessage{
+		ChatID: n.chatID,
+		Text:   formatAlertText(alert),
+	})
+}
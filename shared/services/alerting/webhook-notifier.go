@@ -0,0 +1,39 @@
+package alerting
+
+import "time"
+
+// Sends alerts to a generic webhook as JSON
+type webhookNotifier struct {
+	url string
+}
+
+// The body POSTed to the webhook for each alert
+type webhookAlert struct {
+	Name        string            `json:"name"`
+	Summary     string            `json:"summary"`
+	Description string            `json:"description"`
+	Severity    Severity          `json:"severity"`
+	EndsAt      time.Time         `json:"endsAt"`
+	Labels      map[string]string `json:"labels"`
+}
+
+func newWebhookNotifier(url string) *webhookNotifier {
+	return &webhookNotifier{
+		url: url,
+	}
+}
+
+func (n *webhookNotifier) Name() string {
+	return "webhook"
+}
+
+func (n *webhookNotifier) Notify(alert *Alert) error {
+	return postJson(n.url, webhookAlert{
+		Name:        alert.Name,
+		Summary:     alert.Summary,
+		Description: alert.Description,
+		Severity:    alert.Severity,
+		EndsAt:      alert.EndsAt,
+		Labels:      alert.Labels,
+	})
+}
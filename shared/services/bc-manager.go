@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"strings"
@@ -183,6 +184,13 @@ func (m *BeaconClientManager) GetBeaconHead() (beacon.BeaconHead, error) {
 	return result.(beacon.BeaconHead), nil
 }
 
+// Stream events for the provided topics into the channel until the context is cancelled or the stream fails
+func (m *BeaconClientManager) SubscribeEvents(ctx context.Context, topics []beacon.EventTopic, events chan<- beacon.Event) error {
+	return m.runFunction0(func(client beacon.Client) error {
+		return client.SubscribeEvents(ctx, topics, events)
+	})
+}
+
 // Get the Beacon State information
 func (m *BeaconClientManager) GetBeaconStateSSZ(slot uint64) (*beacon.BeaconStateSSZ, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
@@ -363,6 +371,39 @@ func (m *BeaconClientManager) GetValidatorBalances(indices []string, opts *beaco
 	return result.(map[string]*big.Int), nil
 }
 
+// Get the consolidations waiting to be processed in a beacon state
+func (m *BeaconClientManager) GetPendingConsolidations(stateId string) ([]beacon.PendingConsolidation, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPendingConsolidations(stateId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.PendingConsolidation), nil
+}
+
+// Get the deposits waiting to be applied in a beacon state
+func (m *BeaconClientManager) GetPendingDeposits(stateId string) ([]beacon.PendingDeposit, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPendingDeposits(stateId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.PendingDeposit), nil
+}
+
+// Get the partial withdrawals waiting to be processed in a beacon state
+func (m *BeaconClientManager) GetPendingPartialWithdrawals(stateId string) ([]beacon.PendingPartialWithdrawal, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetPendingPartialWithdrawals(stateId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.PendingPartialWithdrawal), nil
+}
+
 /// ==================
 /// Internal Functions
 /// ==================
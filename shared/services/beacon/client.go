@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"context"
 	"math/big"
 	"sort"
 
@@ -63,9 +64,25 @@ type BeaconBlock struct {
 	ExecutionBlockNumber uint64
 	Withdrawals          []WithdrawalInfo
 }
+type PendingConsolidation struct {
+	SourceIndex string
+	TargetIndex string
+}
+type PendingDeposit struct {
+	Pubkey                types.ValidatorPubkey
+	WithdrawalCredentials common.Hash
+	Amount                uint64
+	Slot                  uint64
+}
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    string
+	Amount            uint64
+	WithdrawableEpoch uint64
+}
 type BeaconBlockHeader struct {
 	Slot          uint64
 	ProposerIndex string
+	StateRoot     common.Hash
 }
 
 // Committees is an interface as an optimization- since committees responses
@@ -120,6 +137,28 @@ func (a AttestationInfo) ValidatorAttested(committeeIndex int, position int, com
 	return a.AggregationBits.BitAt(uint64(offset))
 }
 
+// A topic on the Beacon node's event stream
+type EventTopic string
+
+const (
+	EventTopic_Head                EventTopic = "head"
+	EventTopic_FinalizedCheckpoint EventTopic = "finalized_checkpoint"
+	EventTopic_ChainReorg          EventTopic = "chain_reorg"
+	EventTopic_VoluntaryExit       EventTopic = "voluntary_exit"
+)
+
+// An event from the Beacon node's event stream.
+// Only the fields that apply to the event's topic are set.
+type Event struct {
+	Topic          EventTopic
+	Slot           uint64
+	Epoch          uint64
+	BlockRoot      common.Hash
+	EpochChange    bool
+	ReorgDepth     uint64
+	ValidatorIndex string
+}
+
 // Beacon client type
 type BeaconClientType int
 
@@ -154,7 +193,7 @@ const (
 
 // SSZ response go into these wrapper types.
 // You can stream Data into a deserializer.
-// Fork is the consensus version, e.g, "deneb" or "electra"
+// Fork is the consensus version, e.g, "electra" or "fulu"
 
 type BeaconStateSSZ struct {
 	Data []byte
@@ -185,6 +224,9 @@ type Client interface {
 	GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error)
 	GetValidatorBalances(indices []string, opts *ValidatorStatusOptions) (map[string]*big.Int, error)
 	GetValidatorBalancesSafe(indices []string, opts *ValidatorStatusOptions) (map[string]*big.Int, error)
+	GetPendingConsolidations(stateId string) ([]PendingConsolidation, error)
+	GetPendingDeposits(stateId string) ([]PendingDeposit, error)
+	GetPendingPartialWithdrawals(stateId string) ([]PendingPartialWithdrawal, error)
 	GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error)
 	ExitValidator(validatorIndex string, epoch uint64, signature types.ValidatorSignature) error
 	Close() error
@@ -194,4 +236,7 @@ type Client interface {
 
 	GetBeaconStateSSZ(slot uint64) (*BeaconStateSSZ, error)
 	GetBeaconBlockSSZ(slot uint64) (*BeaconBlockSSZ, bool, error)
+
+	// Stream events for the provided topics into the channel until the context is cancelled or the stream fails
+	SubscribeEvents(ctx context.Context, topics []EventTopic, events chan<- Event) error
 }
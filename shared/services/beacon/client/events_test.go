@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func TestReadEvents(t *testing.T) {
+	stream := strings.Join([]string{
+		": keep-alive",
+		"",
+		"event: head",
+		`data: {"slot":"10","block":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","state":"0x600e852a08c1200654ddf11025f1ceacb3c2e74bdd5c630cde0838b2591b69f9","epoch_transition":true}`,
+		"",
+		"event: block_gossip",
+		`data: {"slot":"10"}`,
+		"",
+		"event: voluntary_exit",
+		`data: {"message":{"epoch":"1","validator_index":"42"},`,
+		`data: "signature":"0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"}`,
+		"",
+		"event: chain_reorg",
+		`data: {"slot":"200","depth":"3","old_head_block":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","new_head_block":"0x76262e91970d375a19bfe8a867288d7b9cde43c8635f598d93d39d041706fc76","epoch":"6"}`,
+		"",
+		"",
+	}, "\n")
+
+	events := make(chan beacon.Event, 10)
+	err := readEvents(context.Background(), strings.NewReader(stream), events)
+	if err == nil {
+		t.Fatal("expected an error when the stream ends")
+	}
+	close(events)
+
+	received := []beacon.Event{}
+	for event := range events {
+		received = append(received, event)
+	}
+	if len(received) != 3 {
+		t.Fatalf("expected 3 events, got %d: %+v", len(received), received)
+	}
+	if received[0].Topic != beacon.EventTopic_Head || received[0].Slot != 10 || !received[0].EpochChange {
+		t.Errorf("unexpected head event: %+v", received[0])
+	}
+	if received[1].Topic != beacon.EventTopic_VoluntaryExit || received[1].ValidatorIndex != "42" || received[1].Epoch != 1 {
+		t.Errorf("unexpected voluntary exit event: %+v", received[1])
+	}
+	if received[2].Topic != beacon.EventTopic_ChainReorg || received[2].Slot != 200 || received[2].ReorgDepth != 3 || received[2].Epoch != 6 {
+		t.Errorf("unexpected reorg event: %+v", received[2])
+	}
+}
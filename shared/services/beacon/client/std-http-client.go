@@ -1,7 +1,9 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -32,6 +34,7 @@ const (
 	RequestUrlFormat               = "%s%s"
 	RequestJsonContentType         = "application/json"
 	RequestSSZContentType          = "application/octet-stream"
+	RequestEventStreamContentType  = "text/event-stream"
 	ResponseConsensusVersionHeader = "Eth-Consensus-Version"
 
 	RequestSyncStatusPath                  = "/eth/v1/node/syncing"
@@ -43,6 +46,9 @@ const (
 	RequestForkPath                        = "/eth/v1/beacon/states/%s/fork"
 	RequestValidatorsPath                  = "/eth/v1/beacon/states/%s/validators"
 	RequestValidatorBalancesPath           = "/eth/v1/beacon/states/%s/validator_balances"
+	RequestPendingConsolidationsPath       = "/eth/v1/beacon/states/%s/pending_consolidations"
+	RequestPendingDepositsPath             = "/eth/v1/beacon/states/%s/pending_deposits"
+	RequestPendingPartialWithdrawalsPath   = "/eth/v1/beacon/states/%s/pending_partial_withdrawals"
 	RequestVoluntaryExitPath               = "/eth/v1/beacon/pool/voluntary_exits"
 	RequestAttestationsPath                = "/eth/v1/beacon/blocks/%s/attestations"
 	RequestBeaconBlockPath                 = "/eth/v2/beacon/blocks/%s"
@@ -51,6 +57,7 @@ const (
 	RequestValidatorSyncDuties             = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties         = "/eth/v1/validator/duties/proposer/%s"
 	RequestWithdrawalCredentialsChangePath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	RequestEventsPath                      = "/eth/v1/events?topics=%s"
 
 	MaxRequestValidatorsCount     = 600
 	threadLimit               int = 12
@@ -144,6 +151,7 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 		SlotsPerEpoch:                uint64(eth2Config.Data.SlotsPerEpoch),
 		SecondsPerEpoch:              uint64(eth2Config.Data.SecondsPerSlot * eth2Config.Data.SlotsPerEpoch),
 		EpochsPerSyncCommitteePeriod: uint64(eth2Config.Data.EpochsPerSyncCommitteePeriod),
+		CapellaForkVersion:           eth2Config.Data.CapellaForkVersion,
 	}
 	eth2ConfigCache.Store(&out)
 
@@ -251,6 +259,81 @@ func (c *StandardHttpClient) getValidatorStatus(pubkeyOrIndex string, opts *beac
 
 }
 
+// Get the consolidations waiting to be processed in a beacon state
+func (c *StandardHttpClient) GetPendingConsolidations(stateId string) ([]beacon.PendingConsolidation, error) {
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestPendingConsolidationsPath, stateId))
+	if err != nil {
+		return nil, fmt.Errorf("Could not get pending consolidations: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get pending consolidations: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var response PendingConsolidationsResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode pending consolidations: %w", err)
+	}
+
+	consolidations := make([]beacon.PendingConsolidation, len(response.Data))
+	for i, consolidation := range response.Data {
+		consolidations[i] = beacon.PendingConsolidation{
+			SourceIndex: strconv.FormatUint(uint64(consolidation.SourceIndex), 10),
+			TargetIndex: strconv.FormatUint(uint64(consolidation.TargetIndex), 10),
+		}
+	}
+	return consolidations, nil
+}
+
+// Get the deposits waiting to be applied in a beacon state
+func (c *StandardHttpClient) GetPendingDeposits(stateId string) ([]beacon.PendingDeposit, error) {
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestPendingDepositsPath, stateId))
+	if err != nil {
+		return nil, fmt.Errorf("Could not get pending deposits: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get pending deposits: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var response PendingDepositsResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode pending deposits: %w", err)
+	}
+
+	deposits := make([]beacon.PendingDeposit, len(response.Data))
+	for i, deposit := range response.Data {
+		deposits[i] = beacon.PendingDeposit{
+			Pubkey:                types.BytesToValidatorPubkey(deposit.Pubkey),
+			WithdrawalCredentials: common.BytesToHash(deposit.WithdrawalCredentials),
+			Amount:                uint64(deposit.Amount),
+			Slot:                  uint64(deposit.Slot),
+		}
+	}
+	return deposits, nil
+}
+
+// Get the partial withdrawals waiting to be processed in a beacon state
+func (c *StandardHttpClient) GetPendingPartialWithdrawals(stateId string) ([]beacon.PendingPartialWithdrawal, error) {
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestPendingPartialWithdrawalsPath, stateId))
+	if err != nil {
+		return nil, fmt.Errorf("Could not get pending partial withdrawals: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get pending partial withdrawals: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var response PendingPartialWithdrawalsResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode pending partial withdrawals: %w", err)
+	}
+
+	withdrawals := make([]beacon.PendingPartialWithdrawal, len(response.Data))
+	for i, withdrawal := range response.Data {
+		withdrawals[i] = beacon.PendingPartialWithdrawal{
+			ValidatorIndex:    strconv.FormatUint(uint64(withdrawal.ValidatorIndex), 10),
+			Amount:            uint64(withdrawal.Amount),
+			WithdrawableEpoch: uint64(withdrawal.WithdrawableEpoch),
+		}
+	}
+	return withdrawals, nil
+}
+
 // Get multiple validators' balances
 func (c *StandardHttpClient) GetValidatorBalances(indices []string, opts *beacon.ValidatorStatusOptions) (map[string]*big.Int, error) {
 
@@ -715,6 +798,7 @@ func (c *StandardHttpClient) GetBeaconBlockHeader(blockId string) (beacon.Beacon
 	beaconBlock := beacon.BeaconBlockHeader{
 		Slot:          uint64(block.Data.Header.Message.Slot),
 		ProposerIndex: block.Data.Header.Message.ProposerIndex,
+		StateRoot:     common.BytesToHash(block.Data.Header.Message.StateRoot),
 	}
 	return beaconBlock, true, nil
 }
@@ -741,6 +825,122 @@ func (c *StandardHttpClient) ChangeWithdrawalCredentials(validatorIndex string,
 	})
 }
 
+// Stream events for the provided topics into the channel until the context is cancelled or the stream fails
+func (c *StandardHttpClient) SubscribeEvents(ctx context.Context, topics []beacon.EventTopic, events chan<- beacon.Event) error {
+	topicNames := make([]string, len(topics))
+	for i, topic := range topics {
+		topicNames[i] = string(topic)
+	}
+	requestPath := fmt.Sprintf(RequestEventsPath, strings.Join(topicNames, ","))
+	request, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), nil)
+	if err != nil {
+		return fmt.Errorf("Could not subscribe to events: %w", err)
+	}
+	request.Header.Set("Accept", RequestEventStreamContentType)
+
+	// The stream stays open indefinitely, so this can't use a client with a timeout
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("Could not subscribe to events: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("Could not subscribe to events: HTTP status %d; response body: '%s'", response.StatusCode, string(responseBody))
+	}
+
+	err = readEvents(ctx, response.Body, events)
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// Read server-sent events from a stream and pass the ones with a known topic to the channel
+func readEvents(ctx context.Context, reader io.Reader, events chan<- beacon.Event) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var topic string
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// A blank line ends the event
+			if topic != "" && len(data) > 0 {
+				event, known, err := parseEvent(topic, []byte(strings.Join(data, "\n")))
+				if err != nil {
+					return err
+				}
+				if known {
+					select {
+					case events <- event:
+					case <-ctx.Done():
+						return nil
+					}
+				}
+			}
+			topic = ""
+			data = nil
+		case strings.HasPrefix(line, ":"):
+			// Comments are used as keep-alives
+		case strings.HasPrefix(line, "event:"):
+			topic = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("Could not read event stream: %w", err)
+	}
+	return fmt.Errorf("Could not read event stream: the Beacon node closed it")
+}
+
+// Decode the data of an event, returning false if its topic isn't one the client knows about
+func parseEvent(topic string, data []byte) (beacon.Event, bool, error) {
+	event := beacon.Event{
+		Topic: beacon.EventTopic(topic),
+	}
+	var err error
+	switch event.Topic {
+	case beacon.EventTopic_Head:
+		var head HeadEvent
+		err = json.Unmarshal(data, &head)
+		event.Slot = uint64(head.Slot)
+		event.BlockRoot = head.Block
+		event.EpochChange = head.EpochTransition
+	case beacon.EventTopic_FinalizedCheckpoint:
+		var checkpoint FinalizedCheckpointEvent
+		err = json.Unmarshal(data, &checkpoint)
+		event.Epoch = uint64(checkpoint.Epoch)
+		event.BlockRoot = checkpoint.Block
+	case beacon.EventTopic_ChainReorg:
+		var reorg ChainReorgEvent
+		err = json.Unmarshal(data, &reorg)
+		event.Slot = uint64(reorg.Slot)
+		event.Epoch = uint64(reorg.Epoch)
+		event.BlockRoot = reorg.NewHeadBlock
+		event.ReorgDepth = uint64(reorg.Depth)
+	case beacon.EventTopic_VoluntaryExit:
+		var exit VoluntaryExitEvent
+		err = json.Unmarshal(data, &exit)
+		event.Epoch = uint64(exit.Message.Epoch)
+		event.ValidatorIndex = exit.Message.ValidatorIndex
+	default:
+		return beacon.Event{}, false, nil
+	}
+	if err != nil {
+		return beacon.Event{}, false, fmt.Errorf("Could not decode %s event: %w", topic, err)
+	}
+	return event, true, nil
+}
+
 // Get sync status
 func (c *StandardHttpClient) getSyncStatus() (SyncStatusResponse, error) {
 	responseBody, status, err := c.getRequest(RequestSyncStatusPath)
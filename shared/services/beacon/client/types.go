@@ -80,6 +80,27 @@ type ForkResponse struct {
 		Epoch           uinteger  `json:"epoch"`
 	} `json:"data"`
 }
+type PendingConsolidationsResponse struct {
+	Data []struct {
+		SourceIndex uinteger `json:"source_index"`
+		TargetIndex uinteger `json:"target_index"`
+	} `json:"data"`
+}
+type PendingDepositsResponse struct {
+	Data []struct {
+		Pubkey                byteArray `json:"pubkey"`
+		WithdrawalCredentials byteArray `json:"withdrawal_credentials"`
+		Amount                uinteger  `json:"amount"`
+		Slot                  uinteger  `json:"slot"`
+	} `json:"data"`
+}
+type PendingPartialWithdrawalsResponse struct {
+	Data []struct {
+		ValidatorIndex    uinteger `json:"validator_index"`
+		Amount            uinteger `json:"amount"`
+		WithdrawableEpoch uinteger `json:"withdrawable_epoch"`
+	} `json:"data"`
+}
 type AttestationsResponse struct {
 	Data []Attestation `json:"data"`
 }
@@ -111,8 +132,9 @@ type BeaconBlockHeaderResponse struct {
 		Canonical bool   `json:"canonical"`
 		Header    struct {
 			Message struct {
-				Slot          uinteger `json:"slot"`
-				ProposerIndex string   `json:"proposer_index"`
+				Slot          uinteger  `json:"slot"`
+				ProposerIndex string    `json:"proposer_index"`
+				StateRoot     byteArray `json:"state_root"`
 			} `json:"message"`
 		} `json:"header"`
 	} `json:"data"`
@@ -156,6 +178,26 @@ type ProposerDuty struct {
 	ValidatorIndex string `json:"validator_index"`
 }
 
+// Event stream data, by topic
+type HeadEvent struct {
+	Slot            uinteger    `json:"slot"`
+	Block           common.Hash `json:"block"`
+	EpochTransition bool        `json:"epoch_transition"`
+}
+type FinalizedCheckpointEvent struct {
+	Block common.Hash `json:"block"`
+	Epoch uinteger    `json:"epoch"`
+}
+type ChainReorgEvent struct {
+	Slot         uinteger    `json:"slot"`
+	Depth        uinteger    `json:"depth"`
+	NewHeadBlock common.Hash `json:"new_head_block"`
+	Epoch        uinteger    `json:"epoch"`
+}
+type VoluntaryExitEvent struct {
+	Message VoluntaryExitMessage `json:"message"`
+}
+
 type CommitteesResponse struct {
 	Data []Committee `json:"data"`
 }
@@ -17,19 +17,22 @@ type Eth2Config struct {
 	SlotsPerEpoch                uint64 `json:"slots_per_epoch"`
 	SecondsPerEpoch              uint64 `json:"seconds_per_epoch"`
 	EpochsPerSyncCommitteePeriod uint64 `json:"epochs_per_sync_committee_period"`
+	CapellaForkVersion           []byte `json:"capella_fork_version"`
 }
 
 func (c *Eth2Config) MarshalJSON() ([]byte, error) {
-	// GenesisForkVersion and GenesisValidatorsRoot are returned as hex strings with 0x prefixes.
+	// GenesisForkVersion, GenesisValidatorsRoot, and CapellaForkVersion are returned as hex strings with 0x prefixes.
 	// The other fields are returned as uint64s.
 	type Alias Eth2Config
 	return json.Marshal(&struct {
 		GenesisForkVersion    string `json:"genesis_fork_version"`
 		GenesisValidatorsRoot string `json:"genesis_validators_root"`
+		CapellaForkVersion    string `json:"capella_fork_version"`
 		*Alias
 	}{
 		GenesisForkVersion:    hexutil.Encode(c.GenesisForkVersion),
 		GenesisValidatorsRoot: hexutil.Encode(c.GenesisValidatorsRoot),
+		CapellaForkVersion:    hexutil.Encode(c.CapellaForkVersion),
 		Alias:                 (*Alias)(c),
 	})
 }
@@ -39,6 +42,7 @@ func (c *Eth2Config) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		GenesisForkVersion    string `json:"genesis_fork_version"`
 		GenesisValidatorsRoot string `json:"genesis_validators_root"`
+		CapellaForkVersion    string `json:"capella_fork_version"`
 		*Alias
 	}{
 		Alias: (*Alias)(c),
@@ -57,6 +61,14 @@ func (c *Eth2Config) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
+
+	// Configs serialized before the Capella fork version was added won't have it
+	if aux.CapellaForkVersion != "" {
+		c.CapellaForkVersion, err = hexutil.Decode(aux.CapellaForkVersion)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -59,6 +59,28 @@ type AlertmanagerConfig struct {
 	// The Pushover User Key for alert notifications
 	PushoverUserKey config.Parameter `yaml:"pushoverUserKey,omitempty"`
 
+	// The generic webhook the node sends alerts to directly
+	NotifierWebhookURL         config.Parameter `yaml:"notifierWebhookURL,omitempty"`
+	NotifierWebhookMinSeverity config.Parameter `yaml:"notifierWebhookMinSeverity,omitempty"`
+
+	// The SMTP server the node sends alert emails through directly
+	NotifierSmtpHost        config.Parameter `yaml:"notifierSmtpHost,omitempty"`
+	NotifierSmtpPort        config.Parameter `yaml:"notifierSmtpPort,omitempty"`
+	NotifierSmtpUsername    config.Parameter `yaml:"notifierSmtpUsername,omitempty"`
+	NotifierSmtpPassword    config.Parameter `yaml:"notifierSmtpPassword,omitempty"`
+	NotifierSmtpFrom        config.Parameter `yaml:"notifierSmtpFrom,omitempty"`
+	NotifierSmtpTo          config.Parameter `yaml:"notifierSmtpTo,omitempty"`
+	NotifierSmtpMinSeverity config.Parameter `yaml:"notifierSmtpMinSeverity,omitempty"`
+
+	// The Telegram bot the node sends alerts through directly
+	NotifierTelegramBotToken    config.Parameter `yaml:"notifierTelegramBotToken,omitempty"`
+	NotifierTelegramChatID      config.Parameter `yaml:"notifierTelegramChatID,omitempty"`
+	NotifierTelegramMinSeverity config.Parameter `yaml:"notifierTelegramMinSeverity,omitempty"`
+
+	// The Discord webhook the node sends alerts to directly
+	NotifierDiscordWebhookURL  config.Parameter `yaml:"notifierDiscordWebhookURL,omitempty"`
+	NotifierDiscordMinSeverity config.Parameter `yaml:"notifierDiscordMinSeverity,omitempty"`
+
 	// Alerts configured in prometheus rule configuration file:
 	AlertEnabled_ClientSyncStatusBeacon    config.Parameter `yaml:"alertEnabled_ClientSyncStatusBeacon,omitempty"`
 	AlertEnabled_ClientSyncStatusExecution config.Parameter `yaml:"alertEnabled_ClientSyncStatusExecution,omitempty"`
@@ -78,6 +100,8 @@ type AlertmanagerConfig struct {
 	AlertEnabled_MinipoolBalanceDistributed  config.Parameter `yaml:"alertEnabled_MinipoolBalanceDistributed,omitempty"`
 	AlertEnabled_MinipoolPromoted            config.Parameter `yaml:"alertEnabled_MinipoolPromoted,omitempty"`
 	AlertEnabled_MinipoolStaked              config.Parameter `yaml:"alertEnabled_MinipoolStaked,omitempty"`
+	AlertEnabled_DryRunTransaction           config.Parameter `yaml:"alertEnabled_DryRunTransaction,omitempty"`
+	AlertEnabled_PdaoVotingDecision          config.Parameter `yaml:"alertEnabled_PdaoVotingDecision,omitempty"`
 	AlertEnabled_ExecutionClientSyncComplete config.Parameter `yaml:"alertEnabled_ExecutionClientSyncComplete,omitempty"`
 	AlertEnabled_BeaconClientSyncComplete    config.Parameter `yaml:"alertEnabled_BeaconClientSyncComplete,omitempty"`
 }
@@ -92,7 +116,7 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 		EnableAlerting: config.Parameter{
 			ID:                 "enableAlerting",
 			Name:               "Enable Alerting",
-			Description:        "Enable the Smartnode's alerting system. This will provide you alerts when important events occur with your node.",
+			Description:        "Enable the Smartnode's alerting system. This will provide you alerts when important events occur with your node, through Alertmanager and any of the notification sinks below.",
 			Type:               config.ParameterType_Bool,
 			Default:            map[config.Network]interface{}{config.Network_All: true},
 			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Prometheus, config.ContainerID_Alertmanager},
@@ -189,6 +213,124 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		NotifierWebhookURL: config.Parameter{
+			ID:                 "notifierWebhookURL",
+			Name:               "Notification Webhook URL",
+			Description:        "The node will POST each of its alerts to this URL as JSON, without going through Alertmanager. Use this if you don't run the metrics stack, or want to forward alerts to your own service.\n\nLeave it blank to disable this notification sink.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierWebhookMinSeverity: createParameterForNotifierSeverity("notifierWebhookMinSeverity", "Notification Webhook"),
+
+		NotifierSmtpHost: config.Parameter{
+			ID:                 "notifierSmtpHost",
+			Name:               "Notification SMTP Host",
+			Description:        "The hostname of the SMTP server the node will send alert emails through, without going through Alertmanager.\n\nLeave it blank to disable this notification sink.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierSmtpPort: config.Parameter{
+			ID:                 "notifierSmtpPort",
+			Name:               "Notification SMTP Port",
+			Description:        "The port of the SMTP server. Port 465 uses implicit TLS; on any other port, the connection is upgraded with STARTTLS if the server supports it.",
+			Type:               config.ParameterType_Uint16,
+			Default:            map[config.Network]interface{}{config.Network_All: uint16(587)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierSmtpUsername: config.Parameter{
+			ID:                 "notifierSmtpUsername",
+			Name:               "Notification SMTP Username",
+			Description:        "The username to log into the SMTP server with. Leave it blank if the server doesn't require authentication.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierSmtpPassword: config.Parameter{
+			ID:                 "notifierSmtpPassword",
+			Name:               "Notification SMTP Password",
+			Description:        "The password to log into the SMTP server with.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierSmtpFrom: config.Parameter{
+			ID:                 "notifierSmtpFrom",
+			Name:               "Notification Email Sender",
+			Description:        "The email address alert emails will be sent from.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierSmtpTo: config.Parameter{
+			ID:                 "notifierSmtpTo",
+			Name:               "Notification Email Recipients",
+			Description:        "The email addresses alert emails will be sent to. Multiple addresses can be provided using ';' as a separator.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierSmtpMinSeverity: createParameterForNotifierSeverity("notifierSmtpMinSeverity", "Notification Email"),
+
+		NotifierTelegramBotToken: config.Parameter{
+			ID:                 "notifierTelegramBotToken",
+			Name:               "Notification Telegram Bot Token",
+			Description:        "The token of the Telegram bot the node will send alerts through, without going through Alertmanager. See https://core.telegram.org/bots#how-do-i-create-a-bot to learn how to create one.\n\nLeave it blank to disable this notification sink.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierTelegramChatID: config.Parameter{
+			ID:                 "notifierTelegramChatID",
+			Name:               "Notification Telegram Chat ID",
+			Description:        "The ID of the Telegram chat the bot will send alerts to.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierTelegramMinSeverity: createParameterForNotifierSeverity("notifierTelegramMinSeverity", "Notification Telegram"),
+
+		NotifierDiscordWebhookURL: config.Parameter{
+			ID:                 "notifierDiscordWebhookURL",
+			Name:               "Notification Discord Webhook URL",
+			Description:        "The node will send its alerts to this Discord webhook directly, without going through Alertmanager. Unlike the Alertmanager Discord Webhook URL, this works even if you don't run the metrics stack.\n\nLeave it blank to disable this notification sink.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NotifierDiscordMinSeverity: createParameterForNotifierSeverity("notifierDiscordMinSeverity", "Notification Discord"),
+
 		AlertEnabled_ClientSyncStatusBeacon: createParameterForAlertEnablement(
 			"ClientSyncStatusBeacon",
 			"beacon client is not synced"),
@@ -249,6 +391,14 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			"MinipoolStaked",
 			"Minipool Staked"),
 
+		AlertEnabled_DryRunTransaction: createParameterForAlertEnablement(
+			"DryRunTransaction",
+			"a daemon transaction is simulated in dry-run mode"),
+
+		AlertEnabled_PdaoVotingDecision: createParameterForAlertEnablement(
+			"PdaoVotingDecision",
+			"the voting policy decides how to vote on a Protocol DAO proposal"),
+
 		AlertEnabled_ExecutionClientSyncComplete: createParameterForAlertEnablement(
 			"ExecutionClientSyncComplete",
 			"execution client is synced"),
@@ -287,6 +437,32 @@ func createParameterForAlertEnablement(uniqueName string, label string) config.P
 	}
 }
 
+func createParameterForNotifierSeverity(id string, sinkName string) config.Parameter {
+	return config.Parameter{
+		ID:                 id,
+		Name:               fmt.Sprintf("%s Minimum Severity", sinkName),
+		Description:        "Only alerts with at least this severity will be sent to this notification sink.",
+		Type:               config.ParameterType_Choice,
+		Default:            map[config.Network]interface{}{config.Network_All: config.AlertSeverity_Info},
+		AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+		CanBeBlank:         false,
+		OverwriteOnUpgrade: false,
+		Options: []config.ParameterOption{{
+			Name:        "Info",
+			Description: "Send every alert.",
+			Value:       config.AlertSeverity_Info,
+		}, {
+			Name:        "Warning",
+			Description: "Send warnings and critical alerts.",
+			Value:       config.AlertSeverity_Warning,
+		}, {
+			Name:        "Critical",
+			Description: "Only send critical alerts, such as failed transactions.",
+			Value:       config.AlertSeverity_Critical,
+		}},
+	}
+}
+
 func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.EnableAlerting,
@@ -297,6 +473,20 @@ func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 		&cfg.DiscordWebhookURL,
 		&cfg.PushoverToken,
 		&cfg.PushoverUserKey,
+		&cfg.NotifierWebhookURL,
+		&cfg.NotifierWebhookMinSeverity,
+		&cfg.NotifierSmtpHost,
+		&cfg.NotifierSmtpPort,
+		&cfg.NotifierSmtpUsername,
+		&cfg.NotifierSmtpPassword,
+		&cfg.NotifierSmtpFrom,
+		&cfg.NotifierSmtpTo,
+		&cfg.NotifierSmtpMinSeverity,
+		&cfg.NotifierTelegramBotToken,
+		&cfg.NotifierTelegramChatID,
+		&cfg.NotifierTelegramMinSeverity,
+		&cfg.NotifierDiscordWebhookURL,
+		&cfg.NotifierDiscordMinSeverity,
 		&cfg.ContainerTag,
 		&cfg.AlertEnabled_ClientSyncStatusBeacon,
 		&cfg.AlertEnabled_ClientSyncStatusExecution,
@@ -313,6 +503,8 @@ func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 		&cfg.AlertEnabled_MinipoolBalanceDistributed,
 		&cfg.AlertEnabled_MinipoolPromoted,
 		&cfg.AlertEnabled_MinipoolStaked,
+		&cfg.AlertEnabled_DryRunTransaction,
+		&cfg.AlertEnabled_PdaoVotingDecision,
 		&cfg.AlertEnabled_ExecutionClientSyncComplete,
 		&cfg.AlertEnabled_BeaconClientSyncComplete,
 		&cfg.AlertEnabled_LowETHBalance,
@@ -1150,6 +1150,11 @@ func (cfg *RocketPoolConfig) FeeRecipientFile() string {
 	return FeeRecipientFilename
 }
 
+// Used by text/template to format validator.yml
+func (cfg *RocketPoolConfig) KeymanagerTokenFile() string {
+	return KeymanagerTokenFilename
+}
+
 // Used by text/template to format validator.yml
 func (cfg *RocketPoolConfig) MevBoostUrl() string {
 	if !cfg.EnableMevBoost.Value.(bool) {
@@ -1163,6 +1168,15 @@ func (cfg *RocketPoolConfig) MevBoostUrl() string {
 	return cfg.MevBoost.ExternalUrl.Value.(string)
 }
 
+// Used by text/template to format validator.yml
+func (cfg *RocketPoolConfig) RemoteSignerUrl() string {
+	if !cfg.Smartnode.UseRemoteSigner.Value.(bool) {
+		return ""
+	}
+
+	return cfg.Smartnode.RemoteSignerUrl.Value.(string)
+}
+
 // Gets the tag of the ec container
 // Used by text/template to format eth1.yml
 func (cfg *RocketPoolConfig) GetECContainerTag() (string, error) {
@@ -1560,6 +1574,11 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		}
 	}
 
+	// The remote signer needs somewhere to send the keys to
+	if cfg.Smartnode.UseRemoteSigner.Value == true && cfg.Smartnode.RemoteSignerUrl.Value.(string) == "" {
+		errors = append(errors, "You have the remote signer enabled but don't have a URL set. Please enter the URL of your remote signer to use it.")
+	}
+
 	// Technically not required since native mode doesn't support addons, but defensively check to make sure a native mode
 	// user hasn't tried to configure the rescue node via the TUI
 	if cfg.RescueNode.GetEnabledParameter().Value.(bool) {
@@ -1580,6 +1599,11 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		}
 	}
 
+	// Make sure the custom task intervals can be parsed
+	if _, err := cfg.Smartnode.GetTaskIntervals(); err != nil {
+		errors = append(errors, fmt.Sprintf("Invalid task intervals: %s", err.Error()))
+	}
+
 	// Ensure the selected port numbers are unique. Keeps track of all the errors
 	portMap := make(map[interface{}]bool)
 	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ConsensusCommon.ApiPort, errors)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/shared"
@@ -26,11 +27,20 @@ const (
 	WatchtowerStateFile                string = "state.yml"
 	RegenerateRewardsTreeRequestSuffix string = ".request"
 	RegenerateRewardsTreeRequestFormat string = "%d" + RegenerateRewardsTreeRequestSuffix
-	PrimaryRewardsFileUrl              string = "https://%s.ipfs.dweb.link/%s"
-	SecondaryRewardsFileUrl            string = "https://ipfs.io/ipfs/%s/%s"
 	GithubRewardsFileUrl               string = "https://github.com/rocket-pool/rewards-trees/raw/main/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	ApiSocketFilename                  string = "api.sock"
+	TransactionsFolder                 string = "transactions"
+	TransactionQueueFilenameFormat     string = "%s-queue.json"
+	OfflineTransactionsFilename        string = "offline-transactions.json"
+	KeymanagerTokenFilename            string = "keymanager-token"
+	TasksFolder                        string = "tasks"
+	TaskStatusFilenameFormat           string = "%s-tasks.json"
+	DryRunFilenameFormat               string = "%s-dry-run.json"
+	NodesFolder                        string = "nodes"
+	ProofsFolder                       string = "proofs"
+	VotingPolicyFilename               string = "voting-policy.yml"
 )
 
 // Defaults
@@ -45,8 +55,16 @@ type RewardsExtension string
 const (
 	RewardsExtensionJSON RewardsExtension = ".json"
 	RewardsExtensionSSZ  RewardsExtension = ".ssz"
+	RewardsExtensionCAR  RewardsExtension = ".car"
 )
 
+// Trustless IPFS gateways that serve verifiable CAR data for rewards trees
+var DefaultTrustlessGatewayUrls = []string{
+	"https://trustless-gateway.link",
+	"https://ipfs.io",
+	"https://dweb.link",
+}
+
 // Contract addresses for multicall / network state manager
 type StateManagerContracts struct {
 	Multicaller    common.Address
@@ -82,9 +100,15 @@ type SmartnodeConfig struct {
 	// Manual priority fee override
 	PriorityFee config.Parameter `yaml:"priorityFee,omitempty"`
 
+	// The source of gas fee suggestions
+	GasOracle config.Parameter `yaml:"gasOracle,omitempty"`
+
 	// Threshold for automatic transactions
 	AutoTxGasThreshold config.Parameter `yaml:"minipoolStakeGasThreshold,omitempty"`
 
+	// How long to wait before speeding up a stuck automatic transaction
+	StuckTxTimeout config.Parameter `yaml:"stuckTxTimeout,omitempty"`
+
 	// The amount of ETH in a minipool's balance before auto-distribute kicks in
 	DistributeThreshold config.Parameter `yaml:"distributeThreshold,omitempty"`
 
@@ -97,9 +121,24 @@ type SmartnodeConfig struct {
 	// Custom URL to download a rewards tree
 	RewardsTreeCustomUrl config.Parameter `yaml:"rewardsTreeCustomUrl,omitempty"`
 
+	// Additional trustless IPFS gateways to download rewards trees from
+	RewardsTreeIpfsGateways config.Parameter `yaml:"rewardsTreeIpfsGateways,omitempty"`
+
+	// URL of a local IPFS node's RPC API for downloading and pinning rewards trees
+	IpfsApiUrl config.Parameter `yaml:"ipfsApiUrl,omitempty"`
+
 	// URL for an EC with archive mode, for manual rewards tree generation
 	ArchiveECUrl config.Parameter `yaml:"archiveEcUrl,omitempty"`
 
+	// Toggle for tracking rewards with rolling records during each interval
+	UseRollingRecords config.Parameter `yaml:"useRollingRecords,omitempty"`
+
+	// The number of epochs between rolling record checkpoints
+	RecordCheckpointInterval config.Parameter `yaml:"recordCheckpointInterval,omitempty"`
+
+	// The number of rolling record checkpoints to keep on disk
+	CheckpointRetentionLimit config.Parameter `yaml:"checkpointRetentionLimit,omitempty"`
+
 	// Manual override for the watchtower's max fee
 	WatchtowerMaxFeeOverride config.Parameter `yaml:"watchtowerMaxFeeOverride,omitempty"`
 
@@ -109,9 +148,36 @@ type SmartnodeConfig struct {
 	// The toggle for enabling pDAO proposal verification duties
 	VerifyProposals config.Parameter `yaml:"verifyProposals,omitempty"`
 
+	// The toggle for voting on pDAO proposals automatically with the node's voting policy
+	AutoVoteProposals config.Parameter `yaml:"autoVoteProposals,omitempty"`
+
 	// Delay for automatic queue assignment
 	AutoAssignmentDelay config.Parameter `yaml:"autoAssignmentDelay,omitempty"`
 
+	// Toggle for running the API container as a persistent server
+	EnableApiServer config.Parameter `yaml:"enableApiServer,omitempty"`
+
+	// Toggle for signing with a remote Web3Signer instead of local validator keystores
+	UseRemoteSigner config.Parameter `yaml:"useRemoteSigner,omitempty"`
+
+	// The URL of the remote signer
+	RemoteSignerUrl config.Parameter `yaml:"remoteSignerUrl,omitempty"`
+
+	// Toggle for managing validator keys and fee recipients through the Validator Client's keymanager API
+	UseKeymanagerApi config.Parameter `yaml:"useKeymanagerApi,omitempty"`
+
+	// The port the Validator Client's keymanager API listens on
+	KeymanagerApiPort config.Parameter `yaml:"keymanagerApiPort,omitempty"`
+
+	// The node and watchtower daemon tasks that shouldn't run
+	DisabledTasks config.Parameter `yaml:"disabledTasks,omitempty"`
+
+	// Custom intervals for node and watchtower daemon tasks
+	TaskIntervals config.Parameter `yaml:"taskIntervals,omitempty"`
+
+	// Toggle for simulating the daemons' automatic transactions instead of sending them
+	DryRun config.Parameter `yaml:"dryRun,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -290,10 +356,34 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		GasOracle: config.Parameter{
+			ID:                 "gasOracle",
+			Name:               "Gas Oracle",
+			Description:        "Select where the Smartnode gets its suggested max fees from, both for the transactions you send and for its automatic transactions.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.GasOracle_FeeHistory},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Execution Client",
+				Description: "Calculate suggestions from the fees paid in recent blocks, using your Execution Client's fee history. This works on every network and doesn't need internet access or an API key.",
+				Value:       config.GasOracle_FeeHistory,
+			}, {
+				Name:        "Beaconcha.in",
+				Description: "Use the beaconcha.in gas price oracle, falling back to your Execution Client if it isn't available.",
+				Value:       config.GasOracle_Beaconcha,
+			}, {
+				Name:        "Etherscan",
+				Description: "Use the Etherscan gas price oracle, falling back to your Execution Client if it isn't available. Etherscan only supports Mainnet.",
+				Value:       config.GasOracle_Etherscan,
+			}},
+		},
+
 		AutoTxGasThreshold: config.Parameter{
 			ID:   "minipoolStakeGasThreshold",
 			Name: "Automatic TX Gas Threshold",
-			Description: "Occasionally, the Smartnode will attempt to perform some automatic transactions (such as the second `stake` transaction to finish launching a minipool or the `reduce bond` transaction to convert a 16-ETH minipool to an 8-ETH one). During these, your node will use the `Rapid` suggestion from the gas estimator as its max fee.\n\nThis threshold is a limit (in gwei) you can put on that suggestion; your node will not `stake` the new minipool until the suggestion is below this limit.\n\n" +
+			Description: "Occasionally, the Smartnode will attempt to perform some automatic transactions (such as the second `stake` transaction to finish launching a minipool or the `reduce bond` transaction to convert a 16-ETH minipool to an 8-ETH one). During these, your node will use the `Fast` suggestion from the gas oracle as its max fee.\n\nThis threshold is a limit (in gwei) you can put on that suggestion; your node will not `stake` the new minipool until the suggestion is below this limit.\n\n" +
 				"A value of 0 will disable non-essential automatic transactions (such as minipool balance distribution and bond reduction), but essential transactions (such as minipool staking and solo migration promotion) will not be disabled.\n\n" +
 				"NOTE: the node will ignore this limit and automatically execute transactions at whatever the suggested fee happens to be once too much time has passed since those transactions were first eligible. You may end up paying more than you wanted to if you set this too low!",
 			Type:               config.ParameterType_Float,
@@ -303,6 +393,17 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		StuckTxTimeout: config.Parameter{
+			ID:                 "stuckTxTimeout",
+			Name:               "Stuck Transaction Timeout",
+			Description:        "The number of minutes the Smartnode will wait for one of its automatic transactions to be included in a block before it speeds the transaction up by resubmitting it with a higher fee.\n\nThe replacement fee will never exceed your Manual Max Fee if you've set one, or your Automatic TX Gas Threshold otherwise; once it reaches that limit, the transaction is resubmitted at the same fee instead.\n\nSet this to 0 to disable automatic speed-ups.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(10)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		DistributeThreshold: config.Parameter{
 			ID:                 "distributeThreshold",
 			Name:               "Auto-Distribute Threshold",
@@ -325,6 +426,17 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		AutoVoteProposals: config.Parameter{
+			ID:                 "autoVoteProposals",
+			Name:               "Enable Automatic PDAO Voting",
+			Description:        "Check this box to have your node vote on Protocol DAO proposals automatically, following the rules in the voting policy file `voting-policy.yml` in your Smartnode's data folder.\n\nDuring the first voting phase, your node will vote with the voting power delegated to it. During the second phase, it will override your delegate's vote if it doesn't match your policy. Every decision is logged and sent as an alert.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		AutoAssignmentDelay: config.Parameter{
 			ID:                 "autoAssignmentDelay",
 			Name:               "Automatic queue assignment delay",
@@ -336,6 +448,94 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		EnableApiServer: config.Parameter{
+			ID:                 "enableApiServer",
+			Name:               "Enable API Server",
+			Description:        "Enable this to run the API container as a persistent server instead of starting a new process for every `rocketpool` command. The server keeps its connections to your clients and its wallet warm between commands, which makes the CLI much faster for scripting.\n\nThe server listens on a unix socket in your Rocket Pool directory that only your user can access, so dashboards and bots can also use it without going through Docker.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		UseRemoteSigner: config.Parameter{
+			ID:                 "useRemoteSigner",
+			Name:               "Use Remote Signer",
+			Description:        "Enable this to keep your validator keys in a Web3Signer-compatible remote signer instead of in local keystores. New validator keys will be imported into the signer through its keymanager API, your Validator Client will sign through it, and deposits and voluntary exits will be signed by it as well.\n\nIf you already have validators, run `rocketpool wallet rebuild` after enabling this to import their keys into the signer.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		RemoteSignerUrl: config.Parameter{
+			ID:                 "remoteSignerUrl",
+			Name:               "Remote Signer URL",
+			Description:        "The URL of your remote signer, including the port (for example, http://web3signer:9000). It must be reachable from the Smartnode and Validator Client containers, and its keymanager API must be enabled.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		UseKeymanagerApi: config.Parameter{
+			ID:                 "useKeymanagerApi",
+			Name:               "Use Keymanager API",
+			Description:        "Enable this to have the Smartnode load new validator keys and set fee recipients on your running Validator Client through its standard keymanager API, instead of writing files and restarting it. This avoids the missed attestations that come with every Validator Client restart.\n\nIf the API can't be reached, the Smartnode falls back to writing files and restarting the Validator Client as before.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		KeymanagerApiPort: config.Parameter{
+			ID:                 "keymanagerApiPort",
+			Name:               "Keymanager API Port",
+			Description:        "The port your Validator Client's keymanager API should listen on. It is only exposed on Rocket Pool's internal Docker network.",
+			Type:               config.ParameterType_Uint16,
+			Default:            map[config.Network]interface{}{config.Network_All: uint16(5062)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		DisabledTasks: config.Parameter{
+			ID:                 "disabledTasks",
+			Name:               "Disabled Tasks",
+			Description:        "A comma-separated list of node and watchtower daemon tasks that shouldn't run, such as `distribute-minipools,reduce-bonds`. Use `rocketpool node tasks` to see the names of all of the tasks.\n\n[orange]WARNING: Some tasks protect your node from penalties, such as `manage-fee-recipient` and `defend-challenge-exit`. Only disable them if you know what you're doing.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		TaskIntervals: config.Parameter{
+			ID:                 "taskIntervals",
+			Name:               "Task Intervals",
+			Description:        "A comma-separated list of custom intervals for node and watchtower daemon tasks, such as `download-rewards-trees=1h,promote-minipools=30m`. Tasks that aren't listed run at the daemon's default interval.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		DryRun: config.Parameter{
+			ID:                 "dryRun",
+			Name:               "Dry Run",
+			Description:        "Enable this to have the node and watchtower daemons simulate their automatic transactions against the current state of the chain instead of sending them. Each transaction the daemons would have sent is logged, shown by `rocketpool node dry-run`, and sent as an alert if alerting is enabled.\n\nTransactions you send yourself with the `rocketpool` command are not affected.\n\n[orange]WARNING: While this is enabled, the daemons won't perform any of their duties on-chain, including ones that protect your node from penalties.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		RewardsTreeMode: config.Parameter{
 			ID:                 "rewardsTreeMode",
 			Name:               "Rewards Tree Mode",
@@ -378,6 +578,28 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		RewardsTreeIpfsGateways: config.Parameter{
+			ID:                 "rewardsTreeIpfsGateways",
+			Name:               "Rewards Tree IPFS Gateways",
+			Description:        "The Smartnode downloads rewards tree files from several public trustless IPFS gateways at the same time, and verifies every piece of the file against its IPFS CID before using it. Use this field if you would like to add more trustless gateways for it to download from.\nMultiple URLs can be provided using ';' as separator - for example: `https://my-gateway.com;http://192.168.1.10:8080`.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		IpfsApiUrl: config.Parameter{
+			ID:                 "ipfsApiUrl",
+			Name:               "Local IPFS Node URL",
+			Description:        "The URL of the RPC API of an IPFS node you run (e.g., Kubo), such as `http://192.168.1.10:5001`. If set, the Smartnode will also download rewards tree files from this node, and will pin the ones it downloads elsewhere on it so other nodes on your network can fetch them from you.\n\nLeave this blank if you don't run an IPFS node.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
 		ArchiveECUrl: config.Parameter{
 			ID:                 "archiveECUrl",
 			Name:               "Archive-Mode EC URL",
@@ -389,6 +611,39 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		UseRollingRecords: config.Parameter{
+			ID:                 "useRollingRecords",
+			Name:               "Use Rolling Records",
+			Description:        "Enable this to have the watchtower track attestation performance throughout each rewards interval, saving its progress to disk as it goes. When the interval ends, the rewards tree only needs to process the epochs after the last update instead of the whole interval, which greatly reduces the load on your Beacon Node at the end of each interval.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		RecordCheckpointInterval: config.Parameter{
+			ID:                 "recordCheckpointInterval",
+			Name:               "Record Checkpoint Interval",
+			Description:        "The number of epochs between each rolling record checkpoint saved to disk. Lower values mean less work is lost if the watchtower restarts, but use more disk writes.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(45)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		CheckpointRetentionLimit: config.Parameter{
+			ID:                 "checkpointRetentionLimit",
+			Name:               "Checkpoint Retention Limit",
+			Description:        "The number of rolling record checkpoints to keep on disk. Older checkpoints are deleted once this limit is reached.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(200)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		WatchtowerMaxFeeOverride: config.Parameter{
 			ID:                 "watchtowerMaxFeeOverride",
 			Name:               "Watchtower Max Fee Override",
@@ -623,14 +878,30 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.DataPath,
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
+		&cfg.GasOracle,
 		&cfg.AutoTxGasThreshold,
+		&cfg.StuckTxTimeout,
 		&cfg.DistributeThreshold,
 		&cfg.VerifyProposals,
+		&cfg.AutoVoteProposals,
 		&cfg.AutoAssignmentDelay,
+		&cfg.EnableApiServer,
+		&cfg.UseRemoteSigner,
+		&cfg.RemoteSignerUrl,
+		&cfg.UseKeymanagerApi,
+		&cfg.KeymanagerApiPort,
+		&cfg.DisabledTasks,
+		&cfg.TaskIntervals,
+		&cfg.DryRun,
 		&cfg.RewardsTreeMode,
 		&cfg.PriceBalanceSubmissionReferenceTimestamp,
 		&cfg.RewardsTreeCustomUrl,
+		&cfg.RewardsTreeIpfsGateways,
+		&cfg.IpfsApiUrl,
 		&cfg.ArchiveECUrl,
+		&cfg.UseRollingRecords,
+		&cfg.RecordCheckpointInterval,
+		&cfg.CheckpointRetentionLimit,
 		&cfg.WatchtowerMaxFeeOverride,
 		&cfg.WatchtowerPrioFeeOverride,
 	}
@@ -674,6 +945,39 @@ func (cfg *SmartnodeConfig) GetNodeAddressPath() string {
 	return filepath.Join(DaemonDataPath, "address")
 }
 
+// Get the folder the wallets of the additional nodes managed by this installation are kept in
+func (cfg *SmartnodeConfig) GetNodesPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), NodesFolder)
+	}
+
+	return filepath.Join(DaemonDataPath, NodesFolder)
+}
+
+// Get the folder a node's wallet, password and address files are kept in; the primary node has an empty name
+func (cfg *SmartnodeConfig) GetNodeDataPath(node string) string {
+	if node != "" {
+		return filepath.Join(cfg.GetNodesPath(), node)
+	}
+	if cfg.parent.IsNativeMode {
+		return cfg.DataPath.Value.(string)
+	}
+
+	return DaemonDataPath
+}
+
+func (cfg *SmartnodeConfig) GetWalletPathForNode(node string) string {
+	return filepath.Join(cfg.GetNodeDataPath(node), "wallet")
+}
+
+func (cfg *SmartnodeConfig) GetPasswordPathForNode(node string) string {
+	return filepath.Join(cfg.GetNodeDataPath(node), "password")
+}
+
+func (cfg *SmartnodeConfig) GetNodeAddressPathForNode(node string) string {
+	return filepath.Join(cfg.GetNodeDataPath(node), "address")
+}
+
 func (cfg *SmartnodeConfig) GetValidatorKeychainPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "validators")
@@ -698,6 +1002,93 @@ func (cfg *SmartnodeConfig) GetVotingPath() string {
 	return filepath.Join(DaemonDataPath, "voting", string(cfg.Network.Value.(config.Network)))
 }
 
+func (cfg *SmartnodeConfig) GetVotingPolicyPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), VotingPolicyFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, VotingPolicyFilename)
+}
+
+func (cfg *SmartnodeConfig) GetTransactionQueuePath(daemonName string) string {
+	filename := fmt.Sprintf(TransactionQueueFilenameFormat, daemonName)
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), TransactionsFolder, filename)
+	}
+
+	return filepath.Join(DaemonDataPath, TransactionsFolder, filename)
+}
+
+func (cfg *SmartnodeConfig) GetTaskStatusPath(daemonName string) string {
+	filename := fmt.Sprintf(TaskStatusFilenameFormat, daemonName)
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), TasksFolder, filename)
+	}
+
+	return filepath.Join(DaemonDataPath, TasksFolder, filename)
+}
+
+func (cfg *SmartnodeConfig) GetDryRunPath(daemonName string) string {
+	filename := fmt.Sprintf(DryRunFilenameFormat, daemonName)
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), TransactionsFolder, filename)
+	}
+
+	return filepath.Join(DaemonDataPath, TransactionsFolder, filename)
+}
+
+func (cfg *SmartnodeConfig) GetProofCachePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), ProofsFolder)
+	}
+
+	return filepath.Join(DaemonDataPath, ProofsFolder)
+}
+
+// Get the names of the daemon tasks the user disabled
+func (cfg *SmartnodeConfig) GetDisabledTasks() map[string]bool {
+	disabledTasks := map[string]bool{}
+	for _, name := range strings.Split(cfg.DisabledTasks.Value.(string), ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			disabledTasks[name] = true
+		}
+	}
+	return disabledTasks
+}
+
+// Get the custom intervals the user set for daemon tasks
+func (cfg *SmartnodeConfig) GetTaskIntervals() (map[string]time.Duration, error) {
+	intervals := map[string]time.Duration{}
+	for _, entry := range strings.Split(cfg.TaskIntervals.Value.(string), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, intervalString, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("task interval [%s] must be in the form name=interval", entry)
+		}
+		interval, err := time.ParseDuration(strings.TrimSpace(intervalString))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval for task %s: %w", strings.TrimSpace(name), err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("interval for task %s must be greater than 0", strings.TrimSpace(name))
+		}
+		intervals[strings.TrimSpace(name)] = interval
+	}
+	return intervals, nil
+}
+
+func (cfg *SmartnodeConfig) GetOfflineTransactionsPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, TransactionsFolder, OfflineTransactionsFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), TransactionsFolder, OfflineTransactionsFilename)
+}
+
 func (cfg *SmartnodeConfig) GetWalletPathInCLI() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "wallet")
 }
@@ -734,6 +1125,10 @@ func (cfg *SmartnodeConfig) GetCustomKeyPasswordFilePath() string {
 	return filepath.Join(DaemonDataPath, "custom-key-passwords")
 }
 
+func (cfg *SmartnodeConfig) GetApiSocketFilename() string {
+	return ApiSocketFilename
+}
+
 func (cfg *SmartnodeConfig) GetStorageAddress() string {
 	return cfg.storageAddress[cfg.Network.Value.(config.Network)]
 }
@@ -841,6 +1236,18 @@ func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "validators", NativeFeeRecipientFilename)
 }
 
+func (cfg *SmartnodeConfig) GetKeymanagerTokenPath() string {
+	if !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, "validators", KeymanagerTokenFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), "validators", KeymanagerTokenFilename)
+}
+
+func (cfg *SmartnodeConfig) GetKeymanagerApiUrl() string {
+	return fmt.Sprintf("http://%s:%d", ValidatorContainerName, cfg.KeymanagerApiPort.Value)
+}
+
 func (cfg *SmartnodeConfig) GetV100RewardsPoolAddress() common.Address {
 	return common.HexToAddress(cfg.v1_0_0_RewardsPoolAddress[cfg.Network.Value.(config.Network)])
 }
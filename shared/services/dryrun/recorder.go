@@ -0,0 +1,196 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	// The number of simulated transactions to keep in a daemon's log
+	historyLimit int = 100
+
+	logFileMode os.FileMode = 0644
+)
+
+// A transaction a daemon would have sent if it weren't in dry-run mode
+type SimulatedTransaction struct {
+	Time            time.Time       `json:"time"`
+	Task            string          `json:"task"`
+	Hash            common.Hash     `json:"hash"`
+	From            common.Address  `json:"from"`
+	To              *common.Address `json:"to"`
+	Nonce           uint64          `json:"nonce"`
+	Value           *big.Int        `json:"value"`
+	Data            hexutil.Bytes   `json:"data"`
+	GasLimit        uint64          `json:"gasLimit"`
+	EstimatedGas    uint64          `json:"estimatedGas"`
+	GasFeeCap       *big.Int        `json:"gasFeeCap"`
+	GasTipCap       *big.Int        `json:"gasTipCap"`
+	BlockNumber     uint64          `json:"blockNumber"`
+	SimulationError string          `json:"simulationError"`
+}
+
+// Simulates a daemon's transactions against the current chain state and records them instead of sending them
+type Recorder struct {
+	cfg        *config.RocketPoolConfig
+	ec         rocketpool.ExecutionClient
+	daemonName string
+	path       string
+	log        *log.ColorLogger
+	taskName   func() string
+	lock       sync.Mutex
+}
+
+// Create a recorder for the given daemon
+func NewRecorder(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient, daemonName string, logger *log.ColorLogger) *Recorder {
+	return &Recorder{
+		cfg:        cfg,
+		ec:         ec,
+		daemonName: daemonName,
+		path:       cfg.Smartnode.GetDryRunPath(daemonName),
+		log:        logger,
+	}
+}
+
+// Set the function used to get the name of the task that's currently running, so transactions can be attributed to it
+func (r *Recorder) SetTaskSource(taskName func() string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.taskName = taskName
+}
+
+// Simulate a signed transaction with eth_call and gas estimation at the latest block, then record it.
+// A transaction that would revert is still recorded; only failing to reach the client or save the log is an error.
+func (r *Recorder) Simulate(from common.Address, tx *types.Transaction) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	blockNumber, err := r.ec.BlockNumber(context.Background())
+	if err != nil {
+		return fmt.Errorf("error getting the latest block for the dry run: %w", err)
+	}
+
+	simulatedTx := SimulatedTransaction{
+		Time:        time.Now(),
+		Task:        "unknown",
+		Hash:        tx.Hash(),
+		From:        from,
+		To:          tx.To(),
+		Nonce:       tx.Nonce(),
+		Value:       tx.Value(),
+		Data:        tx.Data(),
+		GasLimit:    tx.Gas(),
+		GasFeeCap:   tx.GasFeeCap(),
+		GasTipCap:   tx.GasTipCap(),
+		BlockNumber: blockNumber,
+	}
+	if r.taskName != nil {
+		if taskName := r.taskName(); taskName != "" {
+			simulatedTx.Task = taskName
+		}
+	}
+
+	// Run it against the block the daemon would have sent it on top of
+	msg := ethereum.CallMsg{
+		From:      from,
+		To:        tx.To(),
+		Gas:       tx.Gas(),
+		GasFeeCap: tx.GasFeeCap(),
+		GasTipCap: tx.GasTipCap(),
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+	}
+	block := new(big.Int).SetUint64(blockNumber)
+	_, err = r.ec.CallContract(context.Background(), msg, block)
+	if err != nil {
+		simulatedTx.SimulationError = err.Error()
+	} else {
+		msg.Gas = 0
+		estimatedGas, err := r.ec.EstimateGas(context.Background(), msg)
+		if err != nil {
+			simulatedTx.SimulationError = fmt.Sprintf("error estimating gas: %s", err.Error())
+		} else {
+			simulatedTx.EstimatedGas = estimatedGas
+		}
+	}
+
+	to := common.Address{}
+	if tx.To() != nil {
+		to = *tx.To()
+	}
+	if simulatedTx.SimulationError == "" {
+		r.log.Printlnf("[Dry Run] %s would have sent transaction %s to %s (estimated gas: %d, gas limit: %d).", simulatedTx.Task, simulatedTx.Hash.Hex(), to.Hex(), simulatedTx.EstimatedGas, simulatedTx.GasLimit)
+	} else {
+		r.log.Printlnf("[Dry Run] %s would have sent transaction %s to %s, but it would fail: %s", simulatedTx.Task, simulatedTx.Hash.Hex(), to.Hex(), simulatedTx.SimulationError)
+	}
+	alerting.AlertDryRunTransaction(r.cfg, r.daemonName, simulatedTx.Task, to, simulatedTx.Hash, simulatedTx.SimulationError)
+
+	return r.save(simulatedTx)
+}
+
+// Add a simulated transaction to the log, replacing the file atomically so readers never see a partial write
+func (r *Recorder) save(simulatedTx SimulatedTransaction) error {
+	transactions, err := LoadSimulatedTransactions(r.path)
+	if err != nil {
+		return err
+	}
+	transactions = append(transactions, simulatedTx)
+	if len(transactions) > historyLimit {
+		transactions = transactions[len(transactions)-historyLimit:]
+	}
+
+	bytes, err := json.Marshal(transactions)
+	if err != nil {
+		return fmt.Errorf("error serializing dry run log: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(r.path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating dry run log directory: %w", err)
+	}
+
+	tmpPath := r.path + ".tmp"
+	err = os.WriteFile(tmpPath, bytes, logFileMode)
+	if err != nil {
+		return fmt.Errorf("error writing dry run log [%s]: %w", tmpPath, err)
+	}
+	err = os.Rename(tmpPath, r.path)
+	if err != nil {
+		return fmt.Errorf("error moving dry run log [%s] into place: %w", r.path, err)
+	}
+	return nil
+}
+
+// Load the transactions a daemon simulated, oldest first, returning an empty list if it hasn't simulated any yet
+func LoadSimulatedTransactions(path string) ([]SimulatedTransaction, error) {
+	transactions := []SimulatedTransaction{}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return transactions, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading dry run log [%s]: %w", path, err)
+	}
+
+	err = json.Unmarshal(bytes, &transactions)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing dry run log [%s]: %w", path, err)
+	}
+	return transactions, nil
+}
@@ -193,6 +193,18 @@ func (p *ExecutionClientManager) SuggestGasTipCap(ctx context.Context) (*big.Int
 	return result.(*big.Int), err
 }
 
+// FeeHistory retrieves the fee market history for the requested number of blocks up to lastBlock,
+// including the priority fees paid at each of the requested reward percentiles.
+func (p *ExecutionClientManager) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	result, err := p.runFunction(func(client *ethClient) (interface{}, error) {
+		return client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ethereum.FeeHistory), err
+}
+
 // EstimateGas tries to estimate the gas needed to execute a specific
 // transaction based on the current pending state of the backend blockchain.
 // There is no guarantee that this is the true gas limit requirement as other
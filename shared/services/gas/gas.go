@@ -8,8 +8,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services/config"
-	"github.com/rocket-pool/smartnode/shared/services/gas/etherchain"
-	"github.com/rocket-pool/smartnode/shared/services/gas/etherscan"
+	"github.com/rocket-pool/smartnode/shared/services/gas/oracle"
 	rpsvc "github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
@@ -87,31 +86,16 @@ func GetMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless bo
 		fmt.Printf("Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, colorReset)
 
 	} else {
+		// Get the latest suggestions from the oracle the user selected
+		response, err := rp.GasSuggestions()
+		if err != nil {
+			return Gas{}, fmt.Errorf("Error getting gas price suggestions: %w", err)
+		}
 		if headless {
-			maxFeeWei, err := GetHeadlessMaxFeeWei(cfg)
-			if err != nil {
-				return Gas{}, err
-			}
-			maxFeeGwei = eth.WeiToGwei(maxFeeWei)
+			maxFeeGwei = eth.WeiToGwei(response.Suggestions.Fast.MaxFee)
 		} else {
-			// Try to get the latest gas prices from Etherchain
-			etherchainData, err := etherchain.GetGasPrices(cfg)
-			if err == nil {
-				// Print the Etherchain data and ask for an amount
-				maxFeeGwei = handleEtherchainGasPrices(etherchainData, gasInfo, maxPriorityFeeGwei, gasLimit)
-
-			} else {
-				// Fallback to Etherscan
-				// Etherscan does not have a hoodi endpoint. Fallback uses the mainnet url in all cases.
-				fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", colorYellow, err.Error(), colorReset)
-				etherscanData, err := etherscan.GetGasPrices()
-				if err == nil {
-					// Print the Etherscan data and ask for an amount
-					maxFeeGwei = handleEtherscanGasPrices(etherscanData, gasInfo, maxPriorityFeeGwei, gasLimit)
-				} else {
-					return Gas{}, fmt.Errorf("Error getting gas price suggestions: %w", err)
-				}
-			}
+			// Print the suggestions and ask for an amount
+			maxFeeGwei = handleGasSuggestions(response.Suggestions, gasInfo, maxPriorityFeeGwei, gasLimit)
 		}
 		fmt.Printf("%sUsing a max fee of %.2f gwei and a priority fee of %.2f gwei.\n%s", colorBlue, maxFeeGwei, maxPriorityFeeGwei, colorReset)
 	}
@@ -143,166 +127,71 @@ func GetMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless bo
 }
 
 // Get the suggested max fee for service operations
-func GetHeadlessMaxFeeWei(cfg *config.RocketPoolConfig) (*big.Int, error) {
-	etherchainData, err := etherchain.GetGasPrices(cfg)
-	if err == nil {
-		return etherchainData.RapidWei, nil
-	} else {
-		// Etherscan does not have a hoodi endpoint. Fallback uses the mainnet url in all cases.
-		fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", colorYellow, err.Error(), colorReset)
-		etherscanData, err := etherscan.GetGasPrices()
-		if err == nil {
-			return eth.GweiToWei(etherscanData.FastGwei), nil
-		} else {
-			return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
-		}
+func GetHeadlessMaxFeeWei(cfg *config.RocketPoolConfig, ec oracle.FeeHistoryClient) (*big.Int, error) {
+	suggestions, err := oracle.NewOracle(cfg, ec).GetSuggestions()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
 	}
+	return suggestions.Fast.MaxFee, nil
 }
 
-func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
-
-	rapidGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.RapidWei)+priorityFee, 0)
-	rapidEth := eth.WeiToEth(gasSuggestion.RapidWei)
-
-	var rapidLowLimit float64
-	var rapidHighLimit float64
-	if gasLimit == 0 {
-		rapidLowLimit = rapidEth * float64(gasInfo.EstGasLimit)
-		rapidHighLimit = rapidEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		rapidLowLimit = rapidEth * float64(gasLimit)
-		rapidHighLimit = rapidLowLimit
-	}
-
-	fastGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.FastWei)+priorityFee, 0)
-	fastEth := eth.WeiToEth(gasSuggestion.FastWei)
-
-	var fastLowLimit float64
-	var fastHighLimit float64
-	if gasLimit == 0 {
-		fastLowLimit = fastEth * float64(gasInfo.EstGasLimit)
-		fastHighLimit = fastEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		fastLowLimit = fastEth * float64(gasLimit)
-		fastHighLimit = fastLowLimit
+// Get the max fee for a suggestion in gwei, adjusted for the user's priority fee.
+// Oracles that don't suggest a priority fee only suggest the base fee portion, so the user's priority fee is added on top;
+// for those that do, the max fee is raised if the user's priority fee is higher than the suggested one.
+func getSuggestedMaxFeeGwei(suggestion oracle.FeeSuggestion, priorityFee float64) float64 {
+	maxFeeGwei := eth.WeiToGwei(suggestion.MaxFee)
+	if suggestion.PriorityFee == nil {
+		return maxFeeGwei + priorityFee
 	}
-
-	standardGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.StandardWei)+priorityFee, 0)
-	standardEth := eth.WeiToEth(gasSuggestion.StandardWei)
-
-	var standardLowLimit float64
-	var standardHighLimit float64
-	if gasLimit == 0 {
-		standardLowLimit = standardEth * float64(gasInfo.EstGasLimit)
-		standardHighLimit = standardEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		standardLowLimit = standardEth * float64(gasLimit)
-		standardHighLimit = standardLowLimit
-	}
-
-	slowGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.SlowWei)+priorityFee, 0)
-	slowEth := eth.WeiToEth(gasSuggestion.SlowWei)
-
-	var slowLowLimit float64
-	var slowHighLimit float64
-	if gasLimit == 0 {
-		slowLowLimit = slowEth * float64(gasInfo.EstGasLimit)
-		slowHighLimit = slowEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		slowLowLimit = slowEth * float64(gasLimit)
-		slowHighLimit = slowLowLimit
-	}
-
-	fmt.Printf("%s+============== Suggested Gas Prices ==============+\n", colorBlue)
-	fmt.Println("| Avg Wait Time |  Max Fee  |    Total Gas Cost    |")
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.RapidTime, fmt.Sprintf("%d gwei", int(rapidGwei)), rapidLowLimit, rapidHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.FastTime, fmt.Sprintf("%d gwei", int(fastGwei)), fastLowLimit, fastHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.StandardTime, fmt.Sprintf("%d gwei", int(standardGwei)), standardLowLimit, standardHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.SlowTime, fmt.Sprintf("%d gwei", int(slowGwei)), slowLowLimit, slowHighLimit)
-	fmt.Printf("+==================================================+\n\n%s", colorReset)
-
-	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
-
-	for {
-		desiredPrice := prompt.Prompt(
-			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fastGwei)),
-			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
-			"Not a valid gas price, try again:")
-
-		if desiredPrice == "" {
-			return fastGwei
-		}
-
-		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
-		if err != nil {
-			fmt.Printf("Not a valid gas price (%s), try again.", err.Error())
-			fmt.Println("")
-			continue
-		}
-		if desiredPriceFloat <= 0 {
-			fmt.Println("Max fee must be greater than zero.")
-			continue
-		}
-
-		return desiredPriceFloat
+	suggestedPriorityFee := eth.WeiToGwei(suggestion.PriorityFee)
+	if priorityFee > suggestedPriorityFee {
+		return maxFeeGwei - suggestedPriorityFee + priorityFee
 	}
-
+	return maxFeeGwei
 }
 
-func handleEtherscanGasPrices(gasSuggestion etherscan.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
-
-	fastGwei := math.RoundUp(gasSuggestion.FastGwei+priorityFee, 0)
-	fastEth := gasSuggestion.FastGwei / eth.WeiPerGwei
-
-	var fastLowLimit float64
-	var fastHighLimit float64
-	if gasLimit == 0 {
-		fastLowLimit = fastEth * float64(gasInfo.EstGasLimit)
-		fastHighLimit = fastEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		fastLowLimit = fastEth * float64(gasLimit)
-		fastHighLimit = fastLowLimit
-	}
-
-	standardGwei := math.RoundUp(gasSuggestion.StandardGwei+priorityFee, 0)
-	standardEth := gasSuggestion.StandardGwei / eth.WeiPerGwei
-
-	var standardLowLimit float64
-	var standardHighLimit float64
-	if gasLimit == 0 {
-		standardLowLimit = standardEth * float64(gasInfo.EstGasLimit)
-		standardHighLimit = standardEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		standardLowLimit = standardEth * float64(gasLimit)
-		standardHighLimit = standardLowLimit
+func handleGasSuggestions(suggestions oracle.GasSuggestions, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
+
+	type row struct {
+		speed    string
+		waitTime string
+		maxFee   float64
+		lowCost  float64
+		highCost float64
+	}
+	rows := []row{}
+	for _, speed := range []struct {
+		name       string
+		suggestion oracle.FeeSuggestion
+	}{
+		{"Fast", suggestions.Fast},
+		{"Standard", suggestions.Standard},
+		{"Slow", suggestions.Slow},
+	} {
+		maxFeeGwei := math.RoundUp(getSuggestedMaxFeeGwei(speed.suggestion, priorityFee), 0)
+		maxFeeEth := maxFeeGwei / eth.WeiPerGwei
+
+		var lowCost float64
+		var highCost float64
+		if gasLimit == 0 {
+			lowCost = maxFeeEth * float64(gasInfo.EstGasLimit)
+			highCost = maxFeeEth * float64(gasInfo.SafeGasLimit)
+		} else {
+			lowCost = maxFeeEth * float64(gasLimit)
+			highCost = lowCost
+		}
+		rows = append(rows, row{speed.name, speed.suggestion.WaitTime, maxFeeGwei, lowCost, highCost})
 	}
+	fastGwei := rows[0].maxFee
 
-	slowGwei := math.RoundUp(gasSuggestion.SlowGwei+priorityFee, 0)
-	slowEth := gasSuggestion.SlowGwei / eth.WeiPerGwei
-
-	var slowLowLimit float64
-	var slowHighLimit float64
-	if gasLimit == 0 {
-		slowLowLimit = slowEth * float64(gasInfo.EstGasLimit)
-		slowHighLimit = slowEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		slowLowLimit = slowEth * float64(gasLimit)
-		slowHighLimit = slowLowLimit
+	fmt.Printf("%s+===================== Suggested Gas Prices =====================+\n", colorBlue)
+	fmt.Println("|   Speed   | Avg Wait Time |  Max Fee  |    Total Gas Cost    |")
+	for _, r := range rows {
+		fmt.Printf("| %-9s | %-13s | %-9s | %.4f to %.4f ETH |\n",
+			r.speed, r.waitTime, fmt.Sprintf("%d gwei", int(r.maxFee)), r.lowCost, r.highCost)
 	}
-
-	fmt.Printf("%s+============ Suggested Gas Prices ============+\n", colorBlue)
-	fmt.Println("|   Speed   |  Max Fee  |    Total Gas Cost    |")
-	fmt.Printf("| Fast      | %-9s | %.4f to %.4f ETH |\n",
-		fmt.Sprintf("%d gwei", int(fastGwei)), fastLowLimit, fastHighLimit)
-	fmt.Printf("| Standard  | %-9s | %.4f to %.4f ETH |\n",
-		fmt.Sprintf("%d gwei", int(standardGwei)), standardLowLimit, standardHighLimit)
-	fmt.Printf("| Slow      | %-9s | %.4f to %.4f ETH |\n",
-		fmt.Sprintf("%d gwei", int(slowGwei)), slowLowLimit, slowHighLimit)
-	fmt.Printf("+==============================================+\n\n%s", colorReset)
+	fmt.Printf("+================================================================+\n%s", colorReset)
+	fmt.Printf("Source: %s\n\n", suggestions.Source)
 
 	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
 
@@ -0,0 +1,73 @@
+package oracle
+
+import (
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/gas/etherchain"
+	"github.com/rocket-pool/smartnode/shared/services/gas/etherscan"
+)
+
+// Gets suggestions from the beaconcha.in gas price oracle
+type beaconchaOracle struct {
+	cfg *config.RocketPoolConfig
+}
+
+func (o *beaconchaOracle) Name() string {
+	return "beaconcha.in"
+}
+
+func (o *beaconchaOracle) GetSuggestions() (GasSuggestions, error) {
+	prices, err := etherchain.GetGasPrices(o.cfg)
+	if err != nil {
+		return GasSuggestions{}, err
+	}
+
+	// It only provides gas prices, so there's no separate priority fee
+	return GasSuggestions{
+		Source: o.Name(),
+		Slow: FeeSuggestion{
+			MaxFee:   prices.StandardWei,
+			WaitTime: prices.StandardTime,
+		},
+		Standard: FeeSuggestion{
+			MaxFee:   prices.FastWei,
+			WaitTime: prices.FastTime,
+		},
+		Fast: FeeSuggestion{
+			MaxFee:   prices.RapidWei,
+			WaitTime: prices.RapidTime,
+		},
+	}, nil
+}
+
+// Gets suggestions from the Etherscan gas price oracle
+type etherscanOracle struct{}
+
+func (o *etherscanOracle) Name() string {
+	return "Etherscan"
+}
+
+func (o *etherscanOracle) GetSuggestions() (GasSuggestions, error) {
+	prices, err := etherscan.GetGasPrices()
+	if err != nil {
+		return GasSuggestions{}, err
+	}
+
+	// It only provides gas prices, so there's no separate priority fee
+	return GasSuggestions{
+		Source: o.Name(),
+		Slow: FeeSuggestion{
+			MaxFee:   eth.GweiToWei(prices.SlowGwei),
+			WaitTime: "Slow",
+		},
+		Standard: FeeSuggestion{
+			MaxFee:   eth.GweiToWei(prices.StandardGwei),
+			WaitTime: "Standard",
+		},
+		Fast: FeeSuggestion{
+			MaxFee:   eth.GweiToWei(prices.FastGwei),
+			WaitTime: "Fast",
+		},
+	}, nil
+}
@@ -0,0 +1,112 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+const (
+	// The number of recent blocks to base the suggestions on
+	feeHistoryBlocks uint64 = 20
+
+	// The fastest the base fee can rise is 12.5% per block
+	baseFeeIncreaseNumerator   int64 = 9
+	baseFeeIncreaseDenominator int64 = 8
+)
+
+// The priority fee percentiles paid in recent blocks that the slow, standard and fast suggestions use
+var rewardPercentiles = []float64{10, 50, 90}
+
+// The Execution Client functions the fee history oracle needs
+type FeeHistoryClient interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// Suggests fees from the priority fees paid in recent blocks and the next block's base fee, using eth_feeHistory
+type FeeHistoryOracle struct {
+	ec FeeHistoryClient
+}
+
+// Create an oracle that uses the Execution Client's fee history
+func NewFeeHistoryOracle(ec FeeHistoryClient) *FeeHistoryOracle {
+	return &FeeHistoryOracle{
+		ec: ec,
+	}
+}
+
+func (o *FeeHistoryOracle) Name() string {
+	return "Execution Client fee history"
+}
+
+func (o *FeeHistoryOracle) GetSuggestions() (GasSuggestions, error) {
+	history, err := o.ec.FeeHistory(context.Background(), feeHistoryBlocks, nil, rewardPercentiles)
+	if err != nil {
+		return GasSuggestions{}, fmt.Errorf("error getting fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 {
+		return GasSuggestions{}, fmt.Errorf("the Execution Client didn't return any fee history")
+	}
+
+	// The last base fee is the one for the next block
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	// Use the median of each percentile, skipping empty blocks since they don't say anything about the competition for block space
+	tips := make([]*big.Int, len(rewardPercentiles))
+	for i := range rewardPercentiles {
+		rewards := []*big.Int{}
+		for block, blockRewards := range history.Reward {
+			if block < len(history.GasUsedRatio) && history.GasUsedRatio[block] == 0 {
+				continue
+			}
+			if i < len(blockRewards) && blockRewards[i] != nil {
+				rewards = append(rewards, blockRewards[i])
+			}
+		}
+		if len(rewards) == 0 {
+			tip, err := o.ec.SuggestGasTipCap(context.Background())
+			if err != nil {
+				return GasSuggestions{}, fmt.Errorf("error getting suggested priority fee: %w", err)
+			}
+			tips[i] = tip
+			continue
+		}
+		slices.SortFunc(rewards, func(a *big.Int, b *big.Int) int {
+			return a.Cmp(b)
+		})
+		tips[i] = rewards[len(rewards)/2]
+	}
+
+	// Each speed allows for the base fee rising for one more block than the last
+	standardBaseFee := raiseBaseFee(baseFee)
+	fastBaseFee := raiseBaseFee(standardBaseFee)
+	return GasSuggestions{
+		Source:  o.Name(),
+		BaseFee: baseFee,
+		Slow: FeeSuggestion{
+			MaxFee:      new(big.Int).Add(baseFee, tips[0]),
+			PriorityFee: tips[0],
+			WaitTime:    "A few blocks",
+		},
+		Standard: FeeSuggestion{
+			MaxFee:      new(big.Int).Add(standardBaseFee, tips[1]),
+			PriorityFee: tips[1],
+			WaitTime:    "1-2 blocks",
+		},
+		Fast: FeeSuggestion{
+			MaxFee:      new(big.Int).Add(fastBaseFee, tips[2]),
+			PriorityFee: tips[2],
+			WaitTime:    "Next block",
+		},
+	}, nil
+}
+
+// Get the highest the base fee can be after one more block
+func raiseBaseFee(baseFee *big.Int) *big.Int {
+	raised := new(big.Int).Mul(baseFee, big.NewInt(baseFeeIncreaseNumerator))
+	return raised.Div(raised, big.NewInt(baseFeeIncreaseDenominator))
+}
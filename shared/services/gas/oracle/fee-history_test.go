@@ -0,0 +1,93 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+type fakeFeeHistoryClient struct {
+	history *ethereum.FeeHistory
+	tip     *big.Int
+}
+
+func (c *fakeFeeHistoryClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return c.history, nil
+}
+
+func (c *fakeFeeHistoryClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return c.tip, nil
+}
+
+func rewards(values ...int64) []*big.Int {
+	rewards := make([]*big.Int, len(values))
+	for i, value := range values {
+		rewards[i] = big.NewInt(value)
+	}
+	return rewards
+}
+
+func TestFeeHistorySuggestions(t *testing.T) {
+	client := &fakeFeeHistoryClient{
+		history: &ethereum.FeeHistory{
+			Reward: [][]*big.Int{
+				rewards(1, 2, 3),
+				rewards(100, 200, 300), // Empty block, should be ignored
+				rewards(3, 4, 9),
+				rewards(2, 6, 5),
+			},
+			BaseFee:      rewards(60, 62, 64, 66, 64),
+			GasUsedRatio: []float64{0.5, 0, 0.6, 0.4},
+		},
+		tip: big.NewInt(1000),
+	}
+
+	suggestions, err := NewFeeHistoryOracle(client).GetSuggestions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if suggestions.BaseFee.Int64() != 64 {
+		t.Errorf("expected base fee 64, got %s", suggestions.BaseFee)
+	}
+
+	// Medians of each percentile are 2, 4 and 5
+	checks := []struct {
+		name        string
+		suggestion  FeeSuggestion
+		maxFee      int64
+		priorityFee int64
+	}{
+		{"slow", suggestions.Slow, 64 + 2, 2},
+		{"standard", suggestions.Standard, 72 + 4, 4},
+		{"fast", suggestions.Fast, 81 + 5, 5},
+	}
+	for _, check := range checks {
+		if check.suggestion.MaxFee.Int64() != check.maxFee {
+			t.Errorf("expected %s max fee %d, got %s", check.name, check.maxFee, check.suggestion.MaxFee)
+		}
+		if check.suggestion.PriorityFee.Int64() != check.priorityFee {
+			t.Errorf("expected %s priority fee %d, got %s", check.name, check.priorityFee, check.suggestion.PriorityFee)
+		}
+	}
+}
+
+func TestFeeHistoryFallsBackToTipCap(t *testing.T) {
+	client := &fakeFeeHistoryClient{
+		history: &ethereum.FeeHistory{
+			Reward:       [][]*big.Int{rewards(1, 2, 3)},
+			BaseFee:      rewards(10, 10),
+			GasUsedRatio: []float64{0},
+		},
+		tip: big.NewInt(7),
+	}
+
+	suggestions, err := NewFeeHistoryOracle(client).GetSuggestions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if suggestions.Slow.PriorityFee.Int64() != 7 || suggestions.Fast.PriorityFee.Int64() != 7 {
+		t.Errorf("expected every priority fee to fall back to the suggested tip cap, got %s and %s", suggestions.Slow.PriorityFee, suggestions.Fast.PriorityFee)
+	}
+}
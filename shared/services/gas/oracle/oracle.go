@@ -0,0 +1,86 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// A suggested max fee and priority fee for one transaction speed
+type FeeSuggestion struct {
+	MaxFee      *big.Int `json:"maxFee"`
+	PriorityFee *big.Int `json:"priorityFee"`
+	WaitTime    string   `json:"waitTime"`
+}
+
+// EIP-1559 fee suggestions for slow, standard and fast transactions
+type GasSuggestions struct {
+	Source   string        `json:"source"`
+	BaseFee  *big.Int      `json:"baseFee"`
+	Slow     FeeSuggestion `json:"slow"`
+	Standard FeeSuggestion `json:"standard"`
+	Fast     FeeSuggestion `json:"fast"`
+}
+
+// A source of gas fee suggestions
+type Oracle interface {
+	// Get the name of the oracle, which is shown alongside its suggestions
+	Name() string
+
+	// Get the current fee suggestions
+	GetSuggestions() (GasSuggestions, error)
+}
+
+// Get the oracle the user selected. External oracles fall back to the Execution Client's fee history if they fail.
+func NewOracle(cfg *config.RocketPoolConfig, ec FeeHistoryClient) Oracle {
+	var local Oracle
+	if ec != nil {
+		local = NewFeeHistoryOracle(ec)
+	}
+
+	var external Oracle
+	switch cfg.Smartnode.GasOracle.Value.(cfgtypes.GasOracle) {
+	case cfgtypes.GasOracle_Beaconcha:
+		external = &beaconchaOracle{cfg: cfg}
+	case cfgtypes.GasOracle_Etherscan:
+		external = &etherscanOracle{}
+	default:
+		if local != nil {
+			return local
+		}
+		// Without an Execution Client, an external oracle is the only option
+		return &beaconchaOracle{cfg: cfg}
+	}
+
+	if local == nil {
+		return external
+	}
+	return &fallbackOracle{
+		primary:  external,
+		fallback: local,
+	}
+}
+
+// Uses a fallback oracle when the primary one fails
+type fallbackOracle struct {
+	primary  Oracle
+	fallback Oracle
+}
+
+func (o *fallbackOracle) Name() string {
+	return o.primary.Name()
+}
+
+func (o *fallbackOracle) GetSuggestions() (GasSuggestions, error) {
+	suggestions, err := o.primary.GetSuggestions()
+	if err == nil {
+		return suggestions, nil
+	}
+	suggestions, fallbackErr := o.fallback.GetSuggestions()
+	if fallbackErr != nil {
+		return GasSuggestions{}, fmt.Errorf("error getting gas suggestions from %s (%s) and %s: %w", o.primary.Name(), err.Error(), o.fallback.Name(), fallbackErr)
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,347 @@
+package keymanager
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+// Config
+const (
+	RequestTimeout       time.Duration = 30 * time.Second
+	keystoresPath        string        = "/eth/v1/keystores"
+	remoteKeysPath       string        = "/eth/v1/remotekeys"
+	feeRecipientPath     string        = "/eth/v1/validator/%s/feerecipient"
+	maxErrorResponseSize int64         = 1024
+)
+
+// Statuses reported for each key in import and delete requests
+const (
+	StatusImported  string = "imported"
+	StatusDuplicate string = "duplicate"
+	StatusDeleted   string = "deleted"
+	StatusNotActive string = "not_active"
+	StatusNotFound  string = "not_found"
+	StatusError     string = "error"
+)
+
+// A client for the standard keymanager API, which Validator Clients and remote signers use to manage their keys at runtime
+type Client struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+// A local keystore loaded by the keymanager
+type Keystore struct {
+	ValidatingPubkey hexutil.Bytes `json:"validating_pubkey"`
+	DerivationPath   string        `json:"derivation_path"`
+	ReadOnly         bool          `json:"readonly"`
+}
+
+// A key loaded by the keymanager that signs through a remote signer
+type RemoteKey struct {
+	Pubkey   hexutil.Bytes `json:"pubkey"`
+	Url      string        `json:"url"`
+	ReadOnly bool          `json:"readonly,omitempty"`
+}
+
+// The result of importing or deleting a single key
+type KeyStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type importKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+type importRemoteKeysRequest struct {
+	RemoteKeys []RemoteKey `json:"remote_keys"`
+}
+
+type deleteKeysRequest struct {
+	Pubkeys []hexutil.Bytes `json:"pubkeys"`
+}
+
+type keyStatusesResponse struct {
+	Data []KeyStatus `json:"data"`
+}
+
+type deleteKeystoresResponse struct {
+	Data               []KeyStatus `json:"data"`
+	SlashingProtection string      `json:"slashing_protection"`
+}
+
+type listKeystoresResponse struct {
+	Data []Keystore `json:"data"`
+}
+
+type listRemoteKeysResponse struct {
+	Data []RemoteKey `json:"data"`
+}
+
+type setFeeRecipientRequest struct {
+	EthAddress common.Address `json:"ethaddress"`
+}
+
+type getFeeRecipientResponse struct {
+	Data struct {
+		EthAddress common.Address `json:"ethaddress"`
+	} `json:"data"`
+}
+
+// Create a new keymanager client; the token is sent as a bearer token with every request, unless it's blank
+func NewClient(url string, token string) *Client {
+	return &Client{
+		url:   strings.TrimSuffix(url, "/"),
+		token: token,
+		httpClient: &http.Client{
+			Timeout: RequestTimeout,
+		},
+	}
+}
+
+// List the local keystores the keymanager has loaded
+func (c *Client) ListKeystores() ([]Keystore, error) {
+	var response listKeystoresResponse
+	if err := c.Request(http.MethodGet, keystoresPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("error listing keystores: %w", err)
+	}
+	return response.Data, nil
+}
+
+// Import EIP-2335 keystores, along with an optional EIP-3076 slashing protection interchange for them.
+// Keys the keymanager already has are left as they are.
+func (c *Client) ImportKeystores(keystores []string, passwords []string, slashingProtection string) error {
+	request := importKeystoresRequest{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	}
+	var response keyStatusesResponse
+	if err := c.Request(http.MethodPost, keystoresPath, request, &response); err != nil {
+		return fmt.Errorf("error importing keystores: %w", err)
+	}
+	for i, result := range response.Data {
+		if result.Status == StatusError {
+			return fmt.Errorf("keymanager could not import keystore %d: %s", i, result.Message)
+		}
+	}
+	return nil
+}
+
+// Delete local keystores, returning the EIP-3076 slashing protection interchange for them
+func (c *Client) DeleteKeystores(pubkeys []types.ValidatorPubkey) (string, error) {
+	var response deleteKeystoresResponse
+	if err := c.Request(http.MethodDelete, keystoresPath, newDeleteKeysRequest(pubkeys), &response); err != nil {
+		return "", fmt.Errorf("error deleting keystores: %w", err)
+	}
+	for i, result := range response.Data {
+		if result.Status == StatusError {
+			return "", fmt.Errorf("keymanager could not delete keystore %s: %s", pubkeys[i].Hex(), result.Message)
+		}
+	}
+	return response.SlashingProtection, nil
+}
+
+// List the keys the keymanager signs for through a remote signer
+func (c *Client) ListRemoteKeys() ([]RemoteKey, error) {
+	var response listRemoteKeysResponse
+	if err := c.Request(http.MethodGet, remoteKeysPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("error listing remote keys: %w", err)
+	}
+	return response.Data, nil
+}
+
+// Register keys that sign through a remote signer
+func (c *Client) ImportRemoteKeys(remoteKeys []RemoteKey) error {
+	var response keyStatusesResponse
+	if err := c.Request(http.MethodPost, remoteKeysPath, importRemoteKeysRequest{RemoteKeys: remoteKeys}, &response); err != nil {
+		return fmt.Errorf("error importing remote keys: %w", err)
+	}
+	for i, result := range response.Data {
+		if result.Status == StatusError {
+			return fmt.Errorf("keymanager could not import remote key %s: %s", remoteKeys[i].Pubkey.String(), result.Message)
+		}
+	}
+	return nil
+}
+
+// Remove keys that sign through a remote signer
+func (c *Client) DeleteRemoteKeys(pubkeys []types.ValidatorPubkey) error {
+	var response keyStatusesResponse
+	if err := c.Request(http.MethodDelete, remoteKeysPath, newDeleteKeysRequest(pubkeys), &response); err != nil {
+		return fmt.Errorf("error deleting remote keys: %w", err)
+	}
+	for i, result := range response.Data {
+		if result.Status == StatusError {
+			return fmt.Errorf("keymanager could not delete remote key %s: %s", pubkeys[i].Hex(), result.Message)
+		}
+	}
+	return nil
+}
+
+// Get the fee recipient the keymanager uses for a single validator
+func (c *Client) GetFeeRecipient(pubkey types.ValidatorPubkey) (common.Address, error) {
+	var response getFeeRecipientResponse
+	path := fmt.Sprintf(feeRecipientPath, hexutil.Encode(pubkey.Bytes()))
+	if err := c.Request(http.MethodGet, path, nil, &response); err != nil {
+		return common.Address{}, fmt.Errorf("error getting fee recipient for validator %s: %w", pubkey.Hex(), err)
+	}
+	return response.Data.EthAddress, nil
+}
+
+// Set the fee recipient for a single validator, overriding the default one
+func (c *Client) SetFeeRecipient(pubkey types.ValidatorPubkey, feeRecipient common.Address) error {
+	path := fmt.Sprintf(feeRecipientPath, hexutil.Encode(pubkey.Bytes()))
+	if err := c.Request(http.MethodPost, path, setFeeRecipientRequest{EthAddress: feeRecipient}, nil); err != nil {
+		return fmt.Errorf("error setting fee recipient for validator %s: %w", pubkey.Hex(), err)
+	}
+	return nil
+}
+
+// Get the pubkeys of every validator the keymanager has loaded, from local keystores and remote signers
+func (c *Client) GetLoadedValidators() ([]types.ValidatorPubkey, error) {
+	keystores, err := c.ListKeystores()
+	if err != nil {
+		return nil, err
+	}
+	remoteKeys, err := c.ListRemoteKeys()
+	if err != nil {
+		return nil, err
+	}
+	pubkeys := make([]types.ValidatorPubkey, 0, len(keystores)+len(remoteKeys))
+	for _, keystore := range keystores {
+		pubkeys = append(pubkeys, types.BytesToValidatorPubkey(keystore.ValidatingPubkey))
+	}
+	for _, remoteKey := range remoteKeys {
+		pubkeys = append(pubkeys, types.BytesToValidatorPubkey(remoteKey.Pubkey))
+	}
+	return pubkeys, nil
+}
+
+// Check whether the keymanager has a key loaded for a validator, either from a local keystore or a remote signer
+func (c *Client) IsValidatorLoaded(pubkey types.ValidatorPubkey) (bool, error) {
+	pubkeys, err := c.GetLoadedValidators()
+	if err != nil {
+		return false, err
+	}
+	for _, loadedPubkey := range pubkeys {
+		if loadedPubkey == pubkey {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Remove the provided validators from the keymanager, whether it signs for them with a local keystore or through a remote signer.
+// Validators it doesn't have are skipped. Returns the EIP-3076 slashing protection interchange for the deleted keystores,
+// which is blank if none were deleted, and the number of validators that were removed.
+func (c *Client) DeleteValidators(pubkeys []types.ValidatorPubkey) (string, int, error) {
+	keystores, err := c.ListKeystores()
+	if err != nil {
+		return "", 0, err
+	}
+	remoteKeys, err := c.ListRemoteKeys()
+	if err != nil {
+		return "", 0, err
+	}
+
+	// Split the validators by the kind of key the keymanager has for them
+	localPubkeys := []types.ValidatorPubkey{}
+	for _, keystore := range keystores {
+		pubkey := types.BytesToValidatorPubkey(keystore.ValidatingPubkey)
+		if slices.Contains(pubkeys, pubkey) {
+			localPubkeys = append(localPubkeys, pubkey)
+		}
+	}
+	remotePubkeys := []types.ValidatorPubkey{}
+	for _, remoteKey := range remoteKeys {
+		pubkey := types.BytesToValidatorPubkey(remoteKey.Pubkey)
+		if slices.Contains(pubkeys, pubkey) {
+			remotePubkeys = append(remotePubkeys, pubkey)
+		}
+	}
+
+	var slashingProtection string
+	if len(localPubkeys) > 0 {
+		slashingProtection, err = c.DeleteKeystores(localPubkeys)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	if len(remotePubkeys) > 0 {
+		if err := c.DeleteRemoteKeys(remotePubkeys); err != nil {
+			return slashingProtection, len(localPubkeys), err
+		}
+	}
+	return slashingProtection, len(localPubkeys) + len(remotePubkeys), nil
+}
+
+// Build a request to delete the provided keys
+func newDeleteKeysRequest(pubkeys []types.ValidatorPubkey) deleteKeysRequest {
+	request := deleteKeysRequest{
+		Pubkeys: make([]hexutil.Bytes, len(pubkeys)),
+	}
+	for i, pubkey := range pubkeys {
+		request.Pubkeys[i] = pubkey.Bytes()
+	}
+	return request
+}
+
+// Send a JSON request to the keymanager and decode its JSON response, if one is wanted
+func (c *Client) Request(method string, path string, request interface{}, response interface{}) error {
+	var body io.Reader
+	if request != nil {
+		requestBytes, err := json.Marshal(request)
+		if err != nil {
+			return fmt.Errorf("error serializing request: %w", err)
+		}
+		body = bytes.NewReader(requestBytes)
+	}
+	httpRequest, err := http.NewRequest(method, c.url+path, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	if request != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	httpRequest.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		httpRequest.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode < 200 || httpResponse.StatusCode > 299 {
+		responseBody, _ := io.ReadAll(io.LimitReader(httpResponse.Body, maxErrorResponseSize))
+		return fmt.Errorf("request failed with code %d: %s", httpResponse.StatusCode, string(responseBody))
+	}
+	if response == nil {
+		return nil
+	}
+
+	responseBody, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if err := json.Unmarshal(responseBody, response); err != nil {
+		return fmt.Errorf("error deserializing response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package keymanager
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+const testToken string = "api-token"
+
+var (
+	localPubkey   = types.BytesToValidatorPubkey([]byte{0x01})
+	remotePubkey  = types.BytesToValidatorPubkey([]byte{0x02})
+	unknownPubkey = types.BytesToValidatorPubkey([]byte{0x03})
+)
+
+// A keymanager with one local keystore and one remote key that records the keys it was asked to delete
+type testKeymanager struct {
+	deletedKeystores  []types.ValidatorPubkey
+	deletedRemoteKeys []types.ValidatorPubkey
+	feeRecipients     map[string]common.Address
+	failImports       bool
+}
+
+func (k *testKeymanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+
+	switch {
+	case r.URL.Path == keystoresPath && r.Method == http.MethodGet:
+		writeJson(w, listKeystoresResponse{Data: []Keystore{{ValidatingPubkey: localPubkey.Bytes()}}})
+	case r.URL.Path == remoteKeysPath && r.Method == http.MethodGet:
+		writeJson(w, listRemoteKeysResponse{Data: []RemoteKey{{Pubkey: remotePubkey.Bytes(), Url: "http://signer"}}})
+	case r.URL.Path == keystoresPath && r.Method == http.MethodDelete:
+		k.deletedKeystores = append(k.deletedKeystores, decodeDeleteRequest(body)...)
+		writeJson(w, deleteKeystoresResponse{Data: []KeyStatus{{Status: StatusDeleted}}, SlashingProtection: "interchange"})
+	case r.URL.Path == remoteKeysPath && r.Method == http.MethodDelete:
+		k.deletedRemoteKeys = append(k.deletedRemoteKeys, decodeDeleteRequest(body)...)
+		writeJson(w, keyStatusesResponse{Data: []KeyStatus{{Status: StatusDeleted}}})
+	case r.URL.Path == keystoresPath && r.Method == http.MethodPost:
+		status := StatusImported
+		if k.failImports {
+			status = StatusError
+		}
+		writeJson(w, keyStatusesResponse{Data: []KeyStatus{{Status: status, Message: "bad keystore"}}})
+	case strings.HasSuffix(r.URL.Path, "/feerecipient"):
+		if r.Method == http.MethodPost {
+			var request setFeeRecipientRequest
+			_ = json.Unmarshal(body, &request)
+			k.feeRecipients[r.URL.Path] = request.EthAddress
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		var response getFeeRecipientResponse
+		response.Data.EthAddress = k.feeRecipients[r.URL.Path]
+		writeJson(w, response)
+	default:
+		http.Error(w, strings.Repeat("x", 4096), http.StatusNotFound)
+	}
+}
+
+func writeJson(w http.ResponseWriter, response interface{}) {
+	bytes, _ := json.Marshal(response)
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bytes)
+}
+
+func decodeDeleteRequest(body []byte) []types.ValidatorPubkey {
+	var request deleteKeysRequest
+	_ = json.Unmarshal(body, &request)
+	pubkeys := make([]types.ValidatorPubkey, len(request.Pubkeys))
+	for i, pubkey := range request.Pubkeys {
+		pubkeys[i] = types.BytesToValidatorPubkey(pubkey)
+	}
+	return pubkeys
+}
+
+func newTestClient(t *testing.T) (*Client, *testKeymanager) {
+	km := &testKeymanager{
+		feeRecipients: map[string]common.Address{},
+	}
+	server := httptest.NewServer(km)
+	t.Cleanup(server.Close)
+	return NewClient(server.URL+"/", testToken), km
+}
+
+func TestGetLoadedValidators(t *testing.T) {
+	client, _ := newTestClient(t)
+	pubkeys, err := client.GetLoadedValidators()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pubkeys) != 2 || pubkeys[0] != localPubkey || pubkeys[1] != remotePubkey {
+		t.Fatalf("expected the local and remote keys, got %v", pubkeys)
+	}
+
+	loaded, err := client.IsValidatorLoaded(unknownPubkey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loaded {
+		t.Fatal("expected an unknown key not to be loaded")
+	}
+}
+
+func TestDeleteValidators(t *testing.T) {
+	client, km := newTestClient(t)
+	slashingProtection, removed, err := client.DeleteValidators([]types.ValidatorPubkey{localPubkey, remotePubkey, unknownPubkey})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if removed != 2 {
+		t.Fatalf("expected 2 removed validators, got %d", removed)
+	}
+	if slashingProtection != "interchange" {
+		t.Fatalf("expected the slashing protection interchange, got %q", slashingProtection)
+	}
+	if !slices.Equal(km.deletedKeystores, []types.ValidatorPubkey{localPubkey}) {
+		t.Fatalf("expected only the local key to be deleted as a keystore, got %v", km.deletedKeystores)
+	}
+	if !slices.Equal(km.deletedRemoteKeys, []types.ValidatorPubkey{remotePubkey}) {
+		t.Fatalf("expected only the remote key to be deleted as a remote key, got %v", km.deletedRemoteKeys)
+	}
+}
+
+func TestDeleteValidatorsSkipsUnloadedKeys(t *testing.T) {
+	client, km := newTestClient(t)
+	_, removed, err := client.DeleteValidators([]types.ValidatorPubkey{unknownPubkey})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if removed != 0 || len(km.deletedKeystores) != 0 || len(km.deletedRemoteKeys) != 0 {
+		t.Fatalf("expected nothing to be deleted, got %d removed", removed)
+	}
+}
+
+func TestFeeRecipient(t *testing.T) {
+	client, _ := newTestClient(t)
+	feeRecipient := common.HexToAddress("0x1234")
+	if err := client.SetFeeRecipient(localPubkey, feeRecipient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current, err := client.GetFeeRecipient(localPubkey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if current != feeRecipient {
+		t.Fatalf("expected fee recipient %s, got %s", feeRecipient.Hex(), current.Hex())
+	}
+}
+
+func TestImportKeystoresReportsErrors(t *testing.T) {
+	client, km := newTestClient(t)
+	if err := client.ImportKeystores([]string{"{}"}, []string{"password"}, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	km.failImports = true
+	err := client.ImportKeystores([]string{"{}"}, []string{"password"}, "")
+	if err == nil || !strings.Contains(err.Error(), "bad keystore") {
+		t.Fatalf("expected the import error to be reported, got %v", err)
+	}
+}
+
+func TestRequestErrors(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	// Error responses are truncated
+	err := client.Request(http.MethodGet, "/unknown", nil, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "404") || len(err.Error()) > int(maxErrorResponseSize)+100 {
+		t.Fatalf("expected a truncated 404 error, got %d bytes", len(err.Error()))
+	}
+
+	// Requests without the token are rejected
+	client.token = ""
+	if _, err := client.ListKeystores(); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+}
+
+// Make sure hexutil-encoded pubkeys survive the round trip through the request bodies
+func TestDeleteKeysRequestEncoding(t *testing.T) {
+	request := newDeleteKeysRequest([]types.ValidatorPubkey{localPubkey})
+	bytes, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(bytes), hexutil.Encode(localPubkey.Bytes())) {
+		t.Fatalf("expected the pubkey to be hex encoded, got %s", string(bytes))
+	}
+}
@@ -35,157 +35,142 @@ import (
 const MAX_WITHDRAWAL_SLOT_DISTANCE = 144000 // 20 days.
 
 func GetValidatorProof(c *cli.Context, wallet wallet.Wallet, eth2Config beacon.Eth2Config, megapoolAddress common.Address, validatorPubkey types.ValidatorPubkey) (megapool.ValidatorProof, error) {
-
-	bc, err := GetBeaconClient(c)
+	proofs, errs, err := GetValidatorProofs(c, []types.ValidatorPubkey{validatorPubkey})
 	if err != nil {
 		return megapool.ValidatorProof{}, err
 	}
-
-	// Get the validator index on the beacon chain
-	validatorIndex, err := bc.GetValidatorIndex(validatorPubkey)
-	if err != nil {
-		return megapool.ValidatorProof{}, err
+	if errs[0] != nil {
+		return megapool.ValidatorProof{}, errs[0]
 	}
+	return proofs[0], nil
+}
 
-	validatorIndex64, err := strconv.ParseUint(validatorIndex, 10, 64)
+// Get proofs for several validators at once. They all come from the same beacon state, which is only downloaded and
+// merkleized if there isn't a recent one in the proof cache.
+// A validator that can't be proved, e.g. because it isn't on the beacon chain yet, gets an error at its position in the
+// returned errors without failing the others; the final error is only set if the beacon chain couldn't be queried.
+func GetValidatorProofs(c *cli.Context, validatorPubkeys []types.ValidatorPubkey) ([]megapool.ValidatorProof, []error, error) {
+	proofs := make([]megapool.ValidatorProof, len(validatorPubkeys))
+	if len(validatorPubkeys) == 0 {
+		return proofs, []error{}, nil
+	}
+	validatorIndices, errs, err := getValidatorIndices(c, validatorPubkeys)
 	if err != nil {
-		return megapool.ValidatorProof{}, err
+		return nil, nil, err
 	}
 
-	// Get the head block, requesting the previous one until we have an execution payload
-	blockToRequest := "head"
-	var block beacon.BeaconBlock
-	const maxAttempts = 10
-	for attempts := 0; attempts < maxAttempts; attempts++ {
-		block, _, err = bc.GetBeaconBlock(blockToRequest)
-		if err != nil {
-			return megapool.ValidatorProof{}, err
-		}
-
-		if block.HasExecutionPayload {
-			break
-		}
-		if attempts == maxAttempts-1 {
-			return megapool.ValidatorProof{}, fmt.Errorf("failed to find a block with execution payload after %d attempts", maxAttempts)
+	// Only prove the validators that are on the beacon chain
+	provablePositions := []int{}
+	provableIndices := []uint64{}
+	for i, validatorIndex := range validatorIndices {
+		if errs[i] == nil {
+			provablePositions = append(provablePositions, i)
+			provableIndices = append(provableIndices, validatorIndex)
 		}
-		blockToRequest = fmt.Sprintf("%d", block.Slot-1)
 	}
-
-	// Get the beacon state for that slot
-	beaconStateResponse, err := bc.GetBeaconStateSSZ(block.Slot)
-	if err != nil {
-		return megapool.ValidatorProof{}, err
+	if len(provableIndices) == 0 {
+		return proofs, errs, nil
 	}
-
-	beaconState, err := eth2.NewBeaconState(beaconStateResponse.Data, beaconStateResponse.Fork)
+	proofService, err := GetProofService(c)
 	if err != nil {
-		return megapool.ValidatorProof{}, err
+		return nil, nil, err
 	}
-
-	proofBytes, err := beaconState.ValidatorProof(validatorIndex64)
+	stateProofs, stateProofErrs, err := proofService.GetValidatorProofs(provableIndices)
 	if err != nil {
-		return megapool.ValidatorProof{}, err
+		return nil, nil, err
 	}
 
-	validators := beaconState.GetValidators()
-
-	// Convert [][]byte to [][32]byte
-	proofWithFixedSize := ConvertToFixedSize(proofBytes)
-
-	withdrawalCredentials := validators[validatorIndex64].WithdrawalCredentials
-	// Convert the WithdrawalCredentials to the fixed size [32]byte
-	var withdrawalCredentialsFixed [32]byte
-	copy(withdrawalCredentialsFixed[:], withdrawalCredentials[:])
-
-	val := megapool.ProvedValidator{
-		Pubkey:                     validatorPubkey[:],
-		WithdrawalCredentials:      withdrawalCredentialsFixed,
-		EffectiveBalance:           big.NewInt(int64(validators[validatorIndex64].EffectiveBalance)),
-		Slashed:                    validators[validatorIndex64].Slashed,
-		ActivationEligibilityEpoch: validators[validatorIndex64].ActivationEligibilityEpoch,
-		ActivationEpoch:            validators[validatorIndex64].ActivationEpoch,
-		ExitEpoch:                  validators[validatorIndex64].ExitEpoch,
-		WithdrawableEpoch:          validators[validatorIndex64].WithdrawableEpoch,
-	}
-	proof := megapool.ValidatorProof{
-		Slot:           block.Slot,
-		ValidatorIndex: validatorIndex64,
-		Validator:      val,
-		Witnesses:      proofWithFixedSize,
-	}
-
-	return proof, err
+	for j, i := range provablePositions {
+		if stateProofErrs[j] != nil {
+			errs[i] = stateProofErrs[j]
+			continue
+		}
+		stateProof := stateProofs[j]
+		validator := stateProof.Validator
+		var withdrawalCredentials [32]byte
+		copy(withdrawalCredentials[:], validator.WithdrawalCredentials)
+		proofs[i] = megapool.ValidatorProof{
+			Slot:           stateProof.Slot,
+			ValidatorIndex: stateProof.ValidatorIndex,
+			Validator: megapool.ProvedValidator{
+				Pubkey:                     validatorPubkeys[i][:],
+				WithdrawalCredentials:      withdrawalCredentials,
+				EffectiveBalance:           new(big.Int).SetUint64(validator.EffectiveBalance),
+				Slashed:                    validator.Slashed,
+				ActivationEligibilityEpoch: validator.ActivationEligibilityEpoch,
+				ActivationEpoch:            validator.ActivationEpoch,
+				ExitEpoch:                  validator.ExitEpoch,
+				WithdrawableEpoch:          validator.WithdrawableEpoch,
+			},
+			Witnesses: stateProof.Witnesses,
+		}
+	}
+	return proofs, errs, nil
 }
 
 func GetWithdrawableEpochProof(c *cli.Context, wallet *wallet.Wallet, eth2Config beacon.Eth2Config, megapoolAddress common.Address, validatorPubkey types.ValidatorPubkey) (api.ValidatorWithdrawableEpochProof, error) {
-	bc, err := GetBeaconClient(c)
+	validatorIndices, errs, err := getValidatorIndices(c, []types.ValidatorPubkey{validatorPubkey})
 	if err != nil {
 		return api.ValidatorWithdrawableEpochProof{}, err
 	}
-
-	// Get the validator index on the beacon chain
-	validatorIndex, err := bc.GetValidatorIndex(validatorPubkey)
-	if err != nil {
-		return api.ValidatorWithdrawableEpochProof{}, err
+	if errs[0] != nil {
+		return api.ValidatorWithdrawableEpochProof{}, errs[0]
 	}
-
-	validatorIndex64, err := strconv.ParseUint(validatorIndex, 10, 64)
+	proofService, err := GetProofService(c)
 	if err != nil {
 		return api.ValidatorWithdrawableEpochProof{}, err
 	}
-
-	// Get the head block, requesting the previous one until we have an execution payload
-	blockToRequest := "head"
-	var block beacon.BeaconBlock
-	const maxAttempts = 10
-	for attempts := 0; attempts < maxAttempts; attempts++ {
-		block, _, err = bc.GetBeaconBlock(blockToRequest)
-		if err != nil {
-			return api.ValidatorWithdrawableEpochProof{}, err
-		}
-
-		if block.HasExecutionPayload {
-			break
-		}
-		if attempts == maxAttempts-1 {
-			return api.ValidatorWithdrawableEpochProof{}, fmt.Errorf("failed to find a block with execution payload after %d attempts", maxAttempts)
-		}
-		blockToRequest = fmt.Sprintf("%d", block.Slot-1)
-	}
-
-	// Get the beacon state for that slot
-	beaconStateResponse, err := bc.GetBeaconStateSSZ(block.Slot)
+	stateProofs, errs, err := proofService.GetValidatorProofs(validatorIndices)
 	if err != nil {
 		return api.ValidatorWithdrawableEpochProof{}, err
 	}
-
-	beaconState, err := eth2.NewBeaconState(beaconStateResponse.Data, beaconStateResponse.Fork)
-	if err != nil {
-		return api.ValidatorWithdrawableEpochProof{}, err
+	if errs[0] != nil {
+		return api.ValidatorWithdrawableEpochProof{}, errs[0]
 	}
+	stateProof := stateProofs[0]
 
-	withdrawableEpoch := beaconState.GetValidators()[validatorIndex64].WithdrawableEpoch
+	withdrawableEpoch := stateProof.Validator.WithdrawableEpoch
 	if withdrawableEpoch == math.MaxUint64 {
-		return api.ValidatorWithdrawableEpochProof{}, fmt.Errorf("validator %d is not withdrawable", validatorIndex64)
-	}
-
-	proofBytes, err := beaconState.ValidatorProof(validatorIndex64)
-	if err != nil {
-		return api.ValidatorWithdrawableEpochProof{}, err
+		return api.ValidatorWithdrawableEpochProof{}, fmt.Errorf("validator %d is not withdrawable", stateProof.ValidatorIndex)
 	}
 
-	// Convert [][]byte to [][32]byte
-	proofWithFixedSize := ConvertToFixedSize(proofBytes)
-
 	proof := api.ValidatorWithdrawableEpochProof{
-		Slot:              block.Slot,
-		ValidatorIndex:    new(big.Int).SetUint64(validatorIndex64),
+		Slot:              stateProof.Slot,
+		ValidatorIndex:    new(big.Int).SetUint64(stateProof.ValidatorIndex),
 		Pubkey:            validatorPubkey[:],
 		WithdrawableEpoch: withdrawableEpoch,
-		Witnesses:         proofWithFixedSize,
+		Witnesses:         stateProof.Witnesses,
+	}
+
+	return proof, nil
+}
+
+// Get the beacon chain indices of the provided validators.
+// Validators that aren't on the beacon chain get an error at their position in the returned errors.
+func getValidatorIndices(c *cli.Context, validatorPubkeys []types.ValidatorPubkey) ([]uint64, []error, error) {
+	bc, err := GetBeaconClient(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	statuses, err := bc.GetValidatorStatuses(validatorPubkeys, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return proof, err
+	validatorIndices := make([]uint64, len(validatorPubkeys))
+	errs := make([]error, len(validatorPubkeys))
+	for i, pubkey := range validatorPubkeys {
+		status, exists := statuses[pubkey]
+		if !exists || !status.Exists {
+			errs[i] = fmt.Errorf("validator %s is not on the beacon chain", pubkey.Hex())
+			continue
+		}
+		validatorIndices[i], err = strconv.ParseUint(status.Index, 10, 64)
+		if err != nil {
+			errs[i] = fmt.Errorf("error parsing index [%s] of validator %s: %w", status.Index, pubkey.Hex(), err)
+		}
+	}
+	return validatorIndices, errs, nil
 }
 
 func ConvertToFixedSize(proofBytes [][]byte) [][32]byte {
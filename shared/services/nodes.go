@@ -0,0 +1,81 @@
+package services
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+
+	"github.com/urfave/cli"
+)
+
+// The name the primary node can be selected with; its files live directly in the data folder
+const DefaultNodeName string = "default"
+
+// Node names become folder names and metric labels, so they're kept simple
+var nodeNamePattern = regexp.MustCompile("^[a-z0-9][a-z0-9-]*$")
+
+// Get the name of the node selected with the --node flag. The primary node has an empty name.
+func GetNodeName(c *cli.Context) (string, error) {
+	node := c.GlobalString("node")
+	if node == "" || node == DefaultNodeName {
+		return "", nil
+	}
+	if !nodeNamePattern.MatchString(node) {
+		return "", fmt.Errorf("Invalid node name [%s]; node names can only contain lowercase letters, numbers and dashes.", node)
+	}
+	return node, nil
+}
+
+// Get the names of every node managed by this installation, starting with the primary node.
+// Additional nodes are the folders in the nodes directory that have a wallet in them.
+func GetNodeNames(c *cli.Context) ([]string, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []string{""}
+	entries, err := os.ReadDir(os.ExpandEnv(cfg.Smartnode.GetNodesPath()))
+	if os.IsNotExist(err) {
+		return nodes, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading nodes directory: %w", err)
+	}
+	additionalNodes := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() || !nodeNamePattern.MatchString(entry.Name()) || entry.Name() == DefaultNodeName {
+			continue
+		}
+		_, err := os.Stat(os.ExpandEnv(cfg.Smartnode.GetWalletPathForNode(entry.Name())))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error checking wallet for node [%s]: %w", entry.Name(), err)
+		}
+		additionalNodes = append(additionalNodes, entry.Name())
+	}
+	slices.Sort(additionalNodes)
+	return append(nodes, additionalNodes...), nil
+}
+
+// Get the name to show for a node in logs and metrics
+func GetNodeDisplayName(node string) string {
+	if node == "" {
+		return DefaultNodeName
+	}
+	return node
+}
+
+// Create a context that selects the given node, so a daemon can run the same tasks for each of its nodes.
+// Every other global flag is still read from the original context.
+func NewNodeContext(c *cli.Context, node string) *cli.Context {
+	// Global flags are looked up starting from a context's parent, so the node flag goes in an intermediate context
+	set := flag.NewFlagSet("node", flag.ContinueOnError)
+	set.String("node", node, "")
+	nodeCtx := cli.NewContext(c.App, set, c)
+	return cli.NewContext(c.App, flag.NewFlagSet(c.App.Name, flag.ContinueOnError), nodeCtx)
+}
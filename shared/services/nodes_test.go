@@ -0,0 +1,65 @@
+package services
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/urfave/cli"
+)
+
+// Create a command context with the global flags the daemons use
+func newTestContext(node string) *cli.Context {
+	app := cli.NewApp()
+	set := flag.NewFlagSet(app.Name, flag.ContinueOnError)
+	set.String("settings", "/.rocketpool/user-settings.yml", "")
+	set.String("node", node, "")
+	globalCtx := cli.NewContext(app, set, nil)
+	return cli.NewContext(app, flag.NewFlagSet("node", flag.ContinueOnError), globalCtx)
+}
+
+func TestGetNodeName(t *testing.T) {
+	for _, test := range []struct {
+		flag     string
+		expected string
+		valid    bool
+	}{
+		{"", "", true},
+		{DefaultNodeName, "", true},
+		{"node-2", "node-2", true},
+		{"Node2", "", false},
+		{"../wallet", "", false},
+		{"-node", "", false},
+	} {
+		node, err := GetNodeName(newTestContext(test.flag))
+		if test.valid && err != nil {
+			t.Errorf("unexpected error for node [%s]: %s", test.flag, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected an error for node [%s]", test.flag)
+		}
+		if node != test.expected {
+			t.Errorf("expected node [%s] for flag [%s], got [%s]", test.expected, test.flag, node)
+		}
+	}
+}
+
+func TestNewNodeContext(t *testing.T) {
+	c := newTestContext("")
+	nodeCtx := NewNodeContext(c, "node-2")
+
+	node, err := GetNodeName(nodeCtx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if node != "node-2" {
+		t.Errorf("expected the node context to select [node-2], got [%s]", node)
+	}
+
+	// The other global flags still come from the original context, which isn't changed
+	if settings := nodeCtx.GlobalString("settings"); settings != "/.rocketpool/user-settings.yml" {
+		t.Errorf("expected the settings flag to be inherited, got [%s]", settings)
+	}
+	if node, _ := GetNodeName(c); node != "" {
+		t.Errorf("expected the original context to still select the primary node, got [%s]", node)
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 )
 
 // Config
 const (
 	MinPasswordLength = 12
 	FileMode          = 0600
+	DirMode           = 0700
 )
 
 // Password manager
@@ -57,7 +59,10 @@ func (pm *PasswordManager) SetPassword(password string) error {
 		return fmt.Errorf("Password must be at least %d characters long", MinPasswordLength)
 	}
 
-	// Write to disk
+	// Write to disk, creating the folder for additional nodes if this is their first file
+	if err := os.MkdirAll(filepath.Dir(pm.passwordPath), DirMode); err != nil {
+		return fmt.Errorf("Could not create password directory: %w", err)
+	}
 	if err := os.WriteFile(pm.passwordPath, []byte(password), FileMode); err != nil {
 		return fmt.Errorf("Could not write password to disk: %w", err)
 	}
@@ -0,0 +1,255 @@
+package proofs
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+const (
+	// How many slots behind the head a cached state can be and still be used for new proofs.
+	// This lets the API reuse the state it proved against a moment ago, e.g. between estimating gas and sending a transaction.
+	maxStateAge uint64 = 32
+
+	// How many cached states to keep on disk
+	cacheLimit int = 2
+
+	// How many blocks to go back from the head to find one with an execution payload
+	maxBlockAttempts int = 10
+
+	cacheFileExtension string      = ".proofs"
+	cacheDirMode       os.FileMode = 0755
+)
+
+// A proof of a validator's record in the beacon state, against the root of the block at its slot
+type ValidatorProof struct {
+	Slot           uint64
+	ValidatorIndex uint64
+	Validator      *generic.Validator
+	Witnesses      [][32]byte
+}
+
+// Generates validator proofs, downloading and merkleizing each beacon state only once.
+// The merkleized validator registry is cached on disk by state root, so the API and both daemons can share it
+// without keeping the state in memory.
+type Service struct {
+	bc        beacon.Client
+	cachePath string
+	lock      sync.Mutex
+}
+
+// Create a new proof service
+func NewService(cfg *config.RocketPoolConfig, bc beacon.Client) *Service {
+	return &Service{
+		bc:        bc,
+		cachePath: os.ExpandEnv(cfg.Smartnode.GetProofCachePath()),
+	}
+}
+
+// Get proofs for the provided validators, all from the same recent beacon state.
+// A validator that can't be proved gets an error at its position in the returned errors, without failing the others;
+// the final error is only set if the state couldn't be loaded at all.
+func (s *Service) GetValidatorProofs(validatorIndices []uint64) ([]ValidatorProof, []error, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	cache, err := s.getStateCache(validatorIndices)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cache.Close()
+
+	proofs := make([]ValidatorProof, len(validatorIndices))
+	errs := make([]error, len(validatorIndices))
+	for i, validatorIndex := range validatorIndices {
+		proofs[i], err = cache.GetValidatorProof(validatorIndex)
+		if err != nil {
+			errs[i] = fmt.Errorf("error getting proof for validator %d: %w", validatorIndex, err)
+		}
+	}
+	return proofs, errs, nil
+}
+
+// Get a cached state that has all of the provided validators, only downloading a new one if there isn't a recent one on disk
+func (s *Service) getStateCache(validatorIndices []uint64) (*StateCache, error) {
+	header, err := s.getLatestBlockHeaderWithPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := s.openRecentCache(header, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+	if cache != nil {
+		return cache, nil
+	}
+
+	return s.createCache(header)
+}
+
+// Get the header of the head block, or of the most recent block before it with an execution payload
+func (s *Service) getLatestBlockHeaderWithPayload() (beacon.BeaconBlockHeader, error) {
+	blockToRequest := "head"
+	for attempts := 0; attempts < maxBlockAttempts; attempts++ {
+		block, exists, err := s.bc.GetBeaconBlock(blockToRequest)
+		if err != nil {
+			return beacon.BeaconBlockHeader{}, fmt.Errorf("error getting beacon block %s: %w", blockToRequest, err)
+		}
+		if exists && block.HasExecutionPayload {
+			header, exists, err := s.bc.GetBeaconBlockHeader(strconv.FormatUint(block.Slot, 10))
+			if err != nil {
+				return beacon.BeaconBlockHeader{}, fmt.Errorf("error getting beacon block header for slot %d: %w", block.Slot, err)
+			}
+			if !exists {
+				return beacon.BeaconBlockHeader{}, fmt.Errorf("beacon block header for slot %d does not exist", block.Slot)
+			}
+			return header, nil
+		}
+		if !exists {
+			// Missed slots don't have a block, so go back from the slot that was requested instead
+			slot, err := strconv.ParseUint(blockToRequest, 10, 64)
+			if err != nil {
+				return beacon.BeaconBlockHeader{}, fmt.Errorf("beacon block %s does not exist", blockToRequest)
+			}
+			block.Slot = slot
+		}
+		blockToRequest = strconv.FormatUint(block.Slot-1, 10)
+	}
+	return beacon.BeaconBlockHeader{}, fmt.Errorf("failed to find a block with execution payload after %d attempts", maxBlockAttempts)
+}
+
+// Open the newest cached state if it's recent, still canonical, and has all of the provided validators.
+// Returns nil if there isn't one, so a fresh state is downloaded for validators that were added since it was cached.
+func (s *Service) openRecentCache(header beacon.BeaconBlockHeader, validatorIndices []uint64) (*StateCache, error) {
+	cacheFiles, err := s.getCacheFiles()
+	if err != nil {
+		return nil, err
+	}
+	if len(cacheFiles) == 0 {
+		return nil, nil
+	}
+	newest := cacheFiles[0]
+	if newest.slot > header.Slot || newest.slot+maxStateAge < header.Slot {
+		return nil, nil
+	}
+
+	// Make sure the cached state wasn't reorged out
+	if newest.slot != header.Slot {
+		cachedHeader, exists, err := s.bc.GetBeaconBlockHeader(strconv.FormatUint(newest.slot, 10))
+		if err != nil {
+			return nil, fmt.Errorf("error getting beacon block header for slot %d: %w", newest.slot, err)
+		}
+		if !exists {
+			return nil, nil
+		}
+		header = cachedHeader
+	}
+	if header.StateRoot != newest.stateRoot {
+		return nil, nil
+	}
+
+	cache, err := OpenStateCache(newest.path)
+	if err != nil {
+		return nil, err
+	}
+	for _, validatorIndex := range validatorIndices {
+		if validatorIndex >= cache.ValidatorCount() {
+			cache.Close()
+			return nil, nil
+		}
+	}
+	return cache, nil
+}
+
+// Download the state for the provided block, merkleize its validators, and cache them on disk
+func (s *Service) createCache(header beacon.BeaconBlockHeader) (*StateCache, error) {
+	stateResponse, err := s.bc.GetBeaconStateSSZ(header.Slot)
+	if err != nil {
+		return nil, err
+	}
+	state, err := eth2.NewBeaconState(stateResponse.Data, stateResponse.Fork)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding beacon state for slot %d: %w", header.Slot, err)
+	}
+	// The encoded state is the biggest allocation here, so let it be collected while the validators are merkleized
+	stateResponse = nil
+
+	err = os.MkdirAll(s.cachePath, cacheDirMode)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proof cache directory: %w", err)
+	}
+	path := filepath.Join(s.cachePath, fmt.Sprintf("%d-%s%s", header.Slot, header.StateRoot.Hex(), cacheFileExtension))
+	err = WriteStateCache(path, state, header.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	s.pruneCache()
+
+	return OpenStateCache(path)
+}
+
+// A state cached on disk
+type cacheFile struct {
+	path      string
+	slot      uint64
+	stateRoot common.Hash
+}
+
+// Get the cached states on disk, newest first
+func (s *Service) getCacheFiles() ([]cacheFile, error) {
+	entries, err := os.ReadDir(s.cachePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading proof cache directory: %w", err)
+	}
+
+	cacheFiles := []cacheFile{}
+	for _, entry := range entries {
+		name, isCacheFile := strings.CutSuffix(entry.Name(), cacheFileExtension)
+		if entry.IsDir() || !isCacheFile {
+			continue
+		}
+		slotString, stateRoot, found := strings.Cut(name, "-")
+		if !found {
+			continue
+		}
+		slot, err := strconv.ParseUint(slotString, 10, 64)
+		if err != nil {
+			continue
+		}
+		cacheFiles = append(cacheFiles, cacheFile{
+			path:      filepath.Join(s.cachePath, entry.Name()),
+			slot:      slot,
+			stateRoot: common.HexToHash(stateRoot),
+		})
+	}
+	slices.SortFunc(cacheFiles, func(a, b cacheFile) int {
+		return cmp.Compare(b.slot, a.slot)
+	})
+	return cacheFiles, nil
+}
+
+// Delete all but the newest cached states. Processes that still have an old one open can keep reading it.
+func (s *Service) pruneCache() {
+	cacheFiles, err := s.getCacheFiles()
+	if err != nil || len(cacheFiles) <= cacheLimit {
+		return
+	}
+	for _, file := range cacheFiles[cacheLimit:] {
+		_ = os.Remove(file.path)
+	}
+}
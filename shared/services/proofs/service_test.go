@@ -0,0 +1,72 @@
+package proofs
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	eth2test "github.com/rocket-pool/smartnode/shared/types/eth2/test"
+)
+
+// A Beacon Node that serves a single state at the head, with a block that has an execution payload
+type testBeaconClient struct {
+	beacon.Client
+	header beacon.BeaconBlockHeader
+	state  []byte
+}
+
+func (c *testBeaconClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock, bool, error) {
+	return beacon.BeaconBlock{Slot: c.header.Slot, HasExecutionPayload: true}, true, nil
+}
+
+func (c *testBeaconClient) GetBeaconBlockHeader(blockId string) (beacon.BeaconBlockHeader, bool, error) {
+	return c.header, blockId == strconv.FormatUint(c.header.Slot, 10), nil
+}
+
+func (c *testBeaconClient) GetBeaconStateSSZ(slot uint64) (*beacon.BeaconStateSSZ, error) {
+	return &beacon.BeaconStateSSZ{Data: c.state, Fork: "fulu"}, nil
+}
+
+func TestGetValidatorProofsReportsEachValidator(t *testing.T) {
+	state := eth2test.NewFuluState(5)
+	data, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatalf("error encoding state: %v", err)
+	}
+	stateRoot, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("error getting state root: %v", err)
+	}
+	service := &Service{
+		bc: &testBeaconClient{
+			header: beacon.BeaconBlockHeader{Slot: state.Slot, StateRoot: stateRoot},
+			state:  data,
+		},
+		cachePath: t.TempDir(),
+	}
+
+	// A validator that isn't in the state mustn't stop the others from being proved
+	validatorIndices := []uint64{0, 7, 4}
+	proofs, errs, err := service.GetValidatorProofs(validatorIndices)
+	if err != nil {
+		t.Fatalf("error getting proofs: %v", err)
+	}
+	if len(proofs) != len(validatorIndices) || len(errs) != len(validatorIndices) {
+		t.Fatalf("expected %d proofs and errors, got %d and %d", len(validatorIndices), len(proofs), len(errs))
+	}
+	for i, validatorIndex := range validatorIndices {
+		if validatorIndex == 7 {
+			if errs[i] == nil || !strings.Contains(errs[i].Error(), "validator 7") {
+				t.Fatalf("expected an error for validator 7, got %v", errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Fatalf("unexpected error for validator %d: %v", validatorIndex, errs[i])
+		}
+		if proofs[i].ValidatorIndex != validatorIndex || proofs[i].Slot != state.Slot {
+			t.Fatalf("expected a proof for validator %d at slot %d, got validator %d at slot %d", validatorIndex, state.Slot, proofs[i].ValidatorIndex, proofs[i].Slot)
+		}
+	}
+}
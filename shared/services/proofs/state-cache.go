@@ -0,0 +1,379 @@
+package proofs
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+const (
+	cacheFileVersion uint32      = 1
+	cacheFileMode    os.FileMode = 0644
+
+	// The size of the SSZ encoding of a validator record
+	validatorSize uint64 = 121
+
+	// The validator registry limit is 2^40, so the list's merkle tree is 40 levels deep before its length is mixed in
+	validatorRegistryDepth int = 40
+
+	// The size of the header at the start of a cache file
+	cacheHeaderSize int64 = 4 + 4 + 8 + 32 + 8 + 8
+)
+
+var cacheFileMagic = [4]byte{'R', 'P', 'V', 'P'}
+
+// The header at the start of a cache file
+type cacheHeader struct {
+	Magic           [4]byte
+	Version         uint32
+	Slot            uint64
+	StateRoot       common.Hash
+	ValidatorCount  uint64
+	FieldProofCount uint64
+}
+
+// The roots of empty subtrees of each depth, used as the siblings of nodes past the end of the validator list
+var zeroHashes = func() [][32]byte {
+	hashes := make([][32]byte, validatorRegistryDepth+1)
+	for depth := 1; depth <= validatorRegistryDepth; depth++ {
+		hashes[depth] = hashPair(hashes[depth-1], hashes[depth-1])
+	}
+	return hashes
+}()
+
+// A beacon state's validator registry, merkleized and cached on disk.
+// Cache files hold the header, the proof from the registry root to the block root, every validator record, and then
+// every layer of the registry's merkle tree from the validator roots up. Proofs are read straight from the file.
+type StateCache struct {
+	file         *os.File
+	header       cacheHeader
+	fieldProof   [][32]byte
+	layerOffsets []int64
+	layerLengths []uint64
+}
+
+// Merkleize the validators in a beacon state and save them to a cache file, along with the proof of the registry's root.
+// The registry root is checked against the provided state root before anything is written.
+func WriteStateCache(path string, state eth2.BeaconState, stateRoot common.Hash) error {
+	validators := state.GetValidators()
+	fieldProofBytes, err := state.ValidatorsFieldProof()
+	if err != nil {
+		return fmt.Errorf("error getting validator registry proof: %w", err)
+	}
+	fieldProof := make([][32]byte, len(fieldProofBytes))
+	for i, hash := range fieldProofBytes {
+		if len(hash) != 32 {
+			return fmt.Errorf("validator registry proof has a hash of length %d", len(hash))
+		}
+		copy(fieldProof[i][:], hash)
+	}
+
+	// Merkleize the registry
+	leaves := make([][32]byte, len(validators))
+	for i, validator := range validators {
+		leaves[i], err = validator.HashTreeRoot()
+		if err != nil {
+			return fmt.Errorf("error getting hash tree root for validator %d: %w", i, err)
+		}
+	}
+	layers := merkleizeLayers(leaves)
+
+	// Make sure the registry and its proof add up to the state root, so a bad cache never gets written
+	registryRoot := getRegistryRoot(layers[len(layers)-1], len(layers)-1, uint64(len(validators)))
+	headerProofDepth := bits.Len64(generic.BeaconBlockHeaderStateRootGeneralizedIndex) - 1
+	if len(fieldProof) <= headerProofDepth {
+		return fmt.Errorf("validator registry proof is too short")
+	}
+	computedStateRoot := foldProof(registryRoot, fieldProof[:len(fieldProof)-headerProofDepth], generic.BeaconStateValidatorsIndex)
+	if computedStateRoot != stateRoot {
+		return fmt.Errorf("validator registry proves state root %s, but the expected state root is %s", common.Hash(computedStateRoot).Hex(), stateRoot.Hex())
+	}
+
+	// Write to a temporary file and move it into place, so other processes never see a partial cache
+	file, err := os.CreateTemp(filepath.Dir(path), "*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating proof cache file: %w", err)
+	}
+	tmpPath := file.Name()
+	defer os.Remove(tmpPath)
+
+	writer := bufio.NewWriter(file)
+	header := cacheHeader{
+		Magic:           cacheFileMagic,
+		Version:         cacheFileVersion,
+		Slot:            state.GetSlot(),
+		StateRoot:       stateRoot,
+		ValidatorCount:  uint64(len(validators)),
+		FieldProofCount: uint64(len(fieldProof)),
+	}
+	err = binary.Write(writer, binary.LittleEndian, header)
+	if err == nil {
+		err = binary.Write(writer, binary.LittleEndian, fieldProof)
+	}
+	for i := 0; err == nil && i < len(validators); i++ {
+		var validatorBytes []byte
+		validatorBytes, err = validators[i].MarshalSSZ()
+		if err == nil {
+			_, err = writer.Write(validatorBytes)
+		}
+	}
+	for i := 0; err == nil && i < len(layers); i++ {
+		err = binary.Write(writer, binary.LittleEndian, layers[i])
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = file.Chmod(cacheFileMode)
+	}
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing proof cache file [%s]: %w", tmpPath, err)
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return fmt.Errorf("error moving proof cache file [%s] into place: %w", path, err)
+	}
+	return nil
+}
+
+// Open a cache file, checking that its registry root still adds up to its state root
+func OpenStateCache(path string) (*StateCache, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening proof cache file [%s]: %w", path, err)
+	}
+	cache, err := readStateCache(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error reading proof cache file [%s]: %w", path, err)
+	}
+	return cache, nil
+}
+
+func readStateCache(file *os.File) (*StateCache, error) {
+	cache := &StateCache{
+		file: file,
+	}
+	reader := io.NewSectionReader(file, 0, cacheHeaderSize)
+	err := binary.Read(reader, binary.LittleEndian, &cache.header)
+	if err != nil {
+		return nil, err
+	}
+	if cache.header.Magic != cacheFileMagic {
+		return nil, errors.New("not a proof cache file")
+	}
+	if cache.header.Version != cacheFileVersion {
+		return nil, fmt.Errorf("unsupported version %d", cache.header.Version)
+	}
+	if cache.header.FieldProofCount > 64 {
+		return nil, fmt.Errorf("validator registry proof has %d hashes", cache.header.FieldProofCount)
+	}
+
+	cache.fieldProof = make([][32]byte, cache.header.FieldProofCount)
+	reader = io.NewSectionReader(file, cacheHeaderSize, int64(cache.header.FieldProofCount)*32)
+	err = binary.Read(reader, binary.LittleEndian, cache.fieldProof)
+	if err != nil {
+		return nil, err
+	}
+
+	// Work out where each layer is from the validator count
+	offset := cache.getValidatorOffset(cache.header.ValidatorCount)
+	for length := cache.header.ValidatorCount; ; length = (length + 1) / 2 {
+		cache.layerOffsets = append(cache.layerOffsets, offset)
+		cache.layerLengths = append(cache.layerLengths, length)
+		offset += int64(length) * 32
+		if length <= 1 {
+			break
+		}
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() != offset {
+		return nil, fmt.Errorf("expected %d bytes but the file has %d", offset, info.Size())
+	}
+
+	// Check the top of the tree against the state root to catch files that don't belong together
+	topLayer := len(cache.layerLengths) - 1
+	var top [][32]byte
+	if cache.layerLengths[topLayer] > 0 {
+		root, err := cache.readNode(topLayer, 0)
+		if err != nil {
+			return nil, err
+		}
+		top = [][32]byte{root}
+	}
+	registryRoot := getRegistryRoot(top, topLayer, cache.header.ValidatorCount)
+	headerProofDepth := bits.Len64(generic.BeaconBlockHeaderStateRootGeneralizedIndex) - 1
+	if len(cache.fieldProof) <= headerProofDepth {
+		return nil, errors.New("validator registry proof is too short")
+	}
+	stateRoot := foldProof(registryRoot, cache.fieldProof[:len(cache.fieldProof)-headerProofDepth], generic.BeaconStateValidatorsIndex)
+	if stateRoot != cache.header.StateRoot {
+		return nil, errors.New("validator registry doesn't match the state root")
+	}
+	return cache, nil
+}
+
+// Get the slot of the cached state
+func (c *StateCache) Slot() uint64 {
+	return c.header.Slot
+}
+
+// Get the root of the cached state
+func (c *StateCache) StateRoot() common.Hash {
+	return c.header.StateRoot
+}
+
+// Get the number of validators in the cached state
+func (c *StateCache) ValidatorCount() uint64 {
+	return c.header.ValidatorCount
+}
+
+// Get the proof of a validator's record, against the root of the block at the cached state's slot
+func (c *StateCache) GetValidatorProof(validatorIndex uint64) (ValidatorProof, error) {
+	if validatorIndex >= c.header.ValidatorCount {
+		return ValidatorProof{}, fmt.Errorf("validator index %d is out of bounds, the state has %d validators", validatorIndex, c.header.ValidatorCount)
+	}
+
+	validatorBytes := make([]byte, validatorSize)
+	_, err := c.file.ReadAt(validatorBytes, c.getValidatorOffset(validatorIndex))
+	if err != nil {
+		return ValidatorProof{}, fmt.Errorf("error reading validator: %w", err)
+	}
+	validator := &generic.Validator{}
+	err = validator.UnmarshalSSZ(validatorBytes)
+	if err != nil {
+		return ValidatorProof{}, fmt.Errorf("error decoding validator: %w", err)
+	}
+
+	// Sanity check that the record matches its leaf in the tree
+	leaf, err := c.readNode(0, validatorIndex)
+	if err != nil {
+		return ValidatorProof{}, err
+	}
+	validatorRoot, err := validator.HashTreeRoot()
+	if err != nil {
+		return ValidatorProof{}, fmt.Errorf("error getting hash tree root for validator: %w", err)
+	}
+	if leaf != validatorRoot {
+		return ValidatorProof{}, errors.New("validator record doesn't match its leaf in the proof cache")
+	}
+
+	// Siblings within the registry, then its length, then the path from the registry to the block root
+	witnesses := make([][32]byte, 0, validatorRegistryDepth+1+len(c.fieldProof))
+	for depth := 0; depth < validatorRegistryDepth; depth++ {
+		siblingIndex := (validatorIndex >> depth) ^ 1
+		sibling := zeroHashes[depth]
+		if depth < len(c.layerLengths) && siblingIndex < c.layerLengths[depth] {
+			sibling, err = c.readNode(depth, siblingIndex)
+			if err != nil {
+				return ValidatorProof{}, err
+			}
+		}
+		witnesses = append(witnesses, sibling)
+	}
+	witnesses = append(witnesses, getLengthChunk(c.header.ValidatorCount))
+	witnesses = append(witnesses, c.fieldProof...)
+
+	return ValidatorProof{
+		Slot:           c.header.Slot,
+		ValidatorIndex: validatorIndex,
+		Validator:      validator,
+		Witnesses:      witnesses,
+	}, nil
+}
+
+// Close the cache file
+func (c *StateCache) Close() error {
+	return c.file.Close()
+}
+
+func (c *StateCache) getValidatorOffset(validatorIndex uint64) int64 {
+	return cacheHeaderSize + int64(c.header.FieldProofCount)*32 + int64(validatorIndex*validatorSize)
+}
+
+// Read a node of the registry's merkle tree
+func (c *StateCache) readNode(depth int, index uint64) ([32]byte, error) {
+	var node [32]byte
+	_, err := c.file.ReadAt(node[:], c.layerOffsets[depth]+int64(index)*32)
+	if err != nil {
+		return node, fmt.Errorf("error reading merkle tree node: %w", err)
+	}
+	return node, nil
+}
+
+// Build each layer of a merkle tree from its leaves up to the first layer with a single node.
+// Nodes past the end of a layer are the roots of empty subtrees.
+func merkleizeLayers(leaves [][32]byte) [][][32]byte {
+	layers := [][][32]byte{leaves}
+	for depth := 0; len(layers[depth]) > 1; depth++ {
+		layer := layers[depth]
+		parents := make([][32]byte, (len(layer)+1)/2)
+		for i := range parents {
+			right := zeroHashes[depth]
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			parents[i] = hashPair(layer[2*i], right)
+		}
+		layers = append(layers, parents)
+	}
+	return layers
+}
+
+// Get the root of the validator registry from the top layer of its tree, padding it out to the registry's full depth
+// and mixing in its length
+func getRegistryRoot(topLayer [][32]byte, topDepth int, validatorCount uint64) [32]byte {
+	if len(topLayer) == 0 {
+		return hashPair(zeroHashes[validatorRegistryDepth], getLengthChunk(validatorCount))
+	}
+	root := topLayer[0]
+	for depth := topDepth; depth < validatorRegistryDepth; depth++ {
+		root = hashPair(root, zeroHashes[depth])
+	}
+	return hashPair(root, getLengthChunk(validatorCount))
+}
+
+// Hash a leaf up through its proof, using the bits of its index to tell which side each sibling is on
+func foldProof(leaf [32]byte, proof [][32]byte, index uint64) [32]byte {
+	node := leaf
+	for depth, sibling := range proof {
+		if (index>>depth)&1 == 1 {
+			node = hashPair(sibling, node)
+		} else {
+			node = hashPair(node, sibling)
+		}
+	}
+	return node
+}
+
+// Get the chunk a list's length is mixed into its root with
+func getLengthChunk(length uint64) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:8], length)
+	return chunk
+}
+
+func hashPair(left [32]byte, right [32]byte) [32]byte {
+	var pair [64]byte
+	copy(pair[:32], left[:])
+	copy(pair[32:], right[:])
+	return sha256.Sum256(pair[:])
+}
@@ -0,0 +1,114 @@
+package proofs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/fulu"
+	eth2test "github.com/rocket-pool/smartnode/shared/types/eth2/test"
+)
+
+// Wrap a test state in the prover the Beacon API states are decoded into
+func wrapTestState(t *testing.T, state *fulu.BeaconState) eth2.BeaconState {
+	prover, err := eth2.WrapBeaconState(state)
+	if err != nil {
+		t.Fatalf("error wrapping state: %v", err)
+	}
+	return prover
+}
+
+func TestValidatorProofsMatchState(t *testing.T) {
+	for _, validatorCount := range []uint64{1, 2, 5, 1000} {
+		t.Run(fmt.Sprintf("%d validators", validatorCount), func(t *testing.T) {
+			state := eth2test.NewFuluState(validatorCount)
+			stateRoot, err := state.HashTreeRoot()
+			if err != nil {
+				t.Fatalf("error getting state root: %v", err)
+			}
+
+			path := filepath.Join(t.TempDir(), "state"+cacheFileExtension)
+			prover := wrapTestState(t, state)
+			err = WriteStateCache(path, prover, stateRoot)
+			if err != nil {
+				t.Fatalf("error writing state cache: %v", err)
+			}
+			cache, err := OpenStateCache(path)
+			if err != nil {
+				t.Fatalf("error opening state cache: %v", err)
+			}
+			defer cache.Close()
+
+			if cache.ValidatorCount() != validatorCount {
+				t.Fatalf("expected %d validators, got %d", validatorCount, cache.ValidatorCount())
+			}
+			for _, validatorIndex := range []uint64{0, validatorCount / 2, validatorCount - 1} {
+				proof, err := cache.GetValidatorProof(validatorIndex)
+				if err != nil {
+					t.Fatalf("error getting proof for validator %d: %v", validatorIndex, err)
+				}
+				expectedProof, err := prover.ValidatorProof(validatorIndex)
+				if err != nil {
+					t.Fatalf("error getting proof from state for validator %d: %v", validatorIndex, err)
+				}
+				if len(proof.Witnesses) != len(expectedProof) {
+					t.Fatalf("expected %d witnesses, got %d", len(expectedProof), len(proof.Witnesses))
+				}
+				for i := range expectedProof {
+					if !bytes.Equal(proof.Witnesses[i][:], expectedProof[i]) {
+						t.Fatalf("witness %d for validator %d: expected %x, got %x", i, validatorIndex, expectedProof[i], proof.Witnesses[i])
+					}
+				}
+				if !bytes.Equal(proof.Validator.WithdrawalCredentials, state.Validators[validatorIndex].WithdrawalCredentials) {
+					t.Fatalf("validator %d has the wrong withdrawal credentials", validatorIndex)
+				}
+				if proof.Slot != state.Slot {
+					t.Fatalf("expected slot %d, got %d", state.Slot, proof.Slot)
+				}
+			}
+
+			_, err = cache.GetValidatorProof(validatorCount)
+			if err == nil {
+				t.Fatalf("expected an error for a validator index out of bounds")
+			}
+		})
+	}
+}
+
+func TestStateCacheRejectsMismatches(t *testing.T) {
+	state := eth2test.NewFuluState(10)
+	prover := wrapTestState(t, state)
+	path := filepath.Join(t.TempDir(), "state"+cacheFileExtension)
+	err := WriteStateCache(path, prover, common.Hash{0x01})
+	if err == nil {
+		t.Fatalf("expected an error for the wrong state root")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected no cache file to be written for the wrong state root")
+	}
+
+	stateRoot, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("error getting state root: %v", err)
+	}
+	err = WriteStateCache(path, prover, stateRoot)
+	if err != nil {
+		t.Fatalf("error writing state cache: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("error checking state cache: %v", err)
+	}
+	err = os.Truncate(path, info.Size()-32)
+	if err != nil {
+		t.Fatalf("error truncating state cache: %v", err)
+	}
+	_, err = OpenStateCache(path)
+	if err == nil {
+		t.Fatalf("expected an error for a truncated cache file")
+	}
+}
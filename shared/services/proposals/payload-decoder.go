@@ -0,0 +1,577 @@
+package proposals
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao"
+	"github.com/rocket-pool/smartnode/bindings/dao/security"
+	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	psettings "github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	tnsettings "github.com/rocket-pool/smartnode/bindings/settings/trustednode"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	strutils "github.com/rocket-pool/smartnode/bindings/utils/strings"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const (
+	// The contracts that hold the functions each DAO's proposals execute
+	ProtocolDaoProposalsContractName    string = "rocketDAOProtocolProposals"
+	TrustedNodeDaoProposalsContractName string = "rocketDAONodeTrustedProposals"
+	SecurityDaoProposalsContractName    string = "rocketDAOSecurityProposals"
+
+	// The label used for the rewards percentages, which are set together rather than by setting path
+	rewardsPercentageLabelFormat string = "rewards percentage (%s)"
+)
+
+// The settings contracts behind the namespaces security council proposals use
+var securityNamespaceContracts = map[string]string{
+	"auction":  psettings.AuctionSettingsContractName,
+	"deposit":  psettings.DepositSettingsContractName,
+	"minipool": psettings.MinipoolSettingsContractName,
+	"network":  psettings.NetworkSettingsContractName,
+	"node":     psettings.NodeSettingsContractName,
+}
+
+// The units of the oDAO's numeric settings, by settings contract; anything missing is shown as a plain number.
+// The pDAO's units are in ProtocolDaoSettings.
+var trustedNodeSettingUnits = map[string]map[string]api.ProposalSettingUnit{
+	tnsettings.MembersSettingsContractName: {
+		tnsettings.QuorumSettingPath:                 api.ProposalSettingUnit_Percent,
+		tnsettings.RPLBondSettingPath:                api.ProposalSettingUnit_Rpl,
+		tnsettings.MinipoolUnbondedMaxSettingPath:    api.ProposalSettingUnit_Count,
+		tnsettings.MinipoolUnbondedMinFeeSettingPath: api.ProposalSettingUnit_Percent,
+		tnsettings.ChallengeCooldownSettingPath:      api.ProposalSettingUnit_Duration,
+		tnsettings.ChallengeWindowSettingPath:        api.ProposalSettingUnit_Duration,
+		tnsettings.ChallengeCostSettingPath:          api.ProposalSettingUnit_Eth,
+	},
+	tnsettings.MinipoolSettingsContractName: {
+		tnsettings.DissolvePeriodPath:            api.ProposalSettingUnit_Duration,
+		tnsettings.ScrubPeriodPath:               api.ProposalSettingUnit_Duration,
+		tnsettings.PromotionScrubPeriodPath:      api.ProposalSettingUnit_Duration,
+		tnsettings.BondReductionWindowStartPath:  api.ProposalSettingUnit_Duration,
+		tnsettings.BondReductionWindowLengthPath: api.ProposalSettingUnit_Duration,
+	},
+	tnsettings.ProposalsSettingsContractName: {
+		tnsettings.CooldownTimeSettingPath:  api.ProposalSettingUnit_Duration,
+		tnsettings.VoteTimeSettingPath:      api.ProposalSettingUnit_Duration,
+		tnsettings.VoteDelayTimeSettingPath: api.ProposalSettingUnit_Duration,
+		tnsettings.ExecuteTimeSettingPath:   api.ProposalSettingUnit_Duration,
+		tnsettings.ActionTimeSettingPath:    api.ProposalSettingUnit_Duration,
+	},
+}
+
+// Decode a proposal payload into the changes it would make, comparing them against the chain state at opts.
+// daoName is the contract that holds the payload's function; payloads with a function the decoder doesn't
+// know about are returned with just their raw arguments.
+func DecodeProposalPayload(rp *rocketpool.RocketPool, daoName string, payload []byte, opts *bind.CallOpts) (*api.DecodedProposalPayload, error) {
+
+	// Get the payload's function and arguments
+	daoContractAbi, err := rp.GetABI(daoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting '%s' DAO contract ABI: %w", daoName, err)
+	}
+	if len(payload) < 4 {
+		return nil, fmt.Errorf("proposal payload is only %d bytes long", len(payload))
+	}
+	method, err := daoContractAbi.MethodById(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposal payload method: %w", err)
+	}
+	args, err := method.Inputs.UnpackValues(payload[4:])
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposal payload arguments: %w", err)
+	}
+
+	decoded := &api.DecodedProposalPayload{
+		DAO:       daoName,
+		Function:  method.RawName,
+		Arguments: make([]string, len(args)),
+	}
+	for i, arg := range args {
+		decoded.Arguments[i] = formatArgument(method.Inputs[i].Type, arg)
+	}
+
+	switch daoName {
+	case ProtocolDaoProposalsContractName:
+		err = decodeProtocolDaoPayload(rp, decoded, args, opts)
+	case TrustedNodeDaoProposalsContractName:
+		err = decodeTrustedNodeDaoPayload(rp, decoded, args, opts)
+	case SecurityDaoProposalsContractName:
+		err = decodeSecurityDaoPayload(rp, decoded, args, opts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s payload: %w", method.RawName, err)
+	}
+	return decoded, nil
+
+}
+
+// Get a human-readable line for each change a decoded payload makes
+func FormatProposalPayload(decoded *api.DecodedProposalPayload) []string {
+	if decoded == nil {
+		return nil
+	}
+	lines := []string{}
+	for _, change := range decoded.SettingChanges {
+		line := fmt.Sprintf("Set %s (%s) from %s to %s", change.SettingPath, change.ContractName, valueOrUnknown(change.CurrentValue), change.NewValue)
+		if change.RawCurrentValue == change.RawNewValue {
+			line += " (unchanged)"
+		}
+		lines = append(lines, line)
+	}
+
+	for _, spend := range decoded.TreasurySpends {
+		switch spend.Type {
+		case api.ProposalTreasurySpendType_OneTime:
+			lines = append(lines, fmt.Sprintf("Pay %.6f RPL to %s once for invoice '%s'", eth.WeiToEth(spend.Amount), spend.Recipient.Hex(), spend.Name))
+		case api.ProposalTreasurySpendType_NewRecurring:
+			total := big.NewInt(0).Mul(spend.Amount, big.NewInt(0).SetUint64(spend.NumberOfPeriods))
+			lines = append(lines, fmt.Sprintf("Create recurring payment '%s': %.6f RPL to %s every %s for %d periods starting %s (%.6f RPL in total)",
+				spend.Name, eth.WeiToEth(spend.Amount), spend.Recipient.Hex(), spend.PeriodLength, spend.NumberOfPeriods, spend.StartTime.Format(time.RFC822), eth.WeiToEth(total)))
+		case api.ProposalTreasurySpendType_UpdateRecurring:
+			line := fmt.Sprintf("Update recurring payment '%s' to %.6f RPL to %s every %s for %d periods", spend.Name, eth.WeiToEth(spend.Amount), spend.Recipient.Hex(), spend.PeriodLength, spend.NumberOfPeriods)
+			if spend.Current != nil {
+				line += fmt.Sprintf(" (currently %.6f RPL to %s every %s for %d periods, %d paid)",
+					eth.WeiToEth(spend.Current.AmountPerPeriod), spend.Current.Recipient.Hex(), spend.Current.PeriodLength, spend.Current.NumberOfPeriods, spend.Current.PeriodsPaid)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	daoLabel := "the security council"
+	if decoded.DAO == TrustedNodeDaoProposalsContractName {
+		daoLabel = "the Oracle DAO"
+	}
+	for _, change := range decoded.MemberChanges {
+		member := formatMember(change.ID, change.Address)
+		if change.Action != api.ProposalMemberAction_Invite && !change.IsMember {
+			member += " (not currently a member)"
+		}
+		switch change.Action {
+		case api.ProposalMemberAction_Invite:
+			lines = append(lines, fmt.Sprintf("Invite %s to %s", member, daoLabel))
+		case api.ProposalMemberAction_Leave:
+			lines = append(lines, fmt.Sprintf("Allow %s to leave %s", member, daoLabel))
+		case api.ProposalMemberAction_Kick:
+			line := fmt.Sprintf("Kick %s from %s", member, daoLabel)
+			if change.RplFine != nil && change.RplFine.Sign() > 0 {
+				line += fmt.Sprintf(" with a fine of %.6f RPL", eth.WeiToEth(change.RplFine))
+			}
+			lines = append(lines, line)
+		case api.ProposalMemberAction_Replace:
+			lines = append(lines, fmt.Sprintf("Replace %s in %s with %s (%s)", member, daoLabel, change.NewID, change.NewAddress.Hex()))
+		}
+	}
+
+	for _, upgrade := range decoded.Upgrades {
+		lines = append(lines, fmt.Sprintf("Upgrade (%s) %s from %s to %s", upgrade.Type, upgrade.ContractName, upgrade.CurrentAddress.Hex(), upgrade.ContractAddress.Hex()))
+	}
+
+	// Fall back to the raw function call for anything that isn't understood
+	if len(lines) == 0 {
+		lines = append(lines, strutils.Sanitize(fmt.Sprintf("%s(%s)", decoded.Function, strings.Join(decoded.Arguments, ","))))
+	}
+	return lines
+}
+
+// Decode the changes made by a pDAO proposal
+func decodeProtocolDaoPayload(rp *rocketpool.RocketPool, decoded *api.DecodedProposalPayload, args []any, opts *bind.CallOpts) error {
+	switch decoded.Function {
+	case "proposalSettingMulti":
+		contractNames := args[0].([]string)
+		settingPaths := args[1].([]string)
+		settingTypes := args[2].([]uint8)
+		values := args[3].([][]byte)
+		for i := range contractNames {
+			value, err := decodeMultiValue(types.ProposalSettingType(settingTypes[i]), values[i])
+			if err != nil {
+				return fmt.Errorf("error decoding value of setting %s: %w", settingPaths[i], err)
+			}
+			change, err := getSettingChange(rp, contractNames[i], settingPaths[i], value, opts)
+			if err != nil {
+				return err
+			}
+			decoded.SettingChanges = append(decoded.SettingChanges, change)
+		}
+
+	case "proposalSettingBool", "proposalSettingUint", "proposalSettingAddress", "proposalSettingAddressList":
+		change, err := getSettingChange(rp, args[0].(string), args[1].(string), args[2], opts)
+		if err != nil {
+			return err
+		}
+		decoded.SettingChanges = append(decoded.SettingChanges, change)
+
+	case "proposalSettingRewardsClaimers":
+		current, err := psettings.GetRewardsPercentages(rp, opts)
+		if err != nil {
+			return err
+		}
+		currentValues := []*big.Int{current.OdaoPercentage, current.PdaoPercentage, current.NodePercentage}
+		for i, label := range []string{"Oracle DAO", "Protocol DAO", "node operators"} {
+			decoded.SettingChanges = append(decoded.SettingChanges, NewSettingChange(
+				psettings.RewardsSettingsContractName, fmt.Sprintf(rewardsPercentageLabelFormat, label), api.ProposalSettingUnit_Percent, currentValues[i], args[i].(*big.Int),
+			))
+		}
+
+	case "proposalTreasuryOneTimeSpend":
+		decoded.TreasurySpends = append(decoded.TreasurySpends, api.ProposalTreasurySpend{
+			Type:      api.ProposalTreasurySpendType_OneTime,
+			Name:      args[0].(string),
+			Recipient: args[1].(common.Address),
+			Amount:    args[2].(*big.Int),
+		})
+
+	case "proposalTreasuryNewContract":
+		decoded.TreasurySpends = append(decoded.TreasurySpends, api.ProposalTreasurySpend{
+			Type:            api.ProposalTreasurySpendType_NewRecurring,
+			Name:            args[0].(string),
+			Recipient:       args[1].(common.Address),
+			Amount:          args[2].(*big.Int),
+			PeriodLength:    time.Duration(args[3].(*big.Int).Uint64()) * time.Second,
+			StartTime:       time.Unix(args[4].(*big.Int).Int64(), 0),
+			NumberOfPeriods: args[5].(*big.Int).Uint64(),
+		})
+
+	case "proposalTreasuryUpdateContract":
+		spend := api.ProposalTreasurySpend{
+			Type:            api.ProposalTreasurySpendType_UpdateRecurring,
+			Name:            args[0].(string),
+			Recipient:       args[1].(common.Address),
+			Amount:          args[2].(*big.Int),
+			PeriodLength:    time.Duration(args[3].(*big.Int).Uint64()) * time.Second,
+			NumberOfPeriods: args[4].(*big.Int).Uint64(),
+		}
+		exists, err := dao.GetContractExists(rp, spend.Name, opts)
+		if err != nil {
+			return err
+		}
+		if exists {
+			current, err := dao.GetContract(rp, spend.Name, opts)
+			if err != nil {
+				return err
+			}
+			spend.Current = &api.ProposalRecurringSpend{
+				Recipient:       current.Recipient,
+				AmountPerPeriod: current.AmountPerPeriod,
+				PeriodLength:    time.Duration(current.PeriodLength.Uint64()) * time.Second,
+				LastPaymentTime: time.Unix(current.LastPaymentTime.Int64(), 0),
+				NumberOfPeriods: current.NumPeriods.Uint64(),
+				PeriodsPaid:     current.PeriodsPaid.Uint64(),
+			}
+		}
+		decoded.TreasurySpends = append(decoded.TreasurySpends, spend)
+
+	case "proposalSecurityInvite":
+		change, err := getSecurityMemberChange(rp, api.ProposalMemberAction_Invite, args[1].(common.Address), opts)
+		if err != nil {
+			return err
+		}
+		change.ID = args[0].(string)
+		decoded.MemberChanges = append(decoded.MemberChanges, change)
+
+	case "proposalSecurityKick":
+		change, err := getSecurityMemberChange(rp, api.ProposalMemberAction_Kick, args[0].(common.Address), opts)
+		if err != nil {
+			return err
+		}
+		decoded.MemberChanges = append(decoded.MemberChanges, change)
+
+	case "proposalSecurityKickMulti":
+		for _, address := range args[0].([]common.Address) {
+			change, err := getSecurityMemberChange(rp, api.ProposalMemberAction_Kick, address, opts)
+			if err != nil {
+				return err
+			}
+			decoded.MemberChanges = append(decoded.MemberChanges, change)
+		}
+
+	case "proposalSecurityReplace":
+		change, err := getSecurityMemberChange(rp, api.ProposalMemberAction_Replace, args[0].(common.Address), opts)
+		if err != nil {
+			return err
+		}
+		change.NewID = args[1].(string)
+		change.NewAddress = args[2].(common.Address)
+		decoded.MemberChanges = append(decoded.MemberChanges, change)
+	}
+	return nil
+}
+
+// Decode the changes made by an oDAO proposal
+func decodeTrustedNodeDaoPayload(rp *rocketpool.RocketPool, decoded *api.DecodedProposalPayload, args []any, opts *bind.CallOpts) error {
+	switch decoded.Function {
+	case "proposalSettingBool", "proposalSettingUint":
+		change, err := getSettingChange(rp, args[0].(string), args[1].(string), args[2], opts)
+		if err != nil {
+			return err
+		}
+		decoded.SettingChanges = append(decoded.SettingChanges, change)
+
+	case "proposalInvite":
+		change, err := getTrustedNodeMemberChange(rp, api.ProposalMemberAction_Invite, args[2].(common.Address), opts)
+		if err != nil {
+			return err
+		}
+		change.ID = args[0].(string)
+		change.Url = args[1].(string)
+		decoded.MemberChanges = append(decoded.MemberChanges, change)
+
+	case "proposalLeave":
+		change, err := getTrustedNodeMemberChange(rp, api.ProposalMemberAction_Leave, args[0].(common.Address), opts)
+		if err != nil {
+			return err
+		}
+		decoded.MemberChanges = append(decoded.MemberChanges, change)
+
+	case "proposalKick":
+		change, err := getTrustedNodeMemberChange(rp, api.ProposalMemberAction_Kick, args[0].(common.Address), opts)
+		if err != nil {
+			return err
+		}
+		change.RplFine = args[1].(*big.Int)
+		decoded.MemberChanges = append(decoded.MemberChanges, change)
+
+	case "proposalReplace":
+		change, err := getTrustedNodeMemberChange(rp, api.ProposalMemberAction_Replace, args[0].(common.Address), opts)
+		if err != nil {
+			return err
+		}
+		change.NewID = args[1].(string)
+		change.NewUrl = args[2].(string)
+		change.NewAddress = args[3].(common.Address)
+		decoded.MemberChanges = append(decoded.MemberChanges, change)
+
+	case "proposalUpgrade":
+		upgrade := api.ProposalUpgrade{
+			Type:            args[0].(string),
+			ContractName:    args[1].(string),
+			ContractAddress: args[3].(common.Address),
+		}
+		currentAddress, err := rp.GetAddress(upgrade.ContractName, opts)
+		if err != nil {
+			return err
+		}
+		upgrade.CurrentAddress = *currentAddress
+		decoded.Upgrades = append(decoded.Upgrades, upgrade)
+	}
+	return nil
+}
+
+// Decode the changes made by a security council proposal
+func decodeSecurityDaoPayload(rp *rocketpool.RocketPool, decoded *api.DecodedProposalPayload, args []any, opts *bind.CallOpts) error {
+	switch decoded.Function {
+	case "proposalSettingBool", "proposalSettingUint":
+		// Security council proposals refer to the pDAO settings contracts by namespace
+		namespace := args[0].(string)
+		contractName, exists := securityNamespaceContracts[namespace]
+		if !exists {
+			return fmt.Errorf("unknown settings namespace '%s'", namespace)
+		}
+		change, err := getSettingChange(rp, contractName, args[1].(string), args[2], opts)
+		if err != nil {
+			return err
+		}
+		decoded.SettingChanges = append(decoded.SettingChanges, change)
+	}
+	return nil
+}
+
+// Get the change a proposal makes to a setting
+func getSettingChange(rp *rocketpool.RocketPool, contractName string, settingPath string, newValue any, opts *bind.CallOpts) (api.ProposalSettingChange, error) {
+	var currentValue any
+	var err error
+	unit := getSettingUnit(contractName, settingPath)
+	switch newValue.(type) {
+	case *big.Int:
+		currentValue, err = psettings.GetSettingUint(rp, contractName, settingPath, opts)
+		if unit == api.ProposalSettingUnit_None {
+			unit = api.ProposalSettingUnit_Count
+		}
+	case bool:
+		currentValue, err = psettings.GetSettingBool(rp, contractName, settingPath, opts)
+		unit = api.ProposalSettingUnit_Bool
+	case common.Address:
+		currentValue, err = psettings.GetSettingAddress(rp, contractName, settingPath, opts)
+		unit = api.ProposalSettingUnit_Address
+	case []common.Address:
+		// The only address list setting has its own getter
+		if contractName == psettings.NetworkSettingsContractName && settingPath == psettings.NetworkAllowListedControllersPath {
+			currentValue, err = psettings.GetAllowListedControllers(rp, opts)
+		}
+		unit = api.ProposalSettingUnit_Address
+	default:
+		return api.ProposalSettingChange{}, fmt.Errorf("unsupported value type %T for setting %s", newValue, settingPath)
+	}
+	if err != nil {
+		return api.ProposalSettingChange{}, err
+	}
+	return NewSettingChange(contractName, settingPath, unit, currentValue, newValue), nil
+}
+
+// Get the units of a numeric setting, or none if they aren't known
+func getSettingUnit(contractName string, settingPath string) api.ProposalSettingUnit {
+	if setting, exists := GetProtocolDaoSetting(contractName, settingPath); exists {
+		return setting.Unit
+	}
+	return trustedNodeSettingUnits[contractName][settingPath]
+}
+
+// Create a setting change, formatting both values in the setting's units
+func NewSettingChange(contractName string, settingPath string, unit api.ProposalSettingUnit, currentValue any, newValue any) api.ProposalSettingChange {
+	change := api.ProposalSettingChange{
+		ContractName: contractName,
+		SettingPath:  settingPath,
+		Unit:         unit,
+		NewValue:     formatSettingValue(unit, newValue),
+		RawNewValue:  formatRawValue(newValue),
+	}
+	if currentValue != nil {
+		change.CurrentValue = formatSettingValue(unit, currentValue)
+		change.RawCurrentValue = formatRawValue(currentValue)
+	}
+	return change
+}
+
+// Get a security council membership change, including the member's current details
+func getSecurityMemberChange(rp *rocketpool.RocketPool, action api.ProposalMemberAction, address common.Address, opts *bind.CallOpts) (api.ProposalMemberChange, error) {
+	change := api.ProposalMemberChange{
+		Action:  action,
+		Address: address,
+	}
+	isMember, err := security.GetMemberExists(rp, address, opts)
+	if err != nil {
+		return api.ProposalMemberChange{}, err
+	}
+	change.IsMember = isMember
+	if isMember {
+		change.ID, err = security.GetMemberID(rp, address, opts)
+		if err != nil {
+			return api.ProposalMemberChange{}, err
+		}
+	}
+	return change, nil
+}
+
+// Get an oDAO membership change, including the member's current details
+func getTrustedNodeMemberChange(rp *rocketpool.RocketPool, action api.ProposalMemberAction, address common.Address, opts *bind.CallOpts) (api.ProposalMemberChange, error) {
+	change := api.ProposalMemberChange{
+		Action:  action,
+		Address: address,
+	}
+	isMember, err := trustednode.GetMemberExists(rp, address, opts)
+	if err != nil {
+		return api.ProposalMemberChange{}, err
+	}
+	change.IsMember = isMember
+	if isMember {
+		change.ID, err = trustednode.GetMemberID(rp, address, opts)
+		if err != nil {
+			return api.ProposalMemberChange{}, err
+		}
+		change.Url, err = trustednode.GetMemberUrl(rp, address, opts)
+		if err != nil {
+			return api.ProposalMemberChange{}, err
+		}
+	}
+	return change, nil
+}
+
+// Decode one of the ABI-encoded values of a multi-setting proposal
+func decodeMultiValue(settingType types.ProposalSettingType, value []byte) (any, error) {
+	var typeName string
+	switch settingType {
+	case types.ProposalSettingType_Uint256:
+		typeName = "uint256"
+	case types.ProposalSettingType_Bool:
+		typeName = "bool"
+	case types.ProposalSettingType_Address:
+		typeName = "address"
+	default:
+		return nil, fmt.Errorf("unknown setting type %d", settingType)
+	}
+	abiType, err := abi.NewType(typeName, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := abi.Arguments{{Type: abiType}}.UnpackValues(value)
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// Format a setting value in its units
+func formatSettingValue(unit api.ProposalSettingUnit, value any) string {
+	number, isNumber := value.(*big.Int)
+	if !isNumber {
+		return formatRawValue(value)
+	}
+	switch unit {
+	case api.ProposalSettingUnit_Eth:
+		return fmt.Sprintf("%.6f ETH", eth.WeiToEth(number))
+	case api.ProposalSettingUnit_Rpl:
+		return fmt.Sprintf("%.6f RPL", eth.WeiToEth(number))
+	case api.ProposalSettingUnit_Percent:
+		return fmt.Sprintf("%.4f%%", eth.WeiToEth(number)*100)
+	case api.ProposalSettingUnit_Duration:
+		return (time.Duration(number.Uint64()) * time.Second).String()
+	case api.ProposalSettingUnit_Blocks:
+		return fmt.Sprintf("%s blocks", number.String())
+	}
+	return number.String()
+}
+
+// Format a setting value exactly as it's stored
+func formatRawValue(value any) string {
+	switch value := value.(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	case []common.Address:
+		addresses := make([]string, len(value))
+		for i, address := range value {
+			addresses[i] = address.Hex()
+		}
+		return strings.Join(addresses, ", ")
+	}
+	return fmt.Sprint(value)
+}
+
+// Format a payload argument that doesn't have a decoder
+func formatArgument(argType abi.Type, arg any) string {
+	switch argType.T {
+	case abi.AddressTy:
+		return arg.(common.Address).Hex()
+	case abi.HashTy:
+		return arg.(common.Hash).Hex()
+	case abi.BytesTy:
+		return hex.EncodeToString(arg.([]byte))
+	}
+	return fmt.Sprintf("%v", arg)
+}
+
+// Describe a DAO member by ID, if they have one
+func formatMember(id string, address common.Address) string {
+	if id == "" {
+		return address.Hex()
+	}
+	return fmt.Sprintf("%s (%s)", id, address.Hex())
+}
+
+// Get a value, or a placeholder if it couldn't be read
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "<unknown>"
+	}
+	return value
+}
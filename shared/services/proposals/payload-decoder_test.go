@@ -0,0 +1,139 @@
+package proposals
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	psettings "github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestDecodeMultiValue(t *testing.T) {
+	address := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tests := []struct {
+		name        string
+		settingType types.ProposalSettingType
+		value       []byte
+		expected    any
+	}{
+		{"uint256", types.ProposalSettingType_Uint256, math.U256Bytes(big.NewInt(1e18)), big.NewInt(1e18)},
+		{"bool", types.ProposalSettingType_Bool, math.U256Bytes(big.NewInt(1)), true},
+		{"address", types.ProposalSettingType_Address, common.LeftPadBytes(address.Bytes(), 32), address},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := decodeMultiValue(test.settingType, test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if formatRawValue(value) != formatRawValue(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, value)
+			}
+		})
+	}
+
+	// Unknown types and truncated values can't be decoded
+	if _, err := decodeMultiValue(types.ProposalSettingType(3), math.U256Bytes(big.NewInt(1))); err == nil {
+		t.Fatal("expected an error for an unknown setting type")
+	}
+	if _, err := decodeMultiValue(types.ProposalSettingType_Uint256, []byte{0x01}); err == nil {
+		t.Fatal("expected an error for a truncated value")
+	}
+}
+
+func TestFormatSettingValue(t *testing.T) {
+	address := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tests := []struct {
+		unit     api.ProposalSettingUnit
+		value    any
+		expected string
+	}{
+		{api.ProposalSettingUnit_Eth, eth.EthToWei(1.5), "1.500000 ETH"},
+		{api.ProposalSettingUnit_Rpl, eth.EthToWei(2400), "2400.000000 RPL"},
+		{api.ProposalSettingUnit_Percent, eth.EthToWei(0.05), "5.0000%"},
+		{api.ProposalSettingUnit_Duration, big.NewInt(86400), "24h0m0s"},
+		{api.ProposalSettingUnit_Blocks, big.NewInt(7200), "7200 blocks"},
+		{api.ProposalSettingUnit_Count, big.NewInt(42), "42"},
+		{api.ProposalSettingUnit_Bool, true, "true"},
+		{api.ProposalSettingUnit_Address, address, address.Hex()},
+		{api.ProposalSettingUnit_Address, []common.Address{address, address}, address.Hex() + ", " + address.Hex()},
+	}
+	for _, test := range tests {
+		if formatted := formatSettingValue(test.unit, test.value); formatted != test.expected {
+			t.Errorf("expected %v in %s to be formatted as %q, got %q", test.value, test.unit, test.expected, formatted)
+		}
+	}
+}
+
+func TestFormatProposalPayload(t *testing.T) {
+	if lines := FormatProposalPayload(nil); lines != nil {
+		t.Fatalf("expected no lines for a missing payload, got %v", lines)
+	}
+
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	decoded := &api.DecodedProposalPayload{
+		DAO:      ProtocolDaoProposalsContractName,
+		Function: "proposalSettingMulti",
+		SettingChanges: []api.ProposalSettingChange{
+			NewSettingChange(psettings.NetworkSettingsContractName, "network.reth.collateral.target", api.ProposalSettingUnit_Percent, eth.EthToWei(0.1), eth.EthToWei(0.2)),
+			NewSettingChange(psettings.NetworkSettingsContractName, "network.submit.balances.enabled", api.ProposalSettingUnit_Bool, true, true),
+			NewSettingChange(psettings.NetworkSettingsContractName, "network.node.fee.demand.range", api.ProposalSettingUnit_Eth, nil, eth.EthToWei(1)),
+		},
+		TreasurySpends: []api.ProposalTreasurySpend{
+			{
+				Type:      api.ProposalTreasurySpendType_OneTime,
+				Name:      "invoice-1",
+				Recipient: recipient,
+				Amount:    eth.EthToWei(100),
+			},
+			{
+				Type:            api.ProposalTreasurySpendType_NewRecurring,
+				Name:            "grant",
+				Recipient:       recipient,
+				Amount:          eth.EthToWei(10),
+				PeriodLength:    24 * time.Hour,
+				StartTime:       time.Unix(0, 0),
+				NumberOfPeriods: 3,
+			},
+		},
+		MemberChanges: []api.ProposalMemberChange{
+			{Action: api.ProposalMemberAction_Kick, ID: "member", Address: recipient, IsMember: true, RplFine: eth.EthToWei(50)},
+		},
+	}
+	lines := FormatProposalPayload(decoded)
+	expected := []string{
+		"Set network.reth.collateral.target (rocketDAOProtocolSettingsNetwork) from 10.0000% to 20.0000%",
+		"Set network.submit.balances.enabled (rocketDAOProtocolSettingsNetwork) from true to true (unchanged)",
+		"Set network.node.fee.demand.range (rocketDAOProtocolSettingsNetwork) from <unknown> to 1.000000 ETH",
+		"Pay 100.000000 RPL to " + recipient.Hex() + " once for invoice 'invoice-1'",
+		"Create recurring payment 'grant': 10.000000 RPL to " + recipient.Hex() + " every 24h0m0s for 3 periods",
+		"Kick member (" + recipient.Hex() + ") from the security council with a fine of 50.000000 RPL",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d: %v", len(expected), len(lines), lines)
+	}
+	for i, line := range lines {
+		if !strings.HasPrefix(line, expected[i]) {
+			t.Errorf("expected line %d to start with %q, got %q", i, expected[i], line)
+		}
+	}
+	if !strings.HasSuffix(lines[4], "(30.000000 RPL in total)") {
+		t.Errorf("expected the recurring payment total to be shown, got %q", lines[4])
+	}
+
+	// Payloads without any understood changes fall back to the raw function call
+	lines = FormatProposalPayload(&api.DecodedProposalPayload{
+		DAO:       ProtocolDaoProposalsContractName,
+		Function:  "proposalUnknown",
+		Arguments: []string{"1", recipient.Hex()},
+	})
+	if len(lines) != 1 || lines[0] != "proposalUnknown(1,"+recipient.Hex()+")" {
+		t.Fatalf("expected the raw function call, got %v", lines)
+	}
+}
@@ -0,0 +1,215 @@
+package proposals
+
+import (
+	psettings "github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// A pDAO setting that can be changed by a setting proposal
+type ProtocolDaoSetting struct {
+	// The setting's path in its settings contract
+	Path string
+
+	// The units the setting's value is expressed in
+	Unit api.ProposalSettingUnit
+
+	// True if the setting is a percentage that can go over 100%
+	IsUnbounded bool
+
+	// True if the setting only exists after the Saturn upgrade
+	IsSaturnOnly bool
+
+	// The name and alias of the setting's `pdao propose setting` command
+	Command string
+	Alias   string
+
+	// Optional details for the command's usage: a description of the setting, and a replacement for the unit's hint on how to specify the value
+	Description string
+	ValueUsage  string
+}
+
+// A pDAO settings contract and the settings in it that proposals can change
+type ProtocolDaoSettingsContract struct {
+	ContractName string
+
+	// The name, alias and usage of the contract's `pdao propose setting` command; the name is also its short name in settings bundles
+	Command string
+	Alias   string
+	Usage   string
+
+	Settings []ProtocolDaoSetting
+}
+
+// The settings pDAO proposals can change, by settings contract.
+// Address list settings are left out because they can't be encoded in a multi-setting proposal and have their own commands.
+var ProtocolDaoSettings = []ProtocolDaoSettingsContract{
+	{
+		ContractName: psettings.AuctionSettingsContractName,
+		Command:      "auction",
+		Alias:        "a",
+		Usage:        "Auction settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.CreateLotEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-create-lot-enabled", Alias: "icle"},
+			{Path: psettings.BidOnLotEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-bid-on-lot-enabled", Alias: "ibole"},
+			{Path: psettings.LotMinimumEthValueSettingPath, Unit: api.ProposalSettingUnit_Eth, Command: "lot-minimum-eth-value", Alias: "lminev"},
+			{Path: psettings.LotMaximumEthValueSettingPath, Unit: api.ProposalSettingUnit_Eth, Command: "lot-maximum-eth-value", Alias: "lmaxev"},
+			{Path: psettings.LotDurationSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "lot-duration", Alias: "ld"},
+			{Path: psettings.LotStartingPriceRatioSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "lot-starting-price-ratio", Alias: "lspr"},
+			{Path: psettings.LotReservePriceRatioSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "lot-reserve-price-ratio", Alias: "lrpr"},
+		},
+	},
+	{
+		ContractName: psettings.DepositSettingsContractName,
+		Command:      "deposit",
+		Alias:        "d",
+		Usage:        "Deposit pool settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.DepositEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-depositing-enabled", Alias: "ide"},
+			{Path: psettings.AssignDepositsEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "are-deposit-assignments-enabled", Alias: "adae"},
+			{Path: psettings.MinimumDepositSettingPath, Unit: api.ProposalSettingUnit_Eth, Command: "minimum-deposit", Alias: "md"},
+			{Path: psettings.MaximumDepositPoolSizeSettingPath, Unit: api.ProposalSettingUnit_Eth, Command: "maximum-deposit-pool-size", Alias: "mdps"},
+			{Path: psettings.MaximumDepositAssignmentsSettingPath, Unit: api.ProposalSettingUnit_Count, Command: "maximum-assignments-per-deposit", Alias: "mapd"},
+			{Path: psettings.MaximumSocializedDepositAssignmentsSettingPath, Unit: api.ProposalSettingUnit_Count, Command: "maximum-socialised-assignments-per-deposit", Alias: "msapd"},
+			{Path: psettings.DepositFeeSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "deposit-fee", Alias: "df", ValueUsage: "specify a percentage between 0 and 0.01 (e.g., '0.001' for 0.10%)"},
+			{Path: psettings.ExpressQueueRatePath, Unit: api.ProposalSettingUnit_Count, IsSaturnOnly: true, Command: "express-queue-rate", Alias: "eqr"},
+			{Path: psettings.ExpressQueueTicketsBaseProvisionPath, Unit: api.ProposalSettingUnit_Count, IsSaturnOnly: true, Command: "express-queue-tickets-base-provision", Alias: "eqtbp"},
+		},
+	},
+	{
+		ContractName: psettings.MinipoolSettingsContractName,
+		Command:      "minipool",
+		Alias:        "m",
+		Usage:        "Minipool settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.MinipoolSubmitWithdrawableEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-submit-withdrawable-enabled", Alias: "iswe"},
+			{Path: psettings.MinipoolLaunchTimeoutSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "launch-timeout", Alias: "lt"},
+			{Path: psettings.BondReductionEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-bond-reduction-enabled", Alias: "ibre"},
+			{Path: psettings.MaximumMinipoolCountSettingPath, Unit: api.ProposalSettingUnit_Count, Command: "max-count", Alias: "mc"},
+			{Path: psettings.MinipoolUserDistributeWindowStartSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "user-distribute-window-start", Alias: "udws"},
+			{Path: psettings.MinipoolUserDistributeWindowLengthSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "user-distribute-window-length", Alias: "udwl"},
+		},
+	},
+	{
+		ContractName: psettings.NetworkSettingsContractName,
+		Command:      "network",
+		Alias:        "ne",
+		Usage:        "Network settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.NodeConsensusThresholdSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "oracle-dao-consensus-threshold", Alias: "odct"},
+			{Path: psettings.NetworkPenaltyThresholdSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "node-penalty-threshold", Alias: "npt"},
+			{Path: psettings.NetworkPenaltyPerRateSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "per-penalty-rate", Alias: "ppr"},
+			{Path: psettings.SubmitBalancesEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-submit-balances-enabled", Alias: "isbe"},
+			{Path: psettings.SubmitBalancesFrequencySettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "submit-balances-frequency", Alias: "sbf"},
+			{Path: psettings.SubmitPricesEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-submit-prices-enabled", Alias: "ispe"},
+			{Path: psettings.SubmitPricesFrequencySettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "submit-prices-frequency", Alias: "spf"},
+			{Path: psettings.MinimumNodeFeeSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "minimum-node-fee", Alias: "minnf"},
+			{Path: psettings.TargetNodeFeeSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "target-node-fee", Alias: "tnf"},
+			{Path: psettings.MaximumNodeFeeSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "maximum-node-fee", Alias: "maxnf"},
+			{Path: psettings.NodeFeeDemandRangeSettingPath, Unit: api.ProposalSettingUnit_Eth, Command: "node-fee-demand-range", Alias: "nfdr"},
+			{Path: psettings.TargetRethCollateralRateSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "target-reth-collateral-rate", Alias: "trcr"},
+			{Path: psettings.SubmitRewardsEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-submit-rewards-enabled", Alias: "isre"},
+			{Path: psettings.NetworkNodeCommissionSharePath, Unit: api.ProposalSettingUnit_Percent, IsSaturnOnly: true, Command: "node-commission-share", Alias: "ncs"},
+			{Path: psettings.NetworkNodeCommissionShareSecurityCouncilAdderPath, Unit: api.ProposalSettingUnit_Percent, IsSaturnOnly: true, Command: "node-commission-share-council-adder", Alias: "ncsca"},
+			{Path: psettings.NetworkVoterSharePath, Unit: api.ProposalSettingUnit_Percent, IsSaturnOnly: true, Command: "voter-share", Alias: "vs"},
+			{Path: psettings.NetworkPDAOSharePath, Unit: api.ProposalSettingUnit_Percent, IsSaturnOnly: true, Command: "pdao-share", Alias: "ps"},
+			{Path: psettings.NetworkMaxNodeShareSecurityCouncilAdderPath, Unit: api.ProposalSettingUnit_Percent, IsSaturnOnly: true, Command: "max-node-share-security-council-adder", Alias: "mns"},
+			{Path: psettings.NetworkMaxRethBalanceDeltaPath, Unit: api.ProposalSettingUnit_Percent, IsSaturnOnly: true, Command: "max-reth-balance-delta", Alias: "mrb"},
+		},
+	},
+	{
+		ContractName: psettings.NodeSettingsContractName,
+		Command:      "node",
+		Alias:        "no",
+		Usage:        "Node settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.NodeRegistrationEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-registration-enabled", Alias: "ire"},
+			{Path: psettings.SmoothingPoolRegistrationEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-smoothing-pool-registration-enabled", Alias: "ispre"},
+			{Path: psettings.NodeDepositEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "is-depositing-enabled", Alias: "ide"},
+			{Path: psettings.VacantMinipoolsEnabledSettingPath, Unit: api.ProposalSettingUnit_Bool, Command: "are-vacant-minipools-enabled", Alias: "avme"},
+			{Path: psettings.MinimumPerMinipoolStakeSettingPath, Unit: api.ProposalSettingUnit_Percent, IsUnbounded: true, Command: "minimum-per-minipool-stake", Alias: "minpms"},
+			{Path: psettings.MaximumPerMinipoolStakeSettingPath, Unit: api.ProposalSettingUnit_Percent, IsUnbounded: true, Command: "maximum-per-minipool-stake", Alias: "maxpms"},
+			{Path: psettings.ReducedBondSettingPath, Unit: api.ProposalSettingUnit_Eth, IsSaturnOnly: true, Command: "reduced-bond", Alias: "rb"},
+			{Path: psettings.NodeUnstakingPeriodSettingPath, Unit: api.ProposalSettingUnit_Duration, IsSaturnOnly: true, Command: "node-unstaking-period", Alias: "nup"},
+		},
+	},
+	{
+		ContractName: psettings.ProposalsSettingsContractName,
+		Command:      "proposals",
+		Alias:        "p",
+		Usage:        "Proposal settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.VotePhase1TimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "vote-phase1-time", Alias: "vt1"},
+			{Path: psettings.VotePhase2TimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "vote-phase2-time", Alias: "vt2"},
+			{Path: psettings.VoteDelayTimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "vote-delay-time", Alias: "vdt"},
+			{Path: psettings.ExecuteTimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "execute-time", Alias: "et"},
+			{Path: psettings.ProposalBondSettingPath, Unit: api.ProposalSettingUnit_Rpl, Command: "proposal-bond", Alias: "pb"},
+			{Path: psettings.ChallengeBondSettingPath, Unit: api.ProposalSettingUnit_Rpl, Command: "challenge-bond", Alias: "cb"},
+			{Path: psettings.ChallengePeriodSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "challenge-period", Alias: "cp"},
+			{Path: psettings.ProposalQuorumSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "quorum", Alias: "q"},
+			{Path: psettings.ProposalVetoQuorumSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "veto-quorum", Alias: "vq"},
+			{Path: psettings.ProposalMaxBlockAgeSettingPath, Unit: api.ProposalSettingUnit_Blocks, Command: "max-block-age", Alias: "mba"},
+		},
+	},
+	{
+		ContractName: psettings.RewardsSettingsContractName,
+		Command:      "rewards",
+		Alias:        "r",
+		Usage:        "Rewards settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.RewardsClaimIntervalPeriodsSettingPath, Unit: api.ProposalSettingUnit_Count, Command: "interval-periods", Alias: "ip", Description: "the rewards interval will consist of this number of price/balances submission periods"},
+		},
+	},
+	{
+		ContractName: psettings.SecuritySettingsContractName,
+		Command:      "security",
+		Alias:        "s",
+		Usage:        "Security council settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.SecurityMembersQuorumSettingPath, Unit: api.ProposalSettingUnit_Percent, Command: "members-quorum", Alias: "mq"},
+			{Path: psettings.SecurityMembersLeaveTimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "members-leave-time", Alias: "mlt"},
+			{Path: psettings.SecurityProposalVoteTimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "proposal-vote-time", Alias: "pvt"},
+			{Path: psettings.SecurityProposalExecuteTimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "proposal-execute-time", Alias: "pet"},
+			{Path: psettings.SecurityProposalActionTimeSettingPath, Unit: api.ProposalSettingUnit_Duration, Command: "proposal-action-time", Alias: "pat"},
+		},
+	},
+	{
+		ContractName: psettings.MegapoolSettingsContractName,
+		Command:      "megapool",
+		Alias:        "g",
+		Usage:        "Megapool settings",
+		Settings: []ProtocolDaoSetting{
+			{Path: psettings.MegapoolTimeBeforeDissolveSettingsPath, Unit: api.ProposalSettingUnit_Duration, IsSaturnOnly: true, Command: "time-before-dissolve", Alias: "tbd"},
+			{Path: psettings.MegapoolMaximumMegapoolEthPenaltyPath, Unit: api.ProposalSettingUnit_Eth, IsSaturnOnly: true, Command: "maximum-megapool-eth-penalty", Alias: "mmep"},
+			{Path: psettings.MegapoolNotifyThresholdPath, Unit: api.ProposalSettingUnit_Duration, IsSaturnOnly: true, Command: "notify-threshold", Alias: "nt"},
+			{Path: psettings.MegapoolLateNotifyFinePath, Unit: api.ProposalSettingUnit_Eth, IsSaturnOnly: true, Command: "late-notify-fine", Alias: "lnf"},
+			{Path: psettings.MegapoolUserDistributeWindowLengthPath, Unit: api.ProposalSettingUnit_Duration, IsSaturnOnly: true, Command: "user-distribute-length", Alias: "udl"},
+		},
+	},
+}
+
+// Get a pDAO setting that proposals can change
+func GetProtocolDaoSetting(contractName string, settingPath string) (ProtocolDaoSetting, bool) {
+	for _, contract := range ProtocolDaoSettings {
+		if contract.ContractName != contractName {
+			continue
+		}
+		for _, setting := range contract.Settings {
+			if setting.Path == settingPath {
+				return setting, true
+			}
+		}
+	}
+	return ProtocolDaoSetting{}, false
+}
+
+// Get the type the setting's value is encoded as in a proposal
+func (s ProtocolDaoSetting) Type() types.ProposalSettingType {
+	switch s.Unit {
+	case api.ProposalSettingUnit_Bool:
+		return types.ProposalSettingType_Bool
+	case api.ProposalSettingUnit_Address:
+		return types.ProposalSettingType_Address
+	}
+	return types.ProposalSettingType_Uint256
+}
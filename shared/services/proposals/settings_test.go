@@ -0,0 +1,33 @@
+package proposals
+
+import "testing"
+
+func TestProtocolDaoSettings(t *testing.T) {
+	// The commands are generated from the table, so names and aliases must be unique within each group
+	contractCommands := map[string]bool{}
+	for _, contract := range ProtocolDaoSettings {
+		if contractCommands[contract.Command] || contractCommands[contract.Alias] {
+			t.Errorf("the %s command or its alias %s is used more than once", contract.Command, contract.Alias)
+		}
+		contractCommands[contract.Command] = true
+		contractCommands[contract.Alias] = true
+
+		paths := map[string]bool{}
+		commands := map[string]bool{}
+		for _, setting := range contract.Settings {
+			if paths[setting.Path] {
+				t.Errorf("%s is listed more than once for %s", setting.Path, contract.ContractName)
+			}
+			paths[setting.Path] = true
+			if setting.Command == "" || setting.Alias == "" || commands[setting.Command] || commands[setting.Alias] {
+				t.Errorf("%s needs a command and alias that are unique in the %s group, got '%s' and '%s'", setting.Path, contract.Command, setting.Command, setting.Alias)
+			}
+			commands[setting.Command] = true
+			commands[setting.Alias] = true
+
+			if found, exists := GetProtocolDaoSetting(contract.ContractName, setting.Path); !exists || found.Command != setting.Command {
+				t.Errorf("expected to find %s in %s", setting.Path, contract.ContractName)
+			}
+		}
+	}
+}
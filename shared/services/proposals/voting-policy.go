@@ -0,0 +1,297 @@
+package proposals
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"gopkg.in/yaml.v2"
+)
+
+// The kinds of proposals a voting policy rule can match
+const (
+	VotingPolicyProposalType_Setting         string = "setting"
+	VotingPolicyProposalType_Treasury        string = "treasury"
+	VotingPolicyProposalType_SecurityCouncil string = "security-council"
+	VotingPolicyProposalType_Other           string = "other"
+)
+
+// What a voting policy does with the proposals a rule matches
+type VotingPolicyAction string
+
+const (
+	VotingPolicyAction_Abstain VotingPolicyAction = "abstain"
+	VotingPolicyAction_For     VotingPolicyAction = "for"
+	VotingPolicyAction_Against VotingPolicyAction = "against"
+	VotingPolicyAction_Veto    VotingPolicyAction = "veto"
+	VotingPolicyAction_Follow  VotingPolicyAction = "follow"
+	VotingPolicyAction_Skip    VotingPolicyAction = "skip"
+)
+
+// The vote direction cast by each of the voting actions
+var votingPolicyDirections = map[VotingPolicyAction]types.VoteDirection{
+	VotingPolicyAction_Abstain: types.VoteDirection_Abstain,
+	VotingPolicyAction_For:     types.VoteDirection_For,
+	VotingPolicyAction_Against: types.VoteDirection_Against,
+	VotingPolicyAction_Veto:    types.VoteDirection_AgainstWithVeto,
+}
+
+// A node operator's rules for voting on pDAO proposals automatically.
+// Rules are checked in order and the first one that matches a proposal decides what happens to it;
+// proposals that no rule matches get the default action, which is to skip them if it isn't set.
+type VotingPolicy struct {
+	Default VotingPolicyAction `yaml:"default"`
+	Rules   []VotingPolicyRule `yaml:"rules"`
+}
+
+// A voting policy rule; each condition that's set must hold for the rule to match a proposal
+type VotingPolicyRule struct {
+	Name string `yaml:"name"`
+
+	// Conditions
+	Types             []string `yaml:"types"`
+	Functions         []string `yaml:"functions"`
+	Settings          []string `yaml:"settings"`
+	Proposers         []string `yaml:"proposers"`
+	MinTreasuryAmount *float64 `yaml:"minTreasuryAmount"`
+	MaxTreasuryAmount *float64 `yaml:"maxTreasuryAmount"`
+
+	// What to do with matching proposals; delegate is the node whose vote to copy for the follow action
+	Action   VotingPolicyAction `yaml:"action"`
+	Delegate string             `yaml:"delegate"`
+
+	proposers         []common.Address
+	minTreasuryAmount *big.Int
+	maxTreasuryAmount *big.Int
+	delegate          common.Address
+}
+
+// What a voting policy decided to do with a proposal
+type VotingPolicyDecision struct {
+	// The name of the rule that matched, or blank if the default action was used
+	Rule   string
+	Action VotingPolicyAction
+
+	// The direction to vote in, for the voting actions
+	Direction types.VoteDirection
+
+	// The node whose vote to copy, for the follow action
+	Delegate common.Address
+}
+
+// Load a voting policy from a YAML file
+func LoadVotingPolicy(path string) (*VotingPolicy, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading voting policy %s: %w", path, err)
+	}
+	policy := &VotingPolicy{}
+	if err := yaml.Unmarshal(bytes, policy); err != nil {
+		return nil, fmt.Errorf("error parsing voting policy %s: %w", path, err)
+	}
+	if err := policy.validate(); err != nil {
+		return nil, fmt.Errorf("invalid voting policy %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Decide what to do with a proposal, given the changes its payload makes
+func (p *VotingPolicy) Decide(prop *protocol.ProtocolDaoProposalDetails, decoded *api.DecodedProposalPayload) VotingPolicyDecision {
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if rule.matches(prop, decoded) {
+			return VotingPolicyDecision{
+				Rule:      rule.Name,
+				Action:    rule.Action,
+				Direction: votingPolicyDirections[rule.Action],
+				Delegate:  rule.delegate,
+			}
+		}
+	}
+	return VotingPolicyDecision{
+		Action:    p.Default,
+		Direction: votingPolicyDirections[p.Default],
+	}
+}
+
+// Get a description of the decision for logs and alerts
+func (d VotingPolicyDecision) String() string {
+	source := "the default action"
+	if d.Rule != "" {
+		source = fmt.Sprintf("rule '%s'", d.Rule)
+	}
+	switch d.Action {
+	case VotingPolicyAction_Skip:
+		return fmt.Sprintf("skip (%s)", source)
+	case VotingPolicyAction_Follow:
+		return fmt.Sprintf("follow %s (%s)", d.Delegate.Hex(), source)
+	default:
+		return fmt.Sprintf("vote %s (%s)", types.VoteDirections[d.Direction], source)
+	}
+}
+
+// Get the kind of proposal a decoded payload belongs to
+func GetProposalType(decoded *api.DecodedProposalPayload) string {
+	switch {
+	case len(decoded.TreasurySpends) > 0:
+		return VotingPolicyProposalType_Treasury
+	case len(decoded.MemberChanges) > 0:
+		return VotingPolicyProposalType_SecurityCouncil
+	case len(decoded.SettingChanges) > 0:
+		return VotingPolicyProposalType_Setting
+	default:
+		return VotingPolicyProposalType_Other
+	}
+}
+
+// Get the total amount of RPL (in wei) a decoded payload pays out of the treasury, counting every period of recurring spends
+func GetTreasurySpendTotal(decoded *api.DecodedProposalPayload) *big.Int {
+	total := big.NewInt(0)
+	for _, spend := range decoded.TreasurySpends {
+		amount := new(big.Int).Set(spend.Amount)
+		if spend.Type != api.ProposalTreasurySpendType_OneTime {
+			amount.Mul(amount, new(big.Int).SetUint64(spend.NumberOfPeriods))
+		}
+		total.Add(total, amount)
+	}
+	return total
+}
+
+// Check the policy's actions and conditions, and parse the ones that aren't plain strings
+func (p *VotingPolicy) validate() error {
+	if p.Default == "" {
+		p.Default = VotingPolicyAction_Skip
+	}
+	switch p.Default {
+	case VotingPolicyAction_Follow:
+		return fmt.Errorf("the default action can't be %s since it has no delegate", p.Default)
+	case VotingPolicyAction_Skip:
+	default:
+		if _, exists := votingPolicyDirections[p.Default]; !exists {
+			return fmt.Errorf("unknown default action '%s'", p.Default)
+		}
+	}
+
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("#%d", i+1)
+		}
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule '%s': %w", rule.Name, err)
+		}
+	}
+	return nil
+}
+
+// Check a rule's action and conditions, and parse the ones that aren't plain strings
+func (r *VotingPolicyRule) validate() error {
+	switch r.Action {
+	case VotingPolicyAction_Follow:
+		if !common.IsHexAddress(r.Delegate) {
+			return fmt.Errorf("the follow action needs a valid delegate address, not '%s'", r.Delegate)
+		}
+		r.delegate = common.HexToAddress(r.Delegate)
+	case VotingPolicyAction_Skip:
+	case "":
+		return fmt.Errorf("missing action")
+	default:
+		if _, exists := votingPolicyDirections[r.Action]; !exists {
+			return fmt.Errorf("unknown action '%s'", r.Action)
+		}
+	}
+
+	for _, proposalType := range r.Types {
+		switch proposalType {
+		case VotingPolicyProposalType_Setting, VotingPolicyProposalType_Treasury, VotingPolicyProposalType_SecurityCouncil, VotingPolicyProposalType_Other:
+		default:
+			return fmt.Errorf("unknown proposal type '%s'", proposalType)
+		}
+	}
+
+	r.proposers = make([]common.Address, len(r.Proposers))
+	for i, proposer := range r.Proposers {
+		if !common.IsHexAddress(proposer) {
+			return fmt.Errorf("invalid proposer address '%s'", proposer)
+		}
+		r.proposers[i] = common.HexToAddress(proposer)
+	}
+
+	if r.MinTreasuryAmount != nil {
+		if *r.MinTreasuryAmount < 0 {
+			return fmt.Errorf("minTreasuryAmount can't be negative")
+		}
+		r.minTreasuryAmount = eth.EthToWei(*r.MinTreasuryAmount)
+	}
+	if r.MaxTreasuryAmount != nil {
+		if *r.MaxTreasuryAmount < 0 {
+			return fmt.Errorf("maxTreasuryAmount can't be negative")
+		}
+		r.maxTreasuryAmount = eth.EthToWei(*r.MaxTreasuryAmount)
+	}
+	return nil
+}
+
+// Check if a rule matches a proposal.
+// The treasury amount conditions only match treasury spends; the minimum is inclusive and the maximum is exclusive.
+func (r *VotingPolicyRule) matches(prop *protocol.ProtocolDaoProposalDetails, decoded *api.DecodedProposalPayload) bool {
+	if len(r.Types) > 0 && !containsString(r.Types, GetProposalType(decoded)) {
+		return false
+	}
+	if len(r.Functions) > 0 && !containsString(r.Functions, decoded.Function) {
+		return false
+	}
+	if len(r.Settings) > 0 {
+		found := false
+		for _, change := range decoded.SettingChanges {
+			if containsString(r.Settings, change.SettingPath) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.proposers) > 0 {
+		found := false
+		for _, proposer := range r.proposers {
+			if proposer == prop.ProposerAddress {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if r.minTreasuryAmount != nil || r.maxTreasuryAmount != nil {
+		if len(decoded.TreasurySpends) == 0 {
+			return false
+		}
+		total := GetTreasurySpendTotal(decoded)
+		if r.minTreasuryAmount != nil && total.Cmp(r.minTreasuryAmount) < 0 {
+			return false
+		}
+		if r.maxTreasuryAmount != nil && total.Cmp(r.maxTreasuryAmount) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if a list holds a string, ignoring case
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package proposals
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+var (
+	testProposer = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testDelegate = common.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+func newTestPolicy(t *testing.T, policy VotingPolicy) *VotingPolicy {
+	if err := policy.validate(); err != nil {
+		t.Fatalf("unexpected invalid policy: %s", err.Error())
+	}
+	return &policy
+}
+
+func newTreasuryPayload(amounts ...float64) *api.DecodedProposalPayload {
+	decoded := &api.DecodedProposalPayload{Function: "proposalTreasuryOneTimeSpend"}
+	for _, amount := range amounts {
+		decoded.TreasurySpends = append(decoded.TreasurySpends, api.ProposalTreasurySpend{
+			Type:   api.ProposalTreasurySpendType_OneTime,
+			Amount: eth.EthToWei(amount),
+		})
+	}
+	return decoded
+}
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+func TestVotingPolicyDecide(t *testing.T) {
+	policy := newTestPolicy(t, VotingPolicy{
+		Default: VotingPolicyAction_Abstain,
+		Rules: []VotingPolicyRule{
+			{Name: "trusted proposer", Proposers: []string{testProposer.Hex()}, Action: VotingPolicyAction_For},
+			{Name: "fees", Types: []string{VotingPolicyProposalType_Setting}, Settings: []string{"network.node.fee.minimum"}, Action: VotingPolicyAction_Against},
+			{Name: "security council", Types: []string{VotingPolicyProposalType_SecurityCouncil}, Action: VotingPolicyAction_Follow, Delegate: testDelegate.Hex()},
+			{Name: "upgrades", Functions: []string{"PROPOSALSETTINGADDRESS"}, Action: VotingPolicyAction_Veto},
+			{Types: []string{VotingPolicyProposalType_Other}, Action: VotingPolicyAction_Skip},
+		},
+	})
+
+	settingPayload := func(path string) *api.DecodedProposalPayload {
+		return &api.DecodedProposalPayload{
+			Function:       "proposalSettingUint",
+			SettingChanges: []api.ProposalSettingChange{{SettingPath: path}},
+		}
+	}
+	tests := []struct {
+		name      string
+		proposer  common.Address
+		decoded   *api.DecodedProposalPayload
+		rule      string
+		action    VotingPolicyAction
+		direction types.VoteDirection
+		delegate  common.Address
+	}{
+		{"the first matching rule wins", testProposer, settingPayload("network.node.fee.minimum"), "trusted proposer", VotingPolicyAction_For, types.VoteDirection_For, common.Address{}},
+		{"setting paths", common.Address{}, settingPayload("network.node.fee.minimum"), "fees", VotingPolicyAction_Against, types.VoteDirection_Against, common.Address{}},
+		{"other setting paths fall through", common.Address{}, settingPayload("network.node.fee.maximum"), "", VotingPolicyAction_Abstain, types.VoteDirection_Abstain, common.Address{}},
+		{"follow", common.Address{}, &api.DecodedProposalPayload{MemberChanges: []api.ProposalMemberChange{{}}}, "security council", VotingPolicyAction_Follow, types.VoteDirection_NoVote, testDelegate},
+		{"functions ignore case", common.Address{}, &api.DecodedProposalPayload{Function: "proposalSettingAddress", SettingChanges: []api.ProposalSettingChange{{}}}, "upgrades", VotingPolicyAction_Veto, types.VoteDirection_AgainstWithVeto, common.Address{}},
+		{"unnamed rules are numbered", common.Address{}, &api.DecodedProposalPayload{Function: "proposalUnknown"}, "#5", VotingPolicyAction_Skip, types.VoteDirection_NoVote, common.Address{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prop := &protocol.ProtocolDaoProposalDetails{ProposerAddress: test.proposer}
+			decision := policy.Decide(prop, test.decoded)
+			if decision.Rule != test.rule || decision.Action != test.action || decision.Direction != test.direction || decision.Delegate != test.delegate {
+				t.Fatalf("expected rule '%s' to %s (direction %d, delegate %s), got %+v", test.rule, test.action, test.direction, test.delegate.Hex(), decision)
+			}
+		})
+	}
+}
+
+func TestVotingPolicyTreasuryThresholds(t *testing.T) {
+	policy := newTestPolicy(t, VotingPolicy{
+		Rules: []VotingPolicyRule{
+			{Name: "small", MaxTreasuryAmount: floatPtr(1000), Action: VotingPolicyAction_For},
+			{Name: "large", MinTreasuryAmount: floatPtr(1000), MaxTreasuryAmount: floatPtr(10000), Action: VotingPolicyAction_Abstain},
+			{Name: "huge", MinTreasuryAmount: floatPtr(10000), Action: VotingPolicyAction_Against},
+		},
+	})
+
+	// The minimum is inclusive, the maximum is exclusive, and recurring spends count every period
+	recurring := newTreasuryPayload()
+	recurring.TreasurySpends = append(recurring.TreasurySpends, api.ProposalTreasurySpend{
+		Type:            api.ProposalTreasurySpendType_NewRecurring,
+		Amount:          eth.EthToWei(500),
+		NumberOfPeriods: 20,
+	})
+	tests := []struct {
+		name    string
+		decoded *api.DecodedProposalPayload
+		rule    string
+	}{
+		{"below the threshold", newTreasuryPayload(999), "small"},
+		{"at the threshold", newTreasuryPayload(1000), "large"},
+		{"spends are added together", newTreasuryPayload(600, 600), "large"},
+		{"at the upper threshold", newTreasuryPayload(10000), "huge"},
+		{"recurring spends", recurring, "huge"},
+		{"settings never match treasury thresholds", &api.DecodedProposalPayload{SettingChanges: []api.ProposalSettingChange{{}}}, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decision := policy.Decide(&protocol.ProtocolDaoProposalDetails{}, test.decoded)
+			if decision.Rule != test.rule {
+				t.Fatalf("expected rule '%s' to match, got '%s'", test.rule, decision.Rule)
+			}
+		})
+	}
+}
+
+func TestVotingPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy VotingPolicy
+		valid  bool
+	}{
+		{"empty", VotingPolicy{}, true},
+		{"voting default", VotingPolicy{Default: VotingPolicyAction_Against}, true},
+		{"follow default", VotingPolicy{Default: VotingPolicyAction_Follow}, false},
+		{"unknown default", VotingPolicy{Default: "maybe"}, false},
+		{"missing action", VotingPolicy{Rules: []VotingPolicyRule{{}}}, false},
+		{"unknown action", VotingPolicy{Rules: []VotingPolicyRule{{Action: "maybe"}}}, false},
+		{"follow without a delegate", VotingPolicy{Rules: []VotingPolicyRule{{Action: VotingPolicyAction_Follow}}}, false},
+		{"follow with a delegate", VotingPolicy{Rules: []VotingPolicyRule{{Action: VotingPolicyAction_Follow, Delegate: testDelegate.Hex()}}}, true},
+		{"unknown type", VotingPolicy{Rules: []VotingPolicyRule{{Action: VotingPolicyAction_For, Types: []string{"upgrade"}}}}, false},
+		{"invalid proposer", VotingPolicy{Rules: []VotingPolicyRule{{Action: VotingPolicyAction_For, Proposers: []string{"0x1234"}}}}, false},
+		{"negative minimum", VotingPolicy{Rules: []VotingPolicyRule{{Action: VotingPolicyAction_For, MinTreasuryAmount: floatPtr(-1)}}}, false},
+		{"negative maximum", VotingPolicy{Rules: []VotingPolicyRule{{Action: VotingPolicyAction_For, MaxTreasuryAmount: floatPtr(-1)}}}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.policy.validate()
+			if test.valid && err != nil {
+				t.Fatalf("expected a valid policy, got %s", err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Fatal("expected an invalid policy")
+			}
+		})
+	}
+
+	// A missing default skips proposals
+	policy := newTestPolicy(t, VotingPolicy{})
+	if policy.Default != VotingPolicyAction_Skip {
+		t.Fatalf("expected the default action to be %s, got %s", VotingPolicyAction_Skip, policy.Default)
+	}
+}
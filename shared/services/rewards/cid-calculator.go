@@ -25,8 +25,16 @@ import (
 // Only the last segment of the filename will be used, ie, `/home/alice/foo.zip`
 // will be stripped to `foo.zip`.
 func singleFileDirIPFSCid(data []byte, filename string) (cid.Cid, error) {
+	root, _, err := singleFileDirIPFSDag(data, filename)
+	return root, err
+}
+
+// Builds the DAG for an arbitrary bytestring with a given filename the same way singleFileDirIPFSCid does,
+// returning its root CID and the blockstore holding its blocks
+func singleFileDirIPFSDag(data []byte, filename string) (cid.Cid, blockstore.Blockstore, error) {
 	ds := sync.MutexWrap(datastore.NewMapDatastore())
-	bsvc := blockservice.New(blockstore.NewBlockstore(ds), nil)
+	bs := blockstore.NewBlockstore(ds)
+	bsvc := blockservice.New(bs, nil)
 	dag := merkledag.NewDAGService(bsvc)
 	cidBuilder := merkledag.V1CidPrefix()
 
@@ -37,17 +45,17 @@ func singleFileDirIPFSCid(data []byte, filename string) (cid.Cid, error) {
 	rootNode := unixfs.EmptyDirNode()
 	err := rootNode.SetCidBuilder(cidBuilder)
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error creating the CID builder: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error creating the CID builder: %w", err)
 	}
 	root, err := mfs.NewRoot(context.Background(), dag, rootNode, nil)
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error setting new MFS root: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error setting new MFS root: %w", err)
 	}
 
 	// Create a chunker-reader from the compressed data
 	chnk, err := chunker.FromString(bytes.NewReader(data), "size-1048576")
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error creating chunker-reader from compressed bytes: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error creating chunker-reader from compressed bytes: %w", err)
 	}
 	// Create a DAG builder using the same settings as web3storage
 	params := helpers.DagBuilderParams{
@@ -58,25 +66,25 @@ func singleFileDirIPFSCid(data []byte, filename string) (cid.Cid, error) {
 	}
 	ufsBuilder, err := params.New(chnk)
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error creating params from chunk: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error creating params from chunk: %w", err)
 	}
 
 	// Create the node for the file in the DAG
 	node, err := balanced.Layout(ufsBuilder)
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error creating DAG layout: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error creating DAG layout: %w", err)
 	}
 
 	// Add the file to the root directory
 	err = mfs.PutNode(root, filename, node)
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error adding node to DAG: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error adding node to DAG: %w", err)
 	}
 
 	// Add the file to the dag
 	_, err = mfs.NewFile(filename, node, nil, dag)
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error adding compressed file to DAG: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error adding compressed file to DAG: %w", err)
 	}
 
 	// Finalize the dag and get the cid
@@ -84,22 +92,22 @@ func singleFileDirIPFSCid(data []byte, filename string) (cid.Cid, error) {
 
 	err = rootDir.Flush()
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error flushing DAG root dir: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error flushing DAG root dir: %w", err)
 	}
 
 	err = root.Close()
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error closing DAG root: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error closing DAG root: %w", err)
 	}
 
 	rootDirNode, err := rootDir.GetNode()
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error getting DAG root node: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error getting DAG root node: %w", err)
 	}
 
 	err = ufsBuilder.Add(rootDirNode)
 	if err != nil {
-		return cid.Cid{}, fmt.Errorf("error adding DAG root to UFS builder: %w", err)
+		return cid.Cid{}, nil, fmt.Errorf("error adding DAG root to UFS builder: %w", err)
 	}
-	return rootDirNode.Cid(), nil
+	return rootDirNode.Cid(), bs, nil
 }
@@ -102,6 +102,10 @@ func (r *treeGeneratorImpl_v8) getRulesetVersion() uint64 {
 	return r.rewardsFile.RulesetVersion
 }
 
+// Rolling records aren't supported by this ruleset, so the whole interval is always processed
+func (r *treeGeneratorImpl_v8) setRollingRecord(record *RollingRecord) {
+}
+
 func (r *treeGeneratorImpl_v8) generateTree(rp RewardsExecutionClient, networkName string, previousRewardsPoolAddresses []common.Address, bc RewardsBeaconClient) (*GenerateTreeResult, error) {
 
 	r.log.Printlnf("%s Generating tree using Ruleset v%d.", r.logPrefix, r.rewardsFile.RulesetVersion)
@@ -63,6 +63,9 @@ type treeGeneratorImpl_v9_v10 struct {
 	// fields for RPIP-62 bonus calculations
 	// Withdrawals made by a minipool's validator.
 	minipoolWithdrawals map[common.Address]*big.Int
+
+	// Attestation performance that was already processed during the interval
+	rollingRecord *RollingRecord
 }
 
 // Create a new tree generator
@@ -109,6 +112,11 @@ func (r *treeGeneratorImpl_v9_v10) getRulesetVersion() uint64 {
 	return r.rewardsFile.RulesetVersion
 }
 
+// Set the rolling record to load attestation performance from instead of processing the whole interval
+func (r *treeGeneratorImpl_v9_v10) setRollingRecord(record *RollingRecord) {
+	r.rollingRecord = record
+}
+
 func (r *treeGeneratorImpl_v9_v10) generateTree(rp RewardsExecutionClient, networkName string, previousRewardsPoolAddresses []common.Address, bc RewardsBeaconClient) (*GenerateTreeResult, error) {
 
 	r.log.Printlnf("%s Generating tree using Ruleset v%d.", r.logPrefix, r.rewardsFile.RulesetVersion)
@@ -792,6 +800,9 @@ func (r *treeGeneratorImpl_v9_v10) processAttestationsBalancesAndWithdrawalsForI
 		return err
 	}
 
+	// Skip the epochs that have already been processed by the rolling record
+	startEpoch = r.applyRollingRecord(startEpoch, endEpoch)
+
 	// Check all of the attestations for each epoch
 	r.log.Printlnf("%s Checking participation of %d minipools for epochs %d to %d", r.logPrefix, len(r.validatorIndexMap), startEpoch, endEpoch)
 	r.log.Printlnf("%s NOTE: this will take a long time, progress is reported every 100 epochs", r.logPrefix)
@@ -846,7 +857,6 @@ func (r *treeGeneratorImpl_v9_v10) processEpoch(duringInterval bool, epoch uint6
 		// Get the beacon block for this slot
 		i := i
 		slot := epoch*r.slotsPerEpoch + i
-		slotTime := r.networkState.BeaconConfig.GetSlotTime(slot)
 		wg.Go(func() error {
 			beaconBlock, found, err := r.bc.GetBeaconBlock(fmt.Sprint(slot))
 			if err != nil {
@@ -868,38 +878,8 @@ func (r *treeGeneratorImpl_v9_v10) processEpoch(duringInterval bool, epoch uint6
 				if !exists {
 					continue
 				}
-				nnd := r.networkState.NodeDetailsByAddress[mpi.NodeAddress]
-				nmd := r.networkState.MinipoolDetailsByAddress[mpi.Address]
-
-				// Check that the node is opted into the SP during this slot
-				if !nnd.WasOptedInAt(slotTime) {
-					continue
-				}
-
-				// Check that the minipool's bond is eligible for bonuses at this slot
-				if eligible := nmd.IsEligibleForBonuses(slotTime); !eligible {
-					continue
-				}
-
-				// If the withdrawal is in or after the minipool's withdrawable epoch, adjust it.
-				withdrawalAmount := withdrawal.Amount
-				validatorInfo := r.networkState.MinipoolValidatorDetails[mpi.ValidatorPubkey]
-				if slot >= r.networkState.BeaconConfig.FirstSlotOfEpoch(validatorInfo.WithdrawableEpoch) {
-					// Subtract 32 ETH from the withdrawal amount
-					withdrawalAmount = big.NewInt(0).Sub(withdrawalAmount, thirtyTwoEth)
-					// max(withdrawalAmount, 0)
-					if withdrawalAmount.Sign() < 0 {
-						withdrawalAmount.SetInt64(0)
-					}
-				}
-
-				// Create the minipool's withdrawal sum big.Int if it doesn't exist
 				withdrawalsLock.Lock()
-				if r.minipoolWithdrawals[mpi.Address] == nil {
-					r.minipoolWithdrawals[mpi.Address] = big.NewInt(0)
-				}
-				// Add the withdrawal amount
-				r.minipoolWithdrawals[mpi.Address].Add(r.minipoolWithdrawals[mpi.Address], withdrawalAmount)
+				r.processWithdrawal(mpi, slot, withdrawal.Amount)
 				withdrawalsLock.Unlock()
 			}
 			return nil
@@ -935,6 +915,42 @@ func (r *treeGeneratorImpl_v9_v10) processEpoch(duringInterval bool, epoch uint6
 
 }
 
+// Add a withdrawal to the minipool's consensus income if it was eligible for bonuses at the time
+func (r *treeGeneratorImpl_v9_v10) processWithdrawal(mpi *MinipoolInfo, slot uint64, amount *big.Int) {
+	slotTime := r.networkState.BeaconConfig.GetSlotTime(slot)
+	nnd := r.networkState.NodeDetailsByAddress[mpi.NodeAddress]
+	nmd := r.networkState.MinipoolDetailsByAddress[mpi.Address]
+
+	// Check that the node is opted into the SP during this slot
+	if !nnd.WasOptedInAt(slotTime) {
+		return
+	}
+
+	// Check that the minipool's bond is eligible for bonuses at this slot
+	if eligible := nmd.IsEligibleForBonuses(slotTime); !eligible {
+		return
+	}
+
+	// If the withdrawal is in or after the minipool's withdrawable epoch, adjust it.
+	withdrawalAmount := amount
+	validatorInfo := r.networkState.MinipoolValidatorDetails[mpi.ValidatorPubkey]
+	if slot >= r.networkState.BeaconConfig.FirstSlotOfEpoch(validatorInfo.WithdrawableEpoch) {
+		// Subtract 32 ETH from the withdrawal amount
+		withdrawalAmount = big.NewInt(0).Sub(withdrawalAmount, thirtyTwoEth)
+		// max(withdrawalAmount, 0)
+		if withdrawalAmount.Sign() < 0 {
+			withdrawalAmount.SetInt64(0)
+		}
+	}
+
+	// Create the minipool's withdrawal sum big.Int if it doesn't exist
+	if r.minipoolWithdrawals[mpi.Address] == nil {
+		r.minipoolWithdrawals[mpi.Address] = big.NewInt(0)
+	}
+	// Add the withdrawal amount
+	r.minipoolWithdrawals[mpi.Address].Add(r.minipoolWithdrawals[mpi.Address], withdrawalAmount)
+}
+
 func (r *treeGeneratorImpl_v9_v10) checkAttestations(attestations []beacon.AttestationInfo, inclusionSlot uint64) error {
 
 	// Go through the attestations for the block
@@ -954,7 +970,6 @@ func (r *treeGeneratorImpl_v9_v10) checkAttestations(attestations []beacon.Attes
 			if !exists {
 				continue
 			}
-			blockTime := r.genesisTime.Add(time.Second * time.Duration(r.networkState.BeaconConfig.SecondsPerSlot*attestation.SlotIndex))
 
 			// Check if each RP validator attested successfully
 			for position, validator := range rpCommittee.Positions {
@@ -970,44 +985,50 @@ func (r *treeGeneratorImpl_v9_v10) checkAttestations(attestations []beacon.Attes
 				if len(slotInfo.Committees) == 0 {
 					delete(r.intervalDutiesInfo.Slots, attestation.SlotIndex)
 				}
-				delete(validator.MissingAttestationSlots, attestation.SlotIndex)
+				r.processSuccessfulAttestation(validator, attestation.SlotIndex)
+			}
+		}
+	}
 
-				// Check if this minipool was opted into the SP for this block
-				nodeDetails := r.nodeDetails[validator.NodeIndex]
-				if blockTime.Before(nodeDetails.OptInTime) || blockTime.After(nodeDetails.OptOutTime) {
-					// Not opted in
-					continue
-				}
+	return nil
 
-				eligibleBorrowedEth := nodeDetails.EligibleBorrowedEth
-				_, percentOfBorrowedEth := r.networkState.GetStakedRplValueInEthAndPercentOfBorrowedEth(eligibleBorrowedEth, nodeDetails.RplStake)
+}
 
-				// Mark this duty as completed
-				validator.CompletedAttestations[attestation.SlotIndex] = true
+// Score a successful attestation for the provided minipool
+func (r *treeGeneratorImpl_v9_v10) processSuccessfulAttestation(validator *MinipoolInfo, slotIndex uint64) {
+	blockTime := r.genesisTime.Add(time.Second * time.Duration(r.networkState.BeaconConfig.SecondsPerSlot*slotIndex))
+	delete(validator.MissingAttestationSlots, slotIndex)
 
-				// Get the pseudoscore for this attestation
-				details := r.networkState.MinipoolDetailsByAddress[validator.Address]
-				bond, fee := details.GetMinipoolBondAndNodeFee(blockTime)
+	// Check if this minipool was opted into the SP for this block
+	nodeDetails := r.nodeDetails[validator.NodeIndex]
+	if blockTime.Before(nodeDetails.OptInTime) || blockTime.After(nodeDetails.OptOutTime) {
+		// Not opted in
+		return
+	}
 
-				if r.rewardsFile.RulesetVersion >= 10 {
-					fee = fees.GetMinipoolFeeWithBonus(bond, fee, percentOfBorrowedEth)
-				}
+	eligibleBorrowedEth := nodeDetails.EligibleBorrowedEth
+	_, percentOfBorrowedEth := r.networkState.GetStakedRplValueInEthAndPercentOfBorrowedEth(eligibleBorrowedEth, nodeDetails.RplStake)
 
-				minipoolScore := big.NewInt(0).Sub(oneEth, fee) // 1 - fee
-				minipoolScore.Mul(minipoolScore, bond)          // Multiply by bond
-				minipoolScore.Div(minipoolScore, thirtyTwoEth)  // Divide by 32 to get the bond as a fraction of a total validator
-				minipoolScore.Add(minipoolScore, fee)           // Total = fee + (bond/32)(1 - fee)
+	// Mark this duty as completed
+	validator.CompletedAttestations[slotIndex] = true
 
-				// Add it to the minipool's score and the total score
-				validator.AttestationScore.Add(&validator.AttestationScore.Int, minipoolScore)
-				r.totalAttestationScore.Add(r.totalAttestationScore, minipoolScore)
-				r.successfulAttestations++
-			}
-		}
+	// Get the pseudoscore for this attestation
+	details := r.networkState.MinipoolDetailsByAddress[validator.Address]
+	bond, fee := details.GetMinipoolBondAndNodeFee(blockTime)
+
+	if r.rewardsFile.RulesetVersion >= 10 {
+		fee = fees.GetMinipoolFeeWithBonus(bond, fee, percentOfBorrowedEth)
 	}
 
-	return nil
+	minipoolScore := big.NewInt(0).Sub(oneEth, fee) // 1 - fee
+	minipoolScore.Mul(minipoolScore, bond)          // Multiply by bond
+	minipoolScore.Div(minipoolScore, thirtyTwoEth)  // Divide by 32 to get the bond as a fraction of a total validator
+	minipoolScore.Add(minipoolScore, fee)           // Total = fee + (bond/32)(1 - fee)
 
+	// Add it to the minipool's score and the total score
+	validator.AttestationScore.Add(&validator.AttestationScore.Int, minipoolScore)
+	r.totalAttestationScore.Add(r.totalAttestationScore, minipoolScore)
+	r.successfulAttestations++
 }
 
 // Maps out the attestaion duties for the given epoch
@@ -1044,19 +1065,7 @@ func (r *treeGeneratorImpl_v9_v10) getDutiesForEpoch(committees beacon.Committee
 				continue
 			}
 
-			// Check if this minipool was opted into the SP for this block
-			nodeDetails := r.networkState.NodeDetailsByAddress[minipoolInfo.NodeAddress]
-			isOptedIn := nodeDetails.SmoothingPoolRegistrationState
-			spRegistrationTime := time.Unix(nodeDetails.SmoothingPoolRegistrationChanged.Int64(), 0)
-			if (isOptedIn && blockTime.Sub(spRegistrationTime) < 0) || // If this block occurred before the node opted in, ignore it
-				(!isOptedIn && spRegistrationTime.Sub(blockTime) < 0) { // If this block occurred after the node opted out, ignore it
-				continue
-			}
-
-			// Check if this minipool was in the `staking` state during this time
-			mpd := r.networkState.MinipoolDetailsByAddress[minipoolInfo.Address]
-			statusChangeTime := time.Unix(mpd.StatusTime.Int64(), 0)
-			if mpd.Status != rptypes.Staking || blockTime.Sub(statusChangeTime) < 0 {
+			if !r.hasAttestationDuty(minipoolInfo, blockTime) {
 				continue
 			}
 
@@ -1078,6 +1087,97 @@ func (r *treeGeneratorImpl_v9_v10) getDutiesForEpoch(committees beacon.Committee
 
 }
 
+// Check if a minipool's attestation duty at the provided time counts towards its performance
+func (r *treeGeneratorImpl_v9_v10) hasAttestationDuty(minipoolInfo *MinipoolInfo, blockTime time.Time) bool {
+	// Check if this minipool was opted into the SP for this block
+	nodeDetails := r.networkState.NodeDetailsByAddress[minipoolInfo.NodeAddress]
+	isOptedIn := nodeDetails.SmoothingPoolRegistrationState
+	spRegistrationTime := time.Unix(nodeDetails.SmoothingPoolRegistrationChanged.Int64(), 0)
+	if (isOptedIn && blockTime.Sub(spRegistrationTime) < 0) || // If this block occurred before the node opted in, ignore it
+		(!isOptedIn && spRegistrationTime.Sub(blockTime) < 0) { // If this block occurred after the node opted out, ignore it
+		return false
+	}
+
+	// Check if this minipool was in the `staking` state during this time
+	mpd := r.networkState.MinipoolDetailsByAddress[minipoolInfo.Address]
+	statusChangeTime := time.Unix(mpd.StatusTime.Int64(), 0)
+	if mpd.Status != rptypes.Staking || blockTime.Sub(statusChangeTime) < 0 {
+		return false
+	}
+
+	return true
+}
+
+// Load the duties, attestations, and withdrawals that the rolling record has already processed, returning the first epoch that still needs to be processed
+func (r *treeGeneratorImpl_v9_v10) applyRollingRecord(startEpoch uint64, endEpoch uint64) uint64 {
+	record := r.rollingRecord
+	if record == nil {
+		return startEpoch
+	}
+	if record.RewardsInterval != r.rewardsFile.Index {
+		r.log.Printlnf("%s Rolling record is for interval %d instead of %d, ignoring it.", r.logPrefix, record.RewardsInterval, r.rewardsFile.Index)
+		return startEpoch
+	}
+	if record.StartSlot/r.slotsPerEpoch != startEpoch {
+		r.log.Printlnf("%s Rolling record starts on slot %d which isn't in the interval's first epoch (%d), ignoring it.", r.logPrefix, record.StartSlot, startEpoch)
+		return startEpoch
+	}
+	if record.LastDutiesSlot == 0 {
+		return startEpoch
+	}
+
+	// Attestations for the record's last epoch can still be included in the next one, so that epoch is processed again
+	nextEpoch := record.LastDutiesSlot / r.slotsPerEpoch
+	if nextEpoch > endEpoch {
+		r.log.Printlnf("%s Rolling record extends to slot %d which is past the end of the interval, ignoring it.", r.logPrefix, record.LastDutiesSlot)
+		return startEpoch
+	}
+
+	firstSlot := startEpoch * r.slotsPerEpoch
+	nextSlot := nextEpoch * r.slotsPerEpoch
+	for validatorIndex, minipoolInfo := range r.validatorIndexMap {
+		validator, exists := record.Validators[validatorIndex]
+		if !exists {
+			continue
+		}
+
+		// Replay the duties and attestations as if they came from the Beacon Node
+		for epoch := startEpoch; epoch < nextEpoch; epoch++ {
+			hasDuty, offset, attested := validator.getDuty(epoch - startEpoch)
+			if !hasDuty {
+				continue
+			}
+			slotIndex := epoch*r.slotsPerEpoch + offset
+			if slotIndex < r.rewardsFile.ConsensusStartBlock || slotIndex > r.rewardsFile.ConsensusEndBlock {
+				// Ignore slots that are out of bounds
+				continue
+			}
+			blockTime := r.genesisTime.Add(time.Second * time.Duration(r.beaconConfig.SecondsPerSlot*slotIndex))
+			if !r.hasAttestationDuty(minipoolInfo, blockTime) {
+				continue
+			}
+			if attested {
+				r.processSuccessfulAttestation(minipoolInfo, slotIndex)
+			} else {
+				minipoolInfo.MissingAttestationSlots[slotIndex] = true
+			}
+		}
+
+		// Replay the withdrawals
+		if r.rewardsFile.RulesetVersion < 10 {
+			continue
+		}
+		for _, withdrawal := range validator.Withdrawals {
+			if withdrawal.Slot >= firstSlot && withdrawal.Slot < nextSlot {
+				r.processWithdrawal(minipoolInfo, withdrawal.Slot, &withdrawal.Amount.Int)
+			}
+		}
+	}
+
+	r.log.Printlnf("%s Loaded epochs %d to %d from the rolling record.", r.logPrefix, startEpoch, nextEpoch-1)
+	return nextEpoch
+}
+
 // Maps all minipools to their validator indices and creates a map of indices to minipool info
 func (r *treeGeneratorImpl_v9_v10) createMinipoolIndexMap() error {
 
@@ -109,6 +109,7 @@ type treeGeneratorImpl interface {
 	generateTree(rp RewardsExecutionClient, networkName string, previousRewardsPoolAddresses []common.Address, bc RewardsBeaconClient) (*GenerateTreeResult, error)
 	approximateStakerShareOfSmoothingPool(rp RewardsExecutionClient, networkName string, bc RewardsBeaconClient) (*big.Int, error)
 	getRulesetVersion() uint64
+	setRollingRecord(record *RollingRecord)
 	// Returns the primary artifact cid for consensus, all cids of all files in a map, and any potential errors
 	saveFiles(smartnode *config.SmartnodeConfig, treeResult *GenerateTreeResult, nodeTrusted bool) (cid.Cid, map[string]cid.Cid, error)
 }
@@ -228,6 +229,13 @@ func (t *TreeGenerator) ApproximateStakerShareOfSmoothingPool() (*big.Int, error
 	return t.approximatorImpl.approximateStakerShareOfSmoothingPool(t.rp, fmt.Sprint(t.cfg.Smartnode.Network.Value), t.bc)
 }
 
+// Use a rolling record for the interval so only the epochs after its last update need to be processed
+func (t *TreeGenerator) SetRollingRecord(record *RollingRecord) {
+	for _, info := range t.rewardsIntervalInfos {
+		info.generator.setRollingRecord(record)
+	}
+}
+
 func (t *TreeGenerator) GetGeneratorRulesetVersion() uint64 {
 	return t.generatorImpl.getRulesetVersion()
 }
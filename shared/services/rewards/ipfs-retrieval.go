@@ -0,0 +1,248 @@
+package rewards
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	blockservice "github.com/ipfs/boxo/blockservice"
+	blockstore "github.com/ipfs/boxo/blockstore"
+	merkledag "github.com/ipfs/boxo/ipld/merkledag"
+	uio "github.com/ipfs/boxo/ipld/unixfs/io"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+)
+
+const (
+	// The content type trustless gateways use for CAR responses
+	carContentType string = "application/vnd.ipld.car"
+
+	// The largest CAR header or block section that will be read; rewards trees are chunked into 1 MiB blocks
+	maxCarSectionSize uint64 = 4 * 1024 * 1024
+
+	// The largest CAR response that will be read; compressed rewards trees are far smaller than this
+	maxCarSize int64 = 256 * 1024 * 1024
+)
+
+// Downloads the CAR data for a file in an IPFS directory from a trustless gateway
+func downloadCarFromGateway(ctx context.Context, gatewayUrl string, root cid.Cid, filename string) ([]byte, error) {
+	requestUrl := fmt.Sprintf("%s/ipfs/%s/%s?format=car", strings.TrimSuffix(gatewayUrl, "/"), root.String(), url.PathEscape(filename))
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for %s: %w", requestUrl, err)
+	}
+	request.Header.Set("Accept", carContentType)
+	return doCarRequest(request)
+}
+
+// Exports the CAR data for an IPFS DAG from an IPFS node's RPC API
+func downloadCarFromIpfsNode(ctx context.Context, apiUrl string, root cid.Cid) ([]byte, error) {
+	requestUrl := fmt.Sprintf("%s/api/v0/dag/export?arg=%s", strings.TrimSuffix(apiUrl, "/"), root.String())
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, requestUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for %s: %w", requestUrl, err)
+	}
+	return doCarRequest(request)
+}
+
+// Imports CAR data into an IPFS node through its RPC API, pinning its root
+func pinCarOnIpfsNode(ctx context.Context, apiUrl string, carData []byte) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "rewards.car")
+	if err != nil {
+		return fmt.Errorf("error creating CAR upload: %w", err)
+	}
+	if _, err := part.Write(carData); err != nil {
+		return fmt.Errorf("error creating CAR upload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("error creating CAR upload: %w", err)
+	}
+
+	requestUrl := fmt.Sprintf("%s/api/v0/dag/import?pin-roots=true", strings.TrimSuffix(apiUrl, "/"))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, requestUrl, body)
+	if err != nil {
+		return fmt.Errorf("error creating request for %s: %w", requestUrl, err)
+	}
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("error importing CAR data into %s: %w", apiUrl, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("importing CAR data into %s failed with status %s", apiUrl, response.Status)
+	}
+	return nil
+}
+
+// Sends a request for CAR data and reads the response
+func doCarRequest(request *http.Request) ([]byte, error) {
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status %s", response.Status)
+	}
+	carData, err := io.ReadAll(io.LimitReader(response.Body, maxCarSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if int64(len(carData)) > maxCarSize {
+		return nil, fmt.Errorf("response is larger than the %d byte limit", maxCarSize)
+	}
+	return carData, nil
+}
+
+// Builds the CAR data for a file in an IPFS directory the same way the rewards trees are uploaded, so a file that
+// was downloaded over plain HTTP can still be cached and pinned. The DAG has to have the expected root, so this fails
+// if the file wasn't compressed the same way as the uploaded one.
+func buildCarForFile(ctx context.Context, data []byte, filename string, expectedRoot cid.Cid) ([]byte, error) {
+	root, bs, err := singleFileDirIPFSDag(data, filename)
+	if err != nil {
+		return nil, err
+	}
+	if !root.Equals(expectedRoot) {
+		return nil, fmt.Errorf("the file's CID %s does not match the expected CID %s", root.String(), expectedRoot.String())
+	}
+	keys, err := bs.AllKeysChan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing blocks: %w", err)
+	}
+
+	car := &bytes.Buffer{}
+	writeCarSection(car, encodeCarHeader(root))
+	for blockCid := range keys {
+		block, err := bs.Get(ctx, blockCid)
+		if err != nil {
+			return nil, fmt.Errorf("error getting block %s: %w", blockCid.String(), err)
+		}
+		writeCarSection(car, append(blockCid.Bytes(), block.RawData()...))
+	}
+	return car.Bytes(), nil
+}
+
+// Writes a length-prefixed CAR section
+func writeCarSection(car *bytes.Buffer, section []byte) {
+	car.Write(binary.AppendUvarint(nil, uint64(len(section))))
+	car.Write(section)
+}
+
+// Encodes a CARv1 header with a single root as DAG-CBOR, i.e. {"roots": [root], "version": 1}
+func encodeCarHeader(root cid.Cid) []byte {
+	// CIDs are encoded as tag 42 over their bytes with a leading zero
+	cidBytes := append([]byte{0x00}, root.Bytes()...)
+
+	header := []byte{0xa2, 0x65}
+	header = append(header, "roots"...)
+	header = append(header, 0x81, 0xd8, 0x2a)
+	if len(cidBytes) < 24 {
+		header = append(header, 0x40|byte(len(cidBytes)))
+	} else {
+		header = append(header, 0x58, byte(len(cidBytes)))
+	}
+	header = append(header, cidBytes...)
+	header = append(header, 0x67)
+	header = append(header, "version"...)
+	header = append(header, 0x01)
+	return header
+}
+
+// Reads a file in an IPFS directory out of CAR data.
+// Every block in the CAR data is checked against its CID, and the file is found by walking the DAG down from
+// the root CID, so the file is guaranteed to be the one the root CID refers to; the CAR header's roots aren't trusted.
+func extractFileFromCar(ctx context.Context, carData []byte, root cid.Cid, filename string) ([]byte, error) {
+	// Load the verified blocks into a temporary blockstore
+	bs := blockstore.NewBlockstore(sync.MutexWrap(datastore.NewMapDatastore()))
+	reader := bufio.NewReader(bytes.NewReader(carData))
+	if _, err := readCarSection(reader); err != nil {
+		return nil, fmt.Errorf("error reading CAR header: %w", err)
+	}
+	for {
+		section, err := readCarSection(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading CAR block: %w", err)
+		}
+
+		block, err := verifyCarBlock(section)
+		if err != nil {
+			return nil, err
+		}
+		if err := bs.Put(ctx, block); err != nil {
+			return nil, fmt.Errorf("error storing block %s: %w", block.Cid().String(), err)
+		}
+	}
+
+	// Walk the DAG from the root; there's no exchange, so any missing block is an error
+	dag := merkledag.NewDAGService(blockservice.New(bs, nil))
+	rootNode, err := dag.Get(ctx, root)
+	if err != nil {
+		return nil, fmt.Errorf("error getting root directory %s: %w", root.String(), err)
+	}
+	dir, err := uio.NewDirectoryFromNode(dag, rootNode)
+	if err != nil {
+		return nil, fmt.Errorf("error reading root directory %s: %w", root.String(), err)
+	}
+	fileNode, err := dir.Find(ctx, filename)
+	if err != nil {
+		return nil, fmt.Errorf("error finding %s in root directory %s: %w", filename, root.String(), err)
+	}
+	fileReader, err := uio.NewDagReader(ctx, fileNode, dag)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", filename, err)
+	}
+	fileBytes, err := io.ReadAll(fileReader)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", filename, err)
+	}
+	return fileBytes, nil
+}
+
+// Reads a length-prefixed section of CAR data, returning io.EOF if there are no more sections
+func readCarSection(reader *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	if length == 0 || length > maxCarSectionSize {
+		return nil, fmt.Errorf("invalid section length %d", length)
+	}
+	section := make([]byte, length)
+	if _, err := io.ReadFull(reader, section); err != nil {
+		return nil, fmt.Errorf("error reading section: %w", err)
+	}
+	return section, nil
+}
+
+// Parses a CAR block section and makes sure its data matches its CID
+func verifyCarBlock(section []byte) (blocks.Block, error) {
+	length, blockCid, err := cid.CidFromBytes(section)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block CID: %w", err)
+	}
+	data := section[length:]
+	hashedCid, err := blockCid.Prefix().Sum(data)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing block %s: %w", blockCid.String(), err)
+	}
+	if !hashedCid.Equals(blockCid) {
+		return nil, fmt.Errorf("block %s does not match its CID (hashed to %s)", blockCid.String(), hashedCid.String())
+	}
+	return blocks.NewBlockWithCid(data, blockCid)
+}
@@ -0,0 +1,207 @@
+package rewards
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+)
+
+const testCarFilename string = "rp-rewards-test-1.json.zst"
+
+// Builds CAR data for a single-file directory, letting the caller change or drop blocks along the way
+func buildTestCar(t *testing.T, data []byte, editBlock func(blockCid cid.Cid, blockData []byte) []byte) (cid.Cid, []byte) {
+	ctx := context.Background()
+	root, bs, err := singleFileDirIPFSDag(data, testCarFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := bs.AllKeysChan(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	car := &bytes.Buffer{}
+	writeSection := func(section []byte) {
+		car.Write(binary.AppendUvarint(nil, uint64(len(section))))
+		car.Write(section)
+	}
+
+	// The header isn't trusted, so an empty CBOR map is enough
+	writeSection([]byte{0xa0})
+	for blockCid := range keys {
+		block, err := bs.Get(ctx, blockCid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blockData := editBlock(blockCid, block.RawData())
+		if blockData == nil {
+			continue
+		}
+		writeSection(append(blockCid.Bytes(), blockData...))
+	}
+	return root, car.Bytes()
+}
+
+func TestExtractFileFromCar(t *testing.T) {
+	// Use enough data to be split into several blocks
+	data := make([]byte, 2500*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	root, car := buildTestCar(t, data, func(_ cid.Cid, blockData []byte) []byte {
+		return blockData
+	})
+	extracted, err := extractFileFromCar(context.Background(), car, root, testCarFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, extracted) {
+		t.Fatal("extracted file does not match the original data")
+	}
+
+	// The file has to be found under the root it's expected at
+	otherRoot, _ := buildTestCar(t, []byte("other data"), func(_ cid.Cid, blockData []byte) []byte {
+		return blockData
+	})
+	if _, err := extractFileFromCar(context.Background(), car, otherRoot, testCarFilename); err == nil {
+		t.Fatal("expected an error when extracting from a root that isn't in the CAR data")
+	}
+}
+
+func TestExtractFileFromCarWithTamperedBlock(t *testing.T) {
+	data := make([]byte, 2500*1024)
+	rand.New(rand.NewSource(2)).Read(data)
+
+	tampered := false
+	root, car := buildTestCar(t, data, func(blockCid cid.Cid, blockData []byte) []byte {
+		if tampered || blockCid.Prefix().Codec != cid.Raw {
+			return blockData
+		}
+		tampered = true
+		edited := bytes.Clone(blockData)
+		edited[0] ^= 0xff
+		return edited
+	})
+	if _, err := extractFileFromCar(context.Background(), car, root, testCarFilename); err == nil {
+		t.Fatal("expected an error when a block doesn't match its CID")
+	}
+}
+
+func TestExtractFileFromCarWithMissingBlock(t *testing.T) {
+	data := make([]byte, 2500*1024)
+	rand.New(rand.NewSource(3)).Read(data)
+
+	dropped := false
+	root, car := buildTestCar(t, data, func(blockCid cid.Cid, blockData []byte) []byte {
+		if dropped || blockCid.Prefix().Codec != cid.Raw {
+			return blockData
+		}
+		dropped = true
+		return nil
+	})
+	if _, err := extractFileFromCar(context.Background(), car, root, testCarFilename); err == nil {
+		t.Fatal("expected an error when a block is missing")
+	}
+}
+
+func TestBuildCarForFile(t *testing.T) {
+	data := make([]byte, 2500*1024)
+	rand.New(rand.NewSource(4)).Read(data)
+	root, err := singleFileDirIPFSCid(data, testCarFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	car, err := buildCarForFile(context.Background(), data, testCarFilename, root)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	extracted, err := extractFileFromCar(context.Background(), car, root, testCarFilename)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(data, extracted) {
+		t.Fatal("extracted file does not match the original data")
+	}
+
+	// The header has to name the root so IPFS nodes can pin it
+	header, err := readCarSection(bufio.NewReader(bytes.NewReader(car)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(header, encodeCarHeader(root)) || !bytes.Contains(header, root.Bytes()) {
+		t.Fatalf("expected a header with the root CID, got %x", header)
+	}
+
+	// Data that was compressed differently has a different root
+	if _, err := buildCarForFile(context.Background(), []byte("other data"), testCarFilename, root); err == nil {
+		t.Fatal("expected an error when the file doesn't match the expected root")
+	}
+}
+
+func TestKeepRewardsFileCar(t *testing.T) {
+	_, car := buildTestCar(t, []byte("rewards"), func(_ cid.Cid, blockData []byte) []byte {
+		return blockData
+	})
+
+	// An IPFS node that records the CAR data it's asked to import
+	var pinned []byte
+	ipfsNode := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v0/dag/import" {
+			http.NotFound(w, r)
+			return
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		pinned, _ = io.ReadAll(file)
+	}))
+	defer ipfsNode.Close()
+
+	// A plain HTTP download doesn't come with CAR data, so it's fetched from IPFS
+	gatewayFetches := 0
+	sources := []rewardsFileSource{
+		{name: "https://example.com/tree.json"},
+		{name: "https://gateway.example.com", ipfs: true, fetch: func(ctx context.Context) ([]byte, []byte, error) {
+			gatewayFetches++
+			return nil, car, nil
+		}},
+	}
+	carCachePath := filepath.Join(t.TempDir(), "tree.car")
+	keepRewardsFileCar(rewardsFileDownload{source: "https://example.com/tree.json"}, sources, carCachePath, ipfsNode.URL)
+
+	if gatewayFetches != 1 {
+		t.Fatalf("expected the CAR data to be fetched once, got %d", gatewayFetches)
+	}
+	cached, err := os.ReadFile(carCachePath)
+	if err != nil || !bytes.Equal(cached, car) {
+		t.Fatalf("expected the CAR data to be cached, got error %v", err)
+	}
+	if !bytes.Equal(pinned, car) {
+		t.Fatal("expected the CAR data to be pinned")
+	}
+
+	// Downloads that come with CAR data don't fetch it again, and files from the user's node aren't pinned on it
+	pinned = nil
+	if err := os.Remove(carCachePath); err != nil {
+		t.Fatal(err)
+	}
+	keepRewardsFileCar(rewardsFileDownload{source: ipfsNode.URL, carData: car}, sources, carCachePath, ipfsNode.URL)
+	if gatewayFetches != 1 || pinned != nil {
+		t.Fatalf("expected nothing to be fetched or pinned, got %d fetches", gatewayFetches)
+	}
+	if _, err := os.Stat(carCachePath); err != nil {
+		t.Fatalf("expected the CAR data to be cached, got %v", err)
+	}
+}
@@ -862,3 +862,103 @@ func TestMockWithdrawableEpoch(tt *testing.T) {
 		t.Fatalf("Node two minipool one consensus income does not match expected value: %s != %d", perfTwo.GetConsensusIncome().String(), 1000000000000000000)
 	}
 }
+
+func TestMockRollingRecordv10(tt *testing.T) {
+
+	history := test.NewDefaultMockHistory()
+	// Add a node which is earning some bonus commission
+	node := history.GetNewDefaultMockNode(&test.NewMockNodeParams{
+		SmoothingPool:     true,
+		EightEthMinipools: 1,
+		CollateralRpl:     5,
+	})
+	node.Minipools[0].NodeFee, _ = big.NewInt(0).SetString("50000000000000000", 10)
+	history.Nodes = append(history.Nodes, node)
+	state := history.GetEndNetworkState()
+
+	t := newV8Test(tt, state.NetworkDetails.RewardIndex)
+
+	t.bc.SetState(state)
+
+	consensusStartBlock := history.GetConsensusStartBlock()
+	executionStartBlock := history.GetExecutionStartBlock()
+	consensusEndBlock := history.GetConsensusEndBlock()
+	executionEndBlock := history.GetExecutionEndBlock()
+
+	logger := log.NewColorLogger(color.Faint)
+
+	t.rp.SetRewardSnapshotEvent(history.GetPreviousRewardSnapshotEvent())
+	t.bc.SetBeaconBlock(fmt.Sprint(consensusStartBlock-1), beacon.BeaconBlock{ExecutionBlockNumber: executionStartBlock - 1})
+	t.bc.SetBeaconBlock(fmt.Sprint(consensusStartBlock), beacon.BeaconBlock{ExecutionBlockNumber: executionStartBlock})
+	t.rp.SetHeaderByNumber(big.NewInt(int64(executionStartBlock)), &types.Header{Time: uint64(history.GetStartTime().Unix())})
+
+	for _, validator := range state.MinipoolValidatorDetails {
+		t.bc.SetMinipoolPerformance(validator.Index, make([]uint64, 0))
+	}
+
+	// Set some custom balances for the validators that opt in and out of smoothing pool
+	nodeSummary := history.GetNodeSummary()
+	customBalanceNodes := nodeSummary["single_eight_eth_opted_in_quarter"]
+	for _, node := range customBalanceNodes {
+		node.Minipools[0].SPWithdrawals = eth.EthToWei(0.75)
+	}
+	customBalanceNodes = nodeSummary["single_eight_eth_opted_out_three_quarters"]
+	for _, node := range customBalanceNodes {
+		node.Minipools[0].SPWithdrawals = eth.EthToWei(0.75)
+	}
+	customBalanceNodes = nodeSummary["single_bond_reduction"]
+	for _, node := range customBalanceNodes {
+		node.Minipools[0].SPWithdrawals = eth.EthToWei(0.5)
+	}
+
+	history.SetWithdrawals(t.bc)
+
+	// Record the first half of the interval, and make sure it survives a round trip through its serialized form
+	record := NewRollingRecord(&logger, t.Name(), t.bc, consensusStartBlock, &state.BeaconConfig, state.NetworkDetails.RewardIndex)
+	midEpoch := history.StartEpoch + (history.EndEpoch-history.StartEpoch)/2
+	err := record.UpdateToSlot(history.BeaconConfig.LastSlotOfEpoch(midEpoch), state)
+	t.failIf(err)
+	if record.GetNextEpoch() != midEpoch+1 {
+		t.Fatalf("Record's next epoch does not match expected value %d != %d", record.GetNextEpoch(), midEpoch+1)
+	}
+	bytes, err := record.Serialize()
+	t.failIf(err)
+	record, err = DeserializeRollingRecord(&logger, t.Name(), t.bc, &state.BeaconConfig, bytes)
+	t.failIf(err)
+
+	generatorv9v10 := newTreeGeneratorImpl_v9_v10(
+		10,
+		&logger,
+		t.Name()+"-rolling",
+		state.NetworkDetails.RewardIndex,
+		&SnapshotEnd{
+			Slot:           consensusEndBlock,
+			ConsensusBlock: consensusEndBlock,
+			ExecutionBlock: executionEndBlock,
+		},
+		&types.Header{
+			Number: big.NewInt(int64(history.GetExecutionEndBlock())),
+			Time:   assets.Mainnet20ELHeaderTime,
+		},
+		/* intervalsPassed= */ 1,
+		state,
+	)
+	generatorv9v10.setRollingRecord(record)
+
+	v10Artifacts, err := generatorv9v10.generateTree(
+		t.rp,
+		"mainnet",
+		make([]common.Address, 0),
+		t.bc,
+	)
+	t.failIf(err)
+
+	// The record must not change the result, so the root has to match the one from TestMockIntervalDefaultsTreegenv10
+	v10MerkleRoot := v10Artifacts.RewardsFile.GetMerkleRoot()
+	expectedMerkleRoot := "0x176bba15231cb82edb5c34c8882af09dfb77a2ee31a96b623bffd8e48cedf18b"
+	if !strings.EqualFold(v10MerkleRoot, expectedMerkleRoot) {
+		t.Fatalf("Merkle root does not match expected value %s != %s", v10MerkleRoot, expectedMerkleRoot)
+	} else {
+		t.Logf("Merkle root matches expected value %s", expectedMerkleRoot)
+	}
+}
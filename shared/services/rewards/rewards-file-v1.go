@@ -63,6 +63,16 @@ func (f *MinipoolPerformanceFile_v1) GetSmoothingPoolPerformance(minipoolAddress
 	return perf, exists
 }
 
+// Megapool validators weren't around for v1 files
+func (f *MinipoolPerformanceFile_v1) GetMegapoolValidatorPubkeys() ([]types.ValidatorPubkey, error) {
+	return []types.ValidatorPubkey{}, nil
+}
+
+// Megapool validators weren't around for v1 files
+func (f *MinipoolPerformanceFile_v1) GetMegapoolValidatorPerformance(pubkey types.ValidatorPubkey) (ISmoothingPoolMegapoolValidatorPerformance, bool) {
+	return nil, false
+}
+
 // Minipool stats
 type SmoothingPoolMinipoolPerformance_v1 struct {
 	Pubkey                  string   `json:"pubkey"`
@@ -26,6 +26,9 @@ type MinipoolPerformanceFile_v2 struct {
 	ExecutionEndBlock   uint64                                                  `json:"executionEndBlock,omitempty"`
 	MinipoolPerformance map[common.Address]*SmoothingPoolMinipoolPerformance_v2 `json:"minipoolPerformance"`
 	BonusScalar         *QuotedBigInt                                           `json:"bonusScalar,omitempty"`
+
+	// Megapool validators don't have their own address, so they're keyed by pubkey
+	MegapoolValidatorPerformance map[string]*SmoothingPoolMegapoolValidatorPerformance_v2 `json:"megapoolValidatorPerformance,omitempty"`
 }
 
 // Serialize a minipool performance file into bytes
@@ -65,6 +68,26 @@ func (f *MinipoolPerformanceFile_v2) GetSmoothingPoolPerformance(minipoolAddress
 	return perf, exists
 }
 
+// Get all of the megapool validator pubkeys with rewards in this file
+// NOTE: the order of pubkeys is not guaranteed to be stable, so don't rely on it
+func (f *MinipoolPerformanceFile_v2) GetMegapoolValidatorPubkeys() ([]types.ValidatorPubkey, error) {
+	pubkeys := make([]types.ValidatorPubkey, 0, len(f.MegapoolValidatorPerformance))
+	for key := range f.MegapoolValidatorPerformance {
+		pubkey, err := types.HexToValidatorPubkey(key)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing megapool validator pubkey %s: %w", key, err)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
+
+// Get a megapool validator's smoothing pool performance if it was present
+func (f *MinipoolPerformanceFile_v2) GetMegapoolValidatorPerformance(pubkey types.ValidatorPubkey) (ISmoothingPoolMegapoolValidatorPerformance, bool) {
+	perf, exists := f.MegapoolValidatorPerformance[pubkey.Hex()]
+	return perf, exists
+}
+
 // Minipool stats
 type SmoothingPoolMinipoolPerformance_v2 struct {
 	Pubkey                  string        `json:"pubkey"`
@@ -115,6 +138,20 @@ func (p *SmoothingPoolMinipoolPerformance_v2) GetAttestationScore() *big.Int {
 	return &p.AttestationScore.Int
 }
 
+// Megapool validator stats
+type SmoothingPoolMegapoolValidatorPerformance_v2 struct {
+	SmoothingPoolMinipoolPerformance_v2
+	MegapoolAddress common.Address `json:"megapoolAddress"`
+	ValidatorId     uint32         `json:"validatorId"`
+}
+
+func (p *SmoothingPoolMegapoolValidatorPerformance_v2) GetMegapoolAddress() common.Address {
+	return p.MegapoolAddress
+}
+func (p *SmoothingPoolMegapoolValidatorPerformance_v2) GetValidatorId() uint32 {
+	return p.ValidatorId
+}
+
 // Node operator rewards
 type NodeRewardsInfo_v2 struct {
 	RewardNetwork    uint64        `json:"rewardNetwork"`
@@ -0,0 +1,399 @@
+package rewards
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	recordsFilenameFormat  string = "%d-%d.json.zst"
+	recordsFilenamePattern string = "(?P<slot>\\d+)\\-(?P<epoch>\\d+)\\.json\\.zst"
+	checksumTableFilename  string = "checksums.sha384"
+)
+
+// Manager for saving rolling records to disk as checkpoints and loading them back
+type RollingRecordManager struct {
+	log                  *log.ColorLogger
+	logPrefix            string
+	bc                   RewardsBeaconClient
+	beaconCfg            beacon.Eth2Config
+	recordsPath          string
+	checkpointInterval   uint64
+	retentionLimit       uint64
+	compressor           *zstd.Encoder
+	decompressor         *zstd.Decoder
+	recordsFilenameRegex *regexp.Regexp
+}
+
+// Creates a new manager for rolling records
+func NewRollingRecordManager(log *log.ColorLogger, logPrefix string, bc RewardsBeaconClient, beaconCfg beacon.Eth2Config, recordsPath string, checkpointInterval uint64, retentionLimit uint64) (*RollingRecordManager, error) {
+	// Create the zstd compressor and decompressor
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd compressor for rolling record manager: %w", err)
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd decompressor for rolling record manager: %w", err)
+	}
+
+	// Make the records folder if it doesn't exist
+	fileInfo, err := os.Stat(recordsPath)
+	if os.IsNotExist(err) {
+		err = os.MkdirAll(recordsPath, 0755)
+		if err != nil {
+			return nil, fmt.Errorf("error creating rolling records folder: %w", err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("error checking rolling records folder: %w", err)
+	} else if !fileInfo.IsDir() {
+		return nil, fmt.Errorf("rolling records folder location exists (%s), but is not a folder", recordsPath)
+	}
+
+	if checkpointInterval == 0 {
+		checkpointInterval = 1
+	}
+	if retentionLimit == 0 {
+		retentionLimit = 1
+	}
+
+	return &RollingRecordManager{
+		log:                  log,
+		logPrefix:            logPrefix,
+		bc:                   bc,
+		beaconCfg:            beaconCfg,
+		recordsPath:          recordsPath,
+		checkpointInterval:   checkpointInterval,
+		retentionLimit:       retentionLimit,
+		compressor:           encoder,
+		decompressor:         decoder,
+		recordsFilenameRegex: regexp.MustCompile(recordsFilenamePattern),
+	}, nil
+}
+
+// Update the record to the provided slot, saving a checkpoint every time it crosses the checkpoint interval
+func (m *RollingRecordManager) UpdateRecord(record *RollingRecord, targetSlot uint64, state *state.NetworkState) error {
+	slotsPerEpoch := m.beaconCfg.SlotsPerEpoch
+	recordStartEpoch := record.StartSlot / slotsPerEpoch
+	startTime := time.Now()
+	for {
+		// Stop at the next checkpoint so it can be saved
+		nextEpoch := record.GetNextEpoch()
+		checkpointEpoch := nextEpoch + m.checkpointInterval - 1 - (nextEpoch-recordStartEpoch)%m.checkpointInterval
+		nextTargetSlot := (checkpointEpoch+1)*slotsPerEpoch - 1 // Target is the last slot of the epoch
+		isCheckpoint := true
+		if nextTargetSlot > targetSlot {
+			nextTargetSlot = targetSlot
+			isCheckpoint = false
+		}
+		if (nextTargetSlot+1)/slotsPerEpoch <= nextEpoch {
+			return nil
+		}
+
+		// Update the record to the target slot
+		err := record.UpdateToSlot(nextTargetSlot, state)
+		if err != nil {
+			return fmt.Errorf("error updating record to slot %d: %w", nextTargetSlot, err)
+		}
+		m.log.Printlnf("%s Updated record from epoch %d to epoch %d... (%s so far)", m.logPrefix, nextEpoch, record.LastDutiesSlot/slotsPerEpoch, time.Since(startTime))
+
+		// Save if required
+		if isCheckpoint {
+			err = m.SaveRecordToFile(record)
+			if err != nil {
+				return fmt.Errorf("error saving record: %w", err)
+			}
+			m.log.Printlnf("%s Saved record checkpoint.", m.logPrefix)
+		}
+	}
+}
+
+// Save the rolling record to a file and add it to the checksum table
+func (m *RollingRecordManager) SaveRecordToFile(record *RollingRecord) error {
+
+	// Serialize the record
+	bytes, err := record.Serialize()
+	if err != nil {
+		return fmt.Errorf("error saving rolling record: %w", err)
+	}
+
+	// Compress the record
+	compressedBytes := m.compressor.EncodeAll(bytes, make([]byte, 0, len(bytes)))
+
+	// Get the record filename
+	slot := record.LastDutiesSlot
+	epoch := record.LastDutiesSlot / m.beaconCfg.SlotsPerEpoch
+	filename := filepath.Join(m.recordsPath, fmt.Sprintf(recordsFilenameFormat, slot, epoch))
+
+	// Write it to a file
+	err = writeFileAtomically(filename, compressedBytes, 0664)
+	if err != nil {
+		return fmt.Errorf("error writing file [%s]: %w", filename, err)
+	}
+
+	// Compute the SHA384 hash to act as a checksum
+	checksum := sha512.Sum384(compressedBytes)
+
+	// Load the existing checksum table
+	_, lines, err := m.parseChecksumFile()
+	if err != nil {
+		return fmt.Errorf("error parsing checkpoint file: %w", err)
+	}
+
+	// Add the new record checksum, replacing any older record for the same slot
+	baseFilename := filepath.Base(filename)
+	checksumLine := fmt.Sprintf("%s  %s", hex.EncodeToString(checksum[:]), baseFilename)
+	newLines := make([]string, 0, len(lines)+1)
+	for _, line := range lines {
+		if !strings.HasSuffix(line, "  "+baseFilename) {
+			newLines = append(newLines, line)
+		}
+	}
+	lines = append(newLines, checksumLine)
+
+	// Sort the lines by their slot
+	err = m.sortChecksumEntries(lines)
+	if err != nil {
+		return fmt.Errorf("error sorting checkpoint file entries: %w", err)
+	}
+
+	// Remove old lines and delete the corresponding files that shouldn't be retained
+	if uint64(len(lines)) > m.retentionLimit {
+		cullCount := len(lines) - int(m.retentionLimit)
+		for _, line := range lines[:cullCount] {
+			_, filename, _, err := m.parseChecksumEntry(line)
+			if err != nil {
+				return err
+			}
+			fullFilename := filepath.Join(m.recordsPath, filename)
+
+			// Delete the file if it exists
+			err = os.Remove(fullFilename)
+			if os.IsNotExist(err) {
+				m.log.Printlnf("%s NOTE: tried removing checkpoint file [%s] based on the retention limit, but it didn't exist.", m.logPrefix, filename)
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("error deleting file [%s]: %w", fullFilename, err)
+			}
+
+			m.log.Printlnf("%s Removed checkpoint file [%s] based on the retention limit.", m.logPrefix, filename)
+		}
+		lines = lines[cullCount:]
+	}
+
+	// Save the new checksum table
+	checksumFilename := filepath.Join(m.recordsPath, checksumTableFilename)
+	err = writeFileAtomically(checksumFilename, []byte(strings.Join(lines, "\n")), 0644)
+	if err != nil {
+		return fmt.Errorf("error writing checksum file: %w", err)
+	}
+
+	return nil
+}
+
+// Load the most recent appropriate rolling record from disk, using the checksum table as an index.
+// If none of the saved records can be used, a new one is created for the provided start slot.
+func (m *RollingRecordManager) LoadBestRecordFromDisk(startSlot uint64, targetSlot uint64, rewardsInterval uint64) (*RollingRecord, error) {
+
+	// Parse the checksum file
+	exists, lines, err := m.parseChecksumFile()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing checkpoint file: %w", err)
+	}
+	if !exists {
+		// There isn't a checksum file so start over
+		m.log.Printlnf("%s Checksum file not found, creating a new record from the start.", m.logPrefix)
+		return NewRollingRecord(m.log, m.logPrefix, m.bc, startSlot, &m.beaconCfg, rewardsInterval), nil
+	}
+
+	// Iterate over each file, counting backwards from the bottom
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := lines[i]
+
+		// Extract the checksum, filename, and slot number
+		checksumString, filename, slot, err := m.parseChecksumEntry(line)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if the slot was too far into the future
+		if slot > targetSlot {
+			m.log.Printlnf("%s File [%s] was too far into the future, trying an older one...", m.logPrefix, filename)
+			continue
+		}
+
+		// Check if it was too far into the past
+		if slot < startSlot {
+			m.log.Printlnf("%s File [%s] was too old (generated before the target start slot), none of the remaining records can be used.", m.logPrefix, filename)
+			break
+		}
+
+		// Make sure the checksum parses properly
+		checksum, err := hex.DecodeString(checksumString)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning checkpoint line (%s): checksum (%s) could not be parsed", line, checksumString)
+		}
+
+		// Try to load it
+		fullFilename := filepath.Join(m.recordsPath, filename)
+		record, err := m.loadRecordFromFile(fullFilename, checksum)
+		if err != nil {
+			m.log.Printlnf("%s WARNING: error loading record from file [%s]: %s... attempting previous file", m.logPrefix, fullFilename, err.Error())
+			continue
+		}
+
+		// Check if it was for the proper interval
+		if record.RewardsInterval != rewardsInterval || record.StartSlot != startSlot {
+			m.log.Printlnf("%s File [%s] was for rewards interval %d (start slot %d) instead of %d (start slot %d) so it cannot be used, trying an earlier checkpoint.", m.logPrefix, filename, record.RewardsInterval, record.StartSlot, rewardsInterval, startSlot)
+			continue
+		}
+
+		epoch := slot / m.beaconCfg.SlotsPerEpoch
+		m.log.Printlnf("%s Loaded file [%s] which ended on slot %d (epoch %d) for rewards interval %d.", m.logPrefix, filename, slot, epoch, record.RewardsInterval)
+		return record, nil
+
+	}
+
+	// If we got here then none of the saved files worked so we have to make a new record
+	m.log.Printlnf("%s None of the saved record checkpoint files were eligible for use, creating a new record from the start.", m.logPrefix)
+	return NewRollingRecord(m.log, m.logPrefix, m.bc, startSlot, &m.beaconCfg, rewardsInterval), nil
+
+}
+
+// Get the slot number from a record filename
+func (m *RollingRecordManager) getSlotFromFilename(filename string) (uint64, error) {
+	matches := m.recordsFilenameRegex.FindStringSubmatch(filename)
+	if matches == nil {
+		return 0, fmt.Errorf("filename (%s) did not match the expected format", filename)
+	}
+	slotIndex := m.recordsFilenameRegex.SubexpIndex("slot")
+	if slotIndex == -1 {
+		return 0, fmt.Errorf("slot number not found in filename (%s)", filename)
+	}
+	slotString := matches[slotIndex]
+	slot, err := strconv.ParseUint(slotString, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("slot (%s) could not be parsed to a number", slotString)
+	}
+
+	return slot, nil
+}
+
+// Load a record from a file, making sure its contents match the provided checksum
+func (m *RollingRecordManager) loadRecordFromFile(filename string, expectedChecksum []byte) (*RollingRecord, error) {
+	// Read the file
+	compressedBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	// Calculate the hash and validate it
+	checksum := sha512.Sum384(compressedBytes)
+	if !bytes.Equal(expectedChecksum, checksum[:]) {
+		expectedString := hex.EncodeToString(expectedChecksum)
+		actualString := hex.EncodeToString(checksum[:])
+		return nil, fmt.Errorf("checksum mismatch (expected %s, but it was %s)", expectedString, actualString)
+	}
+
+	// Decompress it
+	bytes, err := m.decompressor.DecodeAll(compressedBytes, []byte{})
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing data: %w", err)
+	}
+
+	// Create a new record from the data
+	return DeserializeRollingRecord(m.log, m.logPrefix, m.bc, &m.beaconCfg, bytes)
+}
+
+// Get the lines from the checksum file
+func (m *RollingRecordManager) parseChecksumFile() (bool, []string, error) {
+	// Check if the file exists
+	checksumFilename := filepath.Join(m.recordsPath, checksumTableFilename)
+	_, err := os.Stat(checksumFilename)
+	if os.IsNotExist(err) {
+		return false, []string{}, nil
+	}
+
+	// Open the checksum file
+	checksumTable, err := os.ReadFile(checksumFilename)
+	if err != nil {
+		return false, nil, fmt.Errorf("error loading checksum table (%s): %w", checksumFilename, err)
+	}
+
+	// Parse out each line
+	originalLines := strings.Split(string(checksumTable), "\n")
+
+	// Remove empty lines
+	lines := make([]string, 0, len(originalLines))
+	for _, line := range originalLines {
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return true, lines, nil
+}
+
+// Sort the checksum file entries by their slot
+func (m *RollingRecordManager) sortChecksumEntries(lines []string) error {
+	var sortErr error
+	sort.Slice(lines, func(i int, j int) bool {
+		_, _, firstSlot, err := m.parseChecksumEntry(lines[i])
+		if err != nil && sortErr == nil {
+			sortErr = err
+			return false
+		}
+
+		_, _, secondSlot, err := m.parseChecksumEntry(lines[j])
+		if err != nil && sortErr == nil {
+			sortErr = err
+			return false
+		}
+
+		return firstSlot < secondSlot
+	})
+	return sortErr
+}
+
+// Get the checksum, the filename, and the slot number from a checksum entry
+func (m *RollingRecordManager) parseChecksumEntry(line string) (string, string, uint64, error) {
+	// Extract the checksum and filename
+	checksumString, filename, found := strings.Cut(line, "  ")
+	if !found {
+		return "", "", 0, fmt.Errorf("error parsing checkpoint line (%s): invalid format", line)
+	}
+
+	// Extract the slot number for this file
+	slot, err := m.getSlotFromFilename(filename)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("error scanning checkpoint line (%s): %w", line, err)
+	}
+
+	return checksumString, filename, slot, nil
+}
+
+// Write a file to a temporary location and move it into place, so readers never see a partially-written file
+func writeFileAtomically(filename string, data []byte, perm os.FileMode) error {
+	tempFilename := filename + ".tmp"
+	err := os.WriteFile(tempFilename, data, perm)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tempFilename, filename)
+}
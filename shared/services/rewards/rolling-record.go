@@ -0,0 +1,315 @@
+package rewards
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// Encoding for a validator's attestation duty in a single epoch of a rolling record.
+// A value of 0 means the validator didn't have a duty in that epoch.
+const (
+	// The slot of the duty, stored as its offset from the start of the epoch plus 1
+	rollingRecordDutySlotMask byte = 0x7f
+
+	// Set once the attestation for the duty has been included in a block
+	rollingRecordAttestedFlag byte = 0x80
+)
+
+// A withdrawal made by a validator tracked by a rolling record
+type RollingRecordWithdrawal struct {
+	Slot   uint64        `json:"slot"`
+	Amount *QuotedBigInt `json:"amount"`
+}
+
+// The attestation duties and withdrawals of a minipool validator in a rolling record
+type RollingRecordValidator struct {
+	Pubkey types.ValidatorPubkey `json:"pubkey"`
+
+	// One encoded duty for each epoch since the start of the record
+	Duties      []byte                    `json:"duties"`
+	Withdrawals []RollingRecordWithdrawal `json:"withdrawals"`
+}
+
+// The minipool validators assigned to a slot's committees, kept until their attestations can no longer be included
+type RollingRecordSlotDuties struct {
+	CommitteeSizes map[uint64]int            `json:"committeeSizes"`
+	Committees     map[uint64]map[int]string `json:"committees"`
+}
+
+// A record of the attestation duties, attestation performance, and withdrawals of every minipool validator during a rewards interval.
+// It's built up as the interval progresses so tree generation only has to process the epochs after the record's last update.
+// Nothing in the record depends on the network state, so eligibility rules are applied when the tree is generated.
+type RollingRecord struct {
+	RewardsInterval  uint64                              `json:"rewardsInterval"`
+	StartSlot        uint64                              `json:"startSlot"`
+	LastDutiesSlot   uint64                              `json:"lastDutiesSlot"`
+	SmartnodeVersion string                              `json:"smartnodeVersion"`
+	Validators       map[string]*RollingRecordValidator  `json:"validators"`
+	PendingDuties    map[uint64]*RollingRecordSlotDuties `json:"pendingDuties"`
+
+	log          *log.ColorLogger
+	logPrefix    string
+	bc           RewardsBeaconClient
+	beaconConfig *beacon.Eth2Config
+}
+
+// Create a new rolling record for the rewards interval that starts at the provided slot
+func NewRollingRecord(log *log.ColorLogger, logPrefix string, bc RewardsBeaconClient, startSlot uint64, beaconConfig *beacon.Eth2Config, rewardsInterval uint64) *RollingRecord {
+	return &RollingRecord{
+		RewardsInterval:  rewardsInterval,
+		StartSlot:        startSlot,
+		SmartnodeVersion: shared.RocketPoolVersion(),
+		Validators:       map[string]*RollingRecordValidator{},
+		PendingDuties:    map[uint64]*RollingRecordSlotDuties{},
+
+		log:          log,
+		logPrefix:    logPrefix,
+		bc:           bc,
+		beaconConfig: beaconConfig,
+	}
+}
+
+// Load a rolling record from its serialized form
+func DeserializeRollingRecord(log *log.ColorLogger, logPrefix string, bc RewardsBeaconClient, beaconConfig *beacon.Eth2Config, bytes []byte) (*RollingRecord, error) {
+	record := &RollingRecord{
+		log:          log,
+		logPrefix:    logPrefix,
+		bc:           bc,
+		beaconConfig: beaconConfig,
+	}
+	err := json.Unmarshal(bytes, record)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing record: %w", err)
+	}
+	if record.Validators == nil {
+		record.Validators = map[string]*RollingRecordValidator{}
+	}
+	if record.PendingDuties == nil {
+		record.PendingDuties = map[uint64]*RollingRecordSlotDuties{}
+	}
+	return record, nil
+}
+
+// Serialize the rolling record
+func (r *RollingRecord) Serialize() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// Get the first epoch that hasn't been processed yet
+func (r *RollingRecord) GetNextEpoch() uint64 {
+	if r.LastDutiesSlot == 0 {
+		return r.StartSlot / r.beaconConfig.SlotsPerEpoch
+	}
+	return r.LastDutiesSlot/r.beaconConfig.SlotsPerEpoch + 1
+}
+
+// Update the record with every complete epoch up to the provided slot.
+// The state is only used to find the validator indices of the network's minipools.
+func (r *RollingRecord) UpdateToSlot(slot uint64, state *state.NetworkState) error {
+	slotsPerEpoch := r.beaconConfig.SlotsPerEpoch
+	if slotsPerEpoch > uint64(rollingRecordDutySlotMask) {
+		return fmt.Errorf("rolling records don't support %d slots per epoch", slotsPerEpoch)
+	}
+
+	// Only whole epochs can be recorded
+	startEpoch := r.GetNextEpoch()
+	endEpoch := (slot+1)/slotsPerEpoch - 1
+	if (slot+1)/slotsPerEpoch <= startEpoch {
+		return nil
+	}
+
+	r.updateValidators(state)
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		err := r.processEpoch(epoch)
+		if err != nil {
+			return fmt.Errorf("error processing epoch %d: %w", epoch, err)
+		}
+		r.LastDutiesSlot = (epoch+1)*slotsPerEpoch - 1
+	}
+
+	return nil
+}
+
+// Start tracking any minipool validators that have been assigned an index since the last update
+func (r *RollingRecord) updateValidators(state *state.NetworkState) {
+	for _, mpd := range state.MinipoolDetails {
+		status, exists := state.MinipoolValidatorDetails[mpd.Pubkey]
+		if !exists || !status.Exists || status.Index == "" {
+			continue
+		}
+		if _, exists := r.Validators[status.Index]; exists {
+			continue
+		}
+		r.Validators[status.Index] = &RollingRecordValidator{
+			Pubkey:      mpd.Pubkey,
+			Duties:      []byte{},
+			Withdrawals: []RollingRecordWithdrawal{},
+		}
+	}
+}
+
+// Record the duties, attestations, and withdrawals of the tracked validators for an epoch
+func (r *RollingRecord) processEpoch(epoch uint64) error {
+
+	// Get the committee info and blocks for this epoch
+	slotsPerEpoch := r.beaconConfig.SlotsPerEpoch
+	var committeeData beacon.Committees
+	blocks := make([]beacon.BeaconBlock, slotsPerEpoch)
+	var wg errgroup.Group
+	wg.Go(func() error {
+		var err error
+		committeeData, err = r.bc.GetCommitteesForEpoch(&epoch)
+		return err
+	})
+	for i := uint64(0); i < slotsPerEpoch; i++ {
+		i := i
+		slot := epoch*slotsPerEpoch + i
+		wg.Go(func() error {
+			beaconBlock, found, err := r.bc.GetBeaconBlock(fmt.Sprint(slot))
+			if err != nil {
+				return err
+			}
+			if found {
+				blocks[i] = beaconBlock
+			}
+			return nil
+		})
+	}
+	err := wg.Wait()
+	// Return preallocated memory to the pool if it exists
+	if committeeData != nil {
+		defer committeeData.Release()
+	}
+	if err != nil {
+		return fmt.Errorf("error getting committee and attestation records: %w", err)
+	}
+
+	// Record the duties of the tracked validators
+	epochIndex := epoch - r.StartSlot/slotsPerEpoch
+	for idx := 0; idx < committeeData.Count(); idx++ {
+		slotIndex := committeeData.Slot(idx)
+		committeeIndex := committeeData.Index(idx)
+		positions := map[int]string{}
+		for position, validatorIndex := range committeeData.Validators(idx) {
+			validator, exists := r.Validators[validatorIndex]
+			if !exists {
+				continue
+			}
+			validator.setDuty(epochIndex, byte(slotIndex-epoch*slotsPerEpoch)+1)
+			positions[position] = validatorIndex
+		}
+		if len(positions) == 0 {
+			continue
+		}
+
+		slotDuties, exists := r.PendingDuties[slotIndex]
+		if !exists {
+			slotDuties = &RollingRecordSlotDuties{
+				CommitteeSizes: map[uint64]int{},
+				Committees:     map[uint64]map[int]string{},
+			}
+			r.PendingDuties[slotIndex] = slotDuties
+		}
+		slotDuties.Committees[committeeIndex] = positions
+	}
+
+	// Committee sizes are needed to find validators in aggregation bits, even for committees without any tracked validators
+	for idx := 0; idx < committeeData.Count(); idx++ {
+		slotDuties, exists := r.PendingDuties[committeeData.Slot(idx)]
+		if exists {
+			slotDuties.CommitteeSizes[committeeData.Index(idx)] = committeeData.ValidatorCount(idx)
+		}
+	}
+
+	// Check the attestations and withdrawals in each block
+	for i, block := range blocks {
+		inclusionSlot := epoch*slotsPerEpoch + uint64(i)
+		r.checkAttestations(block.Attestations, inclusionSlot)
+
+		for _, withdrawal := range block.Withdrawals {
+			validator, exists := r.Validators[withdrawal.ValidatorIndex]
+			if !exists {
+				continue
+			}
+			validator.Withdrawals = append(validator.Withdrawals, RollingRecordWithdrawal{
+				Slot:   inclusionSlot,
+				Amount: QuotedBigIntFromBigInt(withdrawal.Amount),
+			})
+		}
+	}
+
+	// Duties from earlier epochs can't be included in any later blocks
+	for slot := range r.PendingDuties {
+		if slot < epoch*slotsPerEpoch {
+			delete(r.PendingDuties, slot)
+		}
+	}
+
+	return nil
+
+}
+
+// Mark the duties of any tracked validators that participated in the provided attestations as completed
+func (r *RollingRecord) checkAttestations(attestations []beacon.AttestationInfo, inclusionSlot uint64) {
+	slotsPerEpoch := r.beaconConfig.SlotsPerEpoch
+	startEpoch := r.StartSlot / slotsPerEpoch
+	for _, attestation := range attestations {
+		slotDuties, exists := r.PendingDuties[attestation.SlotIndex]
+		if !exists {
+			continue
+		}
+		// Ignore attestations delayed by more than 32 slots
+		if inclusionSlot-attestation.SlotIndex > slotsPerEpoch {
+			continue
+		}
+
+		for _, committeeIndex := range attestation.CommitteeIndices() {
+			positions, exists := slotDuties.Committees[uint64(committeeIndex)]
+			if !exists {
+				continue
+			}
+			for position, validatorIndex := range positions {
+				if !attestation.ValidatorAttested(committeeIndex, position, slotDuties.CommitteeSizes) {
+					continue
+				}
+				delete(positions, position)
+				r.Validators[validatorIndex].setAttested(attestation.SlotIndex/slotsPerEpoch - startEpoch)
+			}
+		}
+	}
+}
+
+// Set the duty for an epoch, growing the list of duties if required
+func (v *RollingRecordValidator) setDuty(epochIndex uint64, duty byte) {
+	for uint64(len(v.Duties)) <= epochIndex {
+		v.Duties = append(v.Duties, 0)
+	}
+	v.Duties[epochIndex] = duty
+}
+
+// Mark the duty for an epoch as attested
+func (v *RollingRecordValidator) setAttested(epochIndex uint64) {
+	if epochIndex < uint64(len(v.Duties)) {
+		v.Duties[epochIndex] |= rollingRecordAttestedFlag
+	}
+}
+
+// Get the duty for an epoch, returning whether there was one, its offset from the start of the epoch, and whether or not it was attested
+func (v *RollingRecordValidator) getDuty(epochIndex uint64) (bool, uint64, bool) {
+	if epochIndex >= uint64(len(v.Duties)) {
+		return false, 0, false
+	}
+	duty := v.Duties[epochIndex]
+	offset := duty & rollingRecordDutySlotMask
+	if offset == 0 {
+		return false, 0, false
+	}
+	return true, uint64(offset) - 1, duty&rollingRecordAttestedFlag != 0
+}
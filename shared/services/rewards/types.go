@@ -72,6 +72,13 @@ type IMinipoolPerformanceFile interface {
 
 	// Get a minipool's smoothing pool performance if it was present
 	GetSmoothingPoolPerformance(minipoolAddress common.Address) (ISmoothingPoolMinipoolPerformance, bool)
+
+	// Get all of the megapool validator pubkeys with rewards in this file
+	// NOTE: the order of pubkeys is not guaranteed to be stable, so don't rely on it
+	GetMegapoolValidatorPubkeys() ([]types.ValidatorPubkey, error)
+
+	// Get a megapool validator's smoothing pool performance if it was present
+	GetMegapoolValidatorPerformance(pubkey types.ValidatorPubkey) (ISmoothingPoolMegapoolValidatorPerformance, bool)
 }
 
 // Interface for version-agnostic rewards files
@@ -156,6 +163,13 @@ type ISmoothingPoolMinipoolPerformance interface {
 	GetAttestationScore() *big.Int
 }
 
+// Megapool validator stats
+type ISmoothingPoolMegapoolValidatorPerformance interface {
+	ISmoothingPoolMinipoolPerformance
+	GetMegapoolAddress() common.Address
+	GetValidatorId() uint32
+}
+
 // Small struct to test version information for rewards files during deserialization
 type VersionHeader struct {
 	RewardsFileVersion uint64 `json:"rewardsFileVersion,omitempty"`
@@ -1,6 +1,7 @@
 package rewards
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -15,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/goccy/go-json"
+	"github.com/ipfs/go-cid"
 	"github.com/klauspost/compress/zstd"
 	"github.com/mitchellh/go-homedir"
 	"github.com/rocket-pool/smartnode/bindings/rewards"
@@ -25,6 +27,14 @@ import (
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 )
 
+const (
+	// How long to wait for a rewards file to download from any of its sources
+	rewardsFileDownloadTimeout time.Duration = 2 * time.Minute
+
+	// How long to wait for a user's IPFS node to pin a downloaded rewards file
+	rewardsFilePinTimeout time.Duration = 30 * time.Second
+)
+
 // Simple container for the zero value so it doesn't have to be recreated over and over
 var zero *big.Int
 
@@ -151,26 +161,195 @@ func GetIntervalInfo(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, no
 	return
 }
 
-// Downloads the rewards file for this interval
+// A source of a rewards file
+type rewardsFileSource struct {
+	name string
+
+	// Whether the source is IPFS, so it always provides the CAR data
+	ipfs bool
+
+	// Gets the uncompressed rewards file, along with its CAR data if it came from IPFS or its DAG could be rebuilt
+	fetch func(ctx context.Context) ([]byte, []byte, error)
+}
+
+// The result of downloading a rewards file from one of its sources
+type rewardsFileDownload struct {
+	source  string
+	file    IRewardsFile
+	carData []byte
+	err     error
+}
+
+// Downloads the rewards file for this interval.
+// Every source is tried at the same time, and the first file that matches the canonical Merkle root is saved.
+// Files from IPFS are verified against the interval's CID before they're decompressed. Whichever source wins,
+// the file's CAR data is cached locally and pinned on the user's IPFS node if they have one.
 func (i *IntervalInfo) DownloadRewardsFile(cfg *config.RocketPoolConfig, isDaemon bool) error {
 	interval := i.Index
-	expectedCid := i.CID
 	expectedRoot := i.MerkleRoot
+
 	// Determine file name and path
 	rewardsTreePath, err := homedir.Expand(cfg.Smartnode.GetRewardsTreePath(interval, isDaemon, config.RewardsExtensionJSON))
 	if err != nil {
 		return fmt.Errorf("error expanding rewards tree path: %w", err)
 	}
-	rewardsTreeFilename := filepath.Base(rewardsTreePath)
+	carCachePath, err := homedir.Expand(cfg.Smartnode.GetRewardsTreePath(interval, isDaemon, config.RewardsExtensionCAR))
+	if err != nil {
+		return fmt.Errorf("error expanding rewards tree CAR path: %w", err)
+	}
+	expectedCid, err := cid.Decode(i.CID)
+	if err != nil {
+		return fmt.Errorf("error parsing CID %s for interval %d: %w", i.CID, interval, err)
+	}
+	ipfsApiUrl := strings.TrimSpace(cfg.Smartnode.IpfsApiUrl.Value.(string))
+
+	// Race the sources
+	sources := getRewardsFileSources(cfg, expectedCid, filepath.Base(rewardsTreePath), carCachePath, ipfsApiUrl)
+	ctx, cancel := context.WithTimeout(context.Background(), rewardsFileDownloadTimeout)
+	defer cancel()
+	results := make(chan rewardsFileDownload, len(sources))
+	for _, source := range sources {
+		go func() {
+			results <- downloadRewardsFileFromSource(ctx, source, expectedRoot)
+		}()
+	}
+
+	errBuilder := strings.Builder{}
+	for range sources {
+		result := <-results
+		if result.err != nil {
+			errBuilder.WriteString(fmt.Sprintf("Downloading from %s failed (%s)\n", result.source, result.err.Error()))
+			continue
+		}
+
+		// Stop the other downloads
+		cancel()
+
+		// Serialize again so we're sure to have all the correct proofs that we've generated (instead of verifying every proof on the file)
+		localRewardsFile := NewLocalFile[IRewardsFile](
+			result.file,
+			rewardsTreePath,
+		)
+		_, err = localRewardsFile.Write()
+		if err != nil {
+			return fmt.Errorf("error saving interval %d file to %s: %w", interval, rewardsTreePath, err)
+		}
+
+		// Keep the CAR data so the tree can be shared
+		keepRewardsFileCar(result, sources, carCachePath, ipfsApiUrl)
+		return nil
+	}
+	return errors.New(errBuilder.String())
+}
+
+// Cache a downloaded rewards file's CAR data and pin it on the user's IPFS node if they have one.
+// Files from plain HTTP sources only come with it if their DAG could be rebuilt, so it's fetched from IPFS otherwise.
+// This is best-effort, since the tree itself was already saved.
+func keepRewardsFileCar(result rewardsFileDownload, sources []rewardsFileSource, carCachePath string, ipfsApiUrl string) {
+	_, err := os.Stat(carCachePath)
+	needsCache := os.IsNotExist(err)
+	needsPin := ipfsApiUrl != "" && result.source != ipfsApiUrl
+	if !needsCache && !needsPin {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rewardsFilePinTimeout)
+	defer cancel()
+	carData := result.carData
+	for _, source := range sources {
+		if carData != nil {
+			break
+		}
+		if source.ipfs {
+			_, carData, _ = source.fetch(ctx)
+		}
+	}
+	if carData == nil {
+		return
+	}
+
+	if needsCache {
+		_ = os.WriteFile(carCachePath, carData, 0644)
+	}
+	if needsPin {
+		_ = pinCarOnIpfsNode(ctx, ipfsApiUrl, carData)
+	}
+}
+
+// Get every source a rewards file can be downloaded from
+func getRewardsFileSources(cfg *config.RocketPoolConfig, expectedCid cid.Cid, rewardsTreeFilename string, carCachePath string, ipfsApiUrl string) []rewardsFileSource {
 	ipfsFilename := rewardsTreeFilename + config.RewardsTreeIpfsExtension
+	sources := []rewardsFileSource{}
+
+	// Reads a rewards file out of verified CAR data
+	fromCar := func(ctx context.Context, carData []byte) ([]byte, []byte, error) {
+		compressedBytes, err := extractFileFromCar(ctx, carData, expectedCid, ipfsFilename)
+		if err != nil {
+			return nil, nil, err
+		}
+		fileBytes, err := decompressFile(compressedBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return fileBytes, carData, nil
+	}
 
-	// Create URL list
+	// The local CAR cache, in case the tree file was removed
+	if _, err := os.Stat(carCachePath); err == nil {
+		sources = append(sources, rewardsFileSource{
+			name: carCachePath,
+			ipfs: true,
+			fetch: func(ctx context.Context) ([]byte, []byte, error) {
+				carData, err := os.ReadFile(carCachePath)
+				if err != nil {
+					return nil, nil, err
+				}
+				return fromCar(ctx, carData)
+			},
+		})
+	}
+
+	// The user's IPFS node
+	if ipfsApiUrl != "" {
+		sources = append(sources, rewardsFileSource{
+			name: ipfsApiUrl,
+			ipfs: true,
+			fetch: func(ctx context.Context) ([]byte, []byte, error) {
+				carData, err := downloadCarFromIpfsNode(ctx, ipfsApiUrl, expectedCid)
+				if err != nil {
+					return nil, nil, err
+				}
+				return fromCar(ctx, carData)
+			},
+		})
+	}
+
+	// Trustless gateways
+	gatewayUrls := append([]string{}, config.DefaultTrustlessGatewayUrls...)
+	for gatewayUrl := range strings.SplitSeq(cfg.Smartnode.RewardsTreeIpfsGateways.Value.(string), ";") {
+		gatewayUrl = strings.TrimSpace(gatewayUrl)
+		if gatewayUrl != "" {
+			gatewayUrls = append(gatewayUrls, gatewayUrl)
+		}
+	}
+	for _, gatewayUrl := range gatewayUrls {
+		sources = append(sources, rewardsFileSource{
+			name: gatewayUrl,
+			ipfs: true,
+			fetch: func(ctx context.Context) ([]byte, []byte, error) {
+				carData, err := downloadCarFromGateway(ctx, gatewayUrl, expectedCid, ipfsFilename)
+				if err != nil {
+					return nil, nil, err
+				}
+				return fromCar(ctx, carData)
+			},
+		})
+	}
+
+	// Plain HTTP sources, which are only verified by the Merkle root
 	urls := []string{
-		fmt.Sprintf(config.PrimaryRewardsFileUrl, expectedCid, ipfsFilename),
-		fmt.Sprintf(config.SecondaryRewardsFileUrl, expectedCid, ipfsFilename),
 		fmt.Sprintf(config.GithubRewardsFileUrl, string(cfg.Smartnode.Network.Value.(cfgtypes.Network)), rewardsTreeFilename),
 	}
-
 	rewardsTreeCustomUrl := cfg.Smartnode.RewardsTreeCustomUrl.Value.(string)
 	rewardsTreeCustomUrl = strings.TrimSpace(rewardsTreeCustomUrl)
 	if len(rewardsTreeCustomUrl) != 0 {
@@ -180,87 +359,98 @@ func (i *IntervalInfo) DownloadRewardsFile(cfg *config.RocketPoolConfig, isDaemo
 			urls = append(urls, fmt.Sprintf(customUrl, rewardsTreeFilename))
 		}
 	}
-
-	// Attempt downloads
-	errBuilder := strings.Builder{}
-	// ipfs http services are very unreliable and like to hold the connection open for several
-	// minutes before returning a 504. Force a short timeout, but if all sources fail,
-	// gradually increase the timeout to be unreasonably long.
-	for _, timeout := range []time.Duration{200 * time.Millisecond, 2 * time.Second, 60 * time.Second} {
-		client := http.Client{
-			Timeout: timeout,
-		}
-		for _, url := range urls {
-			resp, err := client.Get(url)
-			if err != nil {
-				errBuilder.WriteString(fmt.Sprintf("Downloading %s failed (%s)\n", url, err.Error()))
-				continue
-			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode != http.StatusOK {
-				errBuilder.WriteString(fmt.Sprintf("Downloading %s failed with status %s\n", url, resp.Status))
-				continue
-			}
-			// If we got here, we have a successful download
-			bytes, err := io.ReadAll(resp.Body)
-			if err != nil {
-				errBuilder.WriteString(fmt.Sprintf("Error reading response bytes from %s: %s\n", url, err.Error()))
-				continue
-			}
-			writeBytes := bytes
-			if strings.HasSuffix(url, config.RewardsTreeIpfsExtension) {
-				// Decompress it
-				writeBytes, err = decompressFile(bytes)
+	for _, url := range urls {
+		sources = append(sources, rewardsFileSource{
+			name: url,
+			fetch: func(ctx context.Context) ([]byte, []byte, error) {
+				fileBytes, err := downloadFile(ctx, url)
 				if err != nil {
-					errBuilder.WriteString(fmt.Sprintf("Error decompressing %s: %s\n", url, err.Error()))
-					continue
+					return nil, nil, err
 				}
-			}
 
-			deserializedRewardsFile, err := DeserializeRewardsFile(writeBytes)
-			if err != nil {
-				return fmt.Errorf("Error deserializing file %s: %w", rewardsTreePath, err)
-			}
-
-			// Get the original merkle root
-			downloadedRoot := deserializedRewardsFile.GetMerkleRoot()
+				// Rebuild the uploaded DAG if possible, so the file can be cached and pinned like one from IPFS
+				var compressedBytes []byte
+				if strings.HasSuffix(url, config.RewardsTreeIpfsExtension) {
+					compressedBytes = fileBytes
+					fileBytes, err = decompressFile(fileBytes)
+					if err != nil {
+						return nil, nil, err
+					}
+				} else {
+					encoder, _ := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+					compressedBytes = encoder.EncodeAll(fileBytes, make([]byte, 0, len(fileBytes)))
+				}
+				carData, _ := buildCarForFile(ctx, compressedBytes, ipfsFilename, expectedCid)
+				return fileBytes, carData, nil
+			},
+		})
+	}
 
-			// Reconstruct the merkle tree from the file data, this should overwrite the stored Merkle Root with a new one
-			deserializedRewardsFile.GenerateMerkleTree()
+	return sources
+}
 
-			// Get the resulting merkle root
-			calculatedRoot := deserializedRewardsFile.GetMerkleRoot()
+// Downloads a rewards file from one source and makes sure it matches the canonical Merkle root
+func downloadRewardsFileFromSource(ctx context.Context, source rewardsFileSource, expectedRoot common.Hash) rewardsFileDownload {
+	result := rewardsFileDownload{
+		source: source.name,
+	}
+	fileBytes, carData, err := source.fetch(ctx)
+	if err != nil {
+		result.err = err
+		return result
+	}
 
-			// Compare the merkle roots to see if the original is correct
-			if !strings.EqualFold(downloadedRoot, calculatedRoot) {
-				return fmt.Errorf("the merkle root from %s does not match the root generated by its tree data (had %s, but expected %s)", url, downloadedRoot, calculatedRoot)
-			}
+	deserializedRewardsFile, err := DeserializeRewardsFile(fileBytes)
+	if err != nil {
+		result.err = fmt.Errorf("error deserializing file: %w", err)
+		return result
+	}
 
-			// Make sure the calculated root matches the canonical one
-			if !strings.EqualFold(calculatedRoot, expectedRoot.Hex()) {
-				return fmt.Errorf("the merkle root from %s does not match the canonical one (had %s, but expected %s)", url, calculatedRoot, expectedRoot.Hex())
-			}
+	// Get the original merkle root
+	downloadedRoot := deserializedRewardsFile.GetMerkleRoot()
 
-			// Serialize again so we're sure to have all the correct proofs that we've generated (instead of verifying every proof on the file)
-			localRewardsFile := NewLocalFile[IRewardsFile](
-				deserializedRewardsFile,
-				rewardsTreePath,
-			)
-			_, err = localRewardsFile.Write()
-			if err != nil {
-				return fmt.Errorf("error saving interval %d file to %s: %w", interval, rewardsTreePath, err)
-			}
+	// Reconstruct the merkle tree from the file data, this should overwrite the stored Merkle Root with a new one
+	deserializedRewardsFile.GenerateMerkleTree()
 
-			return nil
+	// Get the resulting merkle root
+	calculatedRoot := deserializedRewardsFile.GetMerkleRoot()
 
-		}
+	// Compare the merkle roots to see if the original is correct
+	if !strings.EqualFold(downloadedRoot, calculatedRoot) {
+		result.err = fmt.Errorf("the merkle root does not match the root generated by its tree data (had %s, but expected %s)", downloadedRoot, calculatedRoot)
+		return result
+	}
 
-		errBuilder.WriteString(fmt.Sprintf("Downloading files with timeout %v failed.\n", timeout))
+	// Make sure the calculated root matches the canonical one
+	if !strings.EqualFold(calculatedRoot, expectedRoot.Hex()) {
+		result.err = fmt.Errorf("the merkle root does not match the canonical one (had %s, but expected %s)", calculatedRoot, expectedRoot.Hex())
+		return result
 	}
 
-	return errors.New(errBuilder.String())
+	result.file = deserializedRewardsFile
+	result.carData = carData
+	return result
+}
 
+// Downloads a file over HTTP
+func downloadFile(ctx context.Context, url string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status %s", resp.Status)
+	}
+	bytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response bytes: %w", err)
+	}
+	return bytes, nil
 }
 
 // Gets the start slot for the given interval
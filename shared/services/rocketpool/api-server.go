@@ -0,0 +1,148 @@
+package rocketpool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const (
+	// The host used in request URLs for the API server's unix socket
+	apiServerSocketHost string = "rocketpool-api"
+
+	// How long to wait for the API server to accept a connection before giving up
+	apiServerDialTimeout time.Duration = 5 * time.Second
+)
+
+// API commands that aren't part of a command group
+var topLevelApiCommands = map[string]bool{
+	"wait": true,
+}
+
+// Get the location of the API server, or an empty string if API commands should be run with the daemon binary instead
+func (c *Client) getApiServerAddress() (string, error) {
+	// An explicit server always wins
+	if c.apiServer != "" {
+		return c.apiServer, nil
+	}
+
+	// Native mode and remote sessions don't use the API container
+	if c.daemonPath != "" || c.client != nil {
+		return "", nil
+	}
+
+	cfg, isNew, err := c.LoadConfig()
+	if err != nil {
+		return "", err
+	}
+	if isNew || cfg.Smartnode.EnableApiServer.Value != true {
+		return "", nil
+	}
+
+	// Fall back to docker exec if the API container hasn't created its socket yet
+	socketPath := filepath.Join(c.configPath, config.ApiSocketFilename)
+	if _, err := os.Stat(socketPath); err != nil {
+		return "", nil
+	}
+	return socketPath, nil
+}
+
+// Call the Rocket Pool API server
+func (c *Client) callAPIServer(address string, args string, otherArgs ...string) ([]byte, error) {
+	// The leading args name the command's group and the command itself, and the rest are passed to it
+	fields := strings.Fields(args)
+	routeLength := 2
+	if len(fields) > 0 && topLevelApiCommands[fields[0]] {
+		routeLength = 1
+	}
+	if len(fields) < routeLength {
+		return nil, fmt.Errorf("Invalid API command [%s]", args)
+	}
+	route := fields[:routeLength]
+	fields = fields[routeLength:]
+	request := api.ApiServerRequest{
+		Args:            append(fields, otherArgs...),
+		MaxFee:          c.maxFee,
+		MaxPrioFee:      c.maxPrioFee,
+		GasLimit:        c.gasLimit,
+		OfflineTx:       c.offlineTx,
+		Node:            c.node,
+		IgnoreSyncCheck: c.ignoreSyncCheck,
+		ForceFallbacks:  c.forceFallbacks,
+	}
+	if c.customNonce != nil {
+		request.Nonce = c.customNonce.String()
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("Error encoding API server request: %w", err)
+	}
+
+	// Build the HTTP client
+	httpClient := http.DefaultClient
+	baseUrl := strings.TrimSuffix(address, "/")
+	if !strings.HasPrefix(address, "http://") && !strings.HasPrefix(address, "https://") {
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					dialer := net.Dialer{Timeout: apiServerDialTimeout}
+					return dialer.DialContext(ctx, "unix", address)
+				},
+			},
+		}
+		baseUrl = "http://" + apiServerSocketHost
+	}
+	url := fmt.Sprintf("%s%s/%s", baseUrl, api.ApiServerRoutePrefix, strings.Join(route, "/"))
+
+	if c.debugPrint {
+		fmt.Println("To API server:")
+		fmt.Printf("%s %s\n", url, string(body))
+	}
+
+	// Run the request
+	output, err := func() ([]byte, error) {
+		response, err := httpClient.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("Error calling the API server: %w", err)
+		}
+		defer response.Body.Close()
+		output, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading the API server response: %w", err)
+		}
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("API server returned status %d: %s", response.StatusCode, strings.TrimSpace(string(output)))
+		}
+		return output, nil
+	}()
+
+	if c.debugPrint {
+		if output != nil {
+			fmt.Println("API Out:")
+			fmt.Println(string(output))
+		}
+		if err != nil {
+			fmt.Println("API Err:")
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Reset the gas settings after the call
+	c.maxFee = c.originalMaxFee
+	c.maxPrioFee = c.originalMaxPrioFee
+	c.gasLimit = c.originalGasLimit
+
+	return output, err
+}
@@ -10,6 +10,10 @@ import (
 
 // Wait for a transaction
 func (c *Client) WaitForTransaction(txHash common.Hash) (api.APIResponse, error) {
+	// Exported transactions won't be mined until they're signed and broadcast
+	if c.offlineTx {
+		return api.APIResponse{Status: "success"}, nil
+	}
 	responseBytes, err := c.callAPI(fmt.Sprintf("wait %s", txHash.String()))
 	if err != nil {
 		return api.APIResponse{}, fmt.Errorf("Error waiting for tx: %w", err)
@@ -0,0 +1,100 @@
+#!/bin/sh
+# This script exports or imports the EIP-3076 slashing protection database of Rocket Pool's validator client; only edit if you know what you're doing ;)
+# Usage: slashing-protection.sh <export|import> <interchange file>
+
+ACTION=$1
+INTERCHANGE_FILE=$2
+
+if [ "$ACTION" != "export" ] && [ "$ACTION" != "import" ]; then
+    echo "Unknown action [$ACTION], expected export or import"
+    exit 1
+fi
+if [ -z "$INTERCHANGE_FILE" ]; then
+    echo "No interchange file provided"
+    exit 1
+fi
+
+# Set up the network-based flags
+if [ "$NETWORK" = "mainnet" ]; then
+    LH_NETWORK="mainnet"
+    LODESTAR_NETWORK="mainnet"
+    PRYSM_NETWORK="--mainnet"
+elif [ "$NETWORK" = "devnet" ]; then
+    LH_NETWORK="hoodi"
+    LODESTAR_NETWORK="hoodi"
+    PRYSM_NETWORK="--hoodi"
+elif [ "$NETWORK" = "testnet" ]; then
+    LH_NETWORK="hoodi"
+    LODESTAR_NETWORK="hoodi"
+    PRYSM_NETWORK="--hoodi"
+else
+    echo "Unknown network [$NETWORK]"
+    exit 1
+fi
+
+
+# Lighthouse
+if [ "$CC_CLIENT" = "lighthouse" ]; then
+
+    exec /usr/local/bin/lighthouse account validator slashing-protection $ACTION $INTERCHANGE_FILE \
+        --network $LH_NETWORK \
+        --datadir /validators/lighthouse
+
+fi
+
+
+# Lodestar
+if [ "$CC_CLIENT" = "lodestar" ]; then
+
+    # Lodestar gets the genesis validators root from the Beacon Node
+    exec /usr/app/node_modules/.bin/lodestar validator slashing-protection $ACTION \
+        --network $LODESTAR_NETWORK \
+        --dataDir /validators/lodestar \
+        --beaconNodes $CC_API_ENDPOINT \
+        --file $INTERCHANGE_FILE
+
+fi
+
+
+# Prysm
+if [ "$CC_CLIENT" = "prysm" ]; then
+
+    if [ "$ACTION" = "export" ]; then
+        # Prysm always names the exported file slashing_protection.json, so export to a temporary folder and move it into place
+        EXPORT_DIR=$(mktemp -d)
+        /app/cmd/validator/validator slashing-protection-history export \
+            --accept-terms-of-use \
+            $PRYSM_NETWORK \
+            --datadir /validators/prysm-non-hd/direct \
+            --slashing-protection-export-dir $EXPORT_DIR || exit 1
+        mv $EXPORT_DIR/slashing_protection.json $INTERCHANGE_FILE
+        exit $?
+    fi
+
+    exec /app/cmd/validator/validator slashing-protection-history import \
+        --accept-terms-of-use \
+        $PRYSM_NETWORK \
+        --datadir /validators/prysm-non-hd/direct \
+        --slashing-protection-json-file $INTERCHANGE_FILE
+
+fi
+
+
+# Teku
+if [ "$CC_CLIENT" = "teku" ]; then
+
+    if [ "$ACTION" = "export" ]; then
+        exec /opt/teku/bin/teku slashing-protection export \
+            --data-path=/validators/teku \
+            --to=$INTERCHANGE_FILE
+    fi
+
+    exec /opt/teku/bin/teku slashing-protection import \
+        --data-path=/validators/teku \
+        --from=$INTERCHANGE_FILE
+
+fi
+
+
+echo "Unknown consensus client [$CC_CLIENT]"
+exit 1
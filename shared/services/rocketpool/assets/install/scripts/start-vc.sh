@@ -30,6 +30,12 @@ if [ ! -f "/validators/$FEE_RECIPIENT_FILE" ]; then
     exit 1
 fi
 
+# Report a missing keymanager API token
+if [ "$KEYMANAGER_API_ENABLED" = "true" ] && [ ! -f "/validators/$KEYMANAGER_TOKEN_FILE" ]; then
+    echo "Keymanager API token not found, please wait for the rocketpool_node process to create one."
+    exit 1
+fi
+
 
 # Lighthouse startup
 if [ "$CC_CLIENT" = "lighthouse" ]; then
@@ -61,6 +67,10 @@ if [ "$CC_CLIENT" = "lighthouse" ]; then
         CMD="$CMD --builder-proposals --prefer-builder-proposals"
     fi
 
+    if [ "$KEYMANAGER_API_ENABLED" = "true" ]; then
+        CMD="$CMD --http --http-address 0.0.0.0 --http-port $KEYMANAGER_API_PORT --unencrypted-http-transport --http-token-path /validators/$KEYMANAGER_TOKEN_FILE"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics-address 0.0.0.0 --metrics-port $VC_METRICS_PORT"
     fi
@@ -112,6 +122,14 @@ if [ ! -z "$VC_SUGGESTED_BLOCK_GAS_LIMIT" ]; then
         CMD="$CMD --builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --externalSigner.url $REMOTE_SIGNER_URL --externalSigner.fetch"
+    fi
+
+    if [ "$KEYMANAGER_API_ENABLED" = "true" ]; then
+        CMD="$CMD --keymanager --keymanager.address 0.0.0.0 --keymanager.port $KEYMANAGER_API_PORT --keymanager.tokenFile /validators/$KEYMANAGER_TOKEN_FILE"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics.address 0.0.0.0 --metrics.port $VC_METRICS_PORT"
     fi
@@ -152,6 +170,14 @@ if [ "$CC_CLIENT" = "nimbus" ]; then
         CMD="$CMD --payload-builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --web3-signer-url=$REMOTE_SIGNER_URL"
+    fi
+
+    if [ "$KEYMANAGER_API_ENABLED" = "true" ]; then
+        CMD="$CMD --keymanager --keymanager-address=0.0.0.0 --keymanager-port=$KEYMANAGER_API_PORT --keymanager-token-file=/validators/$KEYMANAGER_TOKEN_FILE"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics-address=0.0.0.0 --metrics-port=$VC_METRICS_PORT"
     fi
@@ -196,6 +222,14 @@ if [ "$CC_CLIENT" = "prysm" ]; then
         CMD="$CMD --enable-doppelganger"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --validators-external-signer-url=$REMOTE_SIGNER_URL --validators-external-signer-public-keys=$REMOTE_SIGNER_URL/api/v1/eth2/publicKeys"
+    fi
+
+    if [ "$KEYMANAGER_API_ENABLED" = "true" ]; then
+        CMD="$CMD --rpc --http-host 0.0.0.0 --http-port $KEYMANAGER_API_PORT --keymanager-token-file /validators/$KEYMANAGER_TOKEN_FILE"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --monitoring-host 0.0.0.0 --monitoring-port $VC_METRICS_PORT"
     else
@@ -253,6 +287,14 @@ if [ "$CC_CLIENT" = "teku" ]; then
         CMD="$CMD --shut-down-when-validator-slashed-enabled=true"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --validators-external-signer-url=$REMOTE_SIGNER_URL --validators-external-signer-public-keys=external-signer"
+    fi
+
+    if [ "$KEYMANAGER_API_ENABLED" = "true" ]; then
+        CMD="$CMD --validator-api-enabled=true --validator-api-interface=0.0.0.0 --validator-api-port=$KEYMANAGER_API_PORT --validator-api-host-allowlist=validator,localhost --validator-api-bearer-file=/validators/$KEYMANAGER_TOKEN_FILE --Xvalidator-api-ssl-enabled=false"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics-enabled=true --metrics-interface=0.0.0.0 --metrics-port=$VC_METRICS_PORT --metrics-host-allowlist=*"
     fi
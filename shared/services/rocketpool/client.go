@@ -72,6 +72,8 @@ type Client struct {
 	maxPrioFee         float64
 	gasLimit           uint64
 	customNonce        *big.Int
+	offlineTx          bool
+	node               string
 	client             *ssh.Client
 	originalMaxFee     float64
 	originalMaxPrioFee float64
@@ -79,6 +81,7 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	apiServer          string
 }
 
 func getClientStatusString(clientStatus api.ClientStatus) string {
@@ -149,12 +152,15 @@ func NewClientFromCtx(c *cli.Context) *Client {
 		maxFee:             c.GlobalFloat64("maxFee"),
 		maxPrioFee:         c.GlobalFloat64("maxPrioFee"),
 		gasLimit:           c.GlobalUint64("gasLimit"),
+		offlineTx:          c.GlobalBool("offline-tx"),
+		node:               c.GlobalString("node"),
 		originalMaxFee:     c.GlobalFloat64("maxFee"),
 		originalMaxPrioFee: c.GlobalFloat64("maxPrioFee"),
 		originalGasLimit:   c.GlobalUint64("gasLimit"),
 		debugPrint:         c.GlobalBool("debug"),
 		forceFallbacks:     false,
 		ignoreSyncCheck:    false,
+		apiServer:          os.ExpandEnv(c.GlobalString("api-server")),
 	}
 
 	if nonce, ok := c.App.Metadata["nonce"]; ok {
@@ -861,6 +867,52 @@ func (c *Client) RunPruneProvisioner(container, volume string) error {
 
 }
 
+// Get the value of an environment variable from the given container's configuration
+func (c *Client) GetContainerEnvVar(container string, name string) (string, error) {
+
+	cmd := fmt.Sprintf("docker container inspect --format='{{range .Config.Env}}{{println .}}{{end}}' %s", container)
+	output, err := c.readOutput(cmd)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		if value, found := strings.CutPrefix(strings.TrimSpace(line), name+"="); found {
+			return value, nil
+		}
+	}
+	return "", nil
+
+}
+
+// Runs the slashing protection script against the data of the given validator container.
+// The container doesn't need to be running; a temporary one is created from the provided image with the same volumes,
+// and the interchange file is read from or written to the provided path on the node.
+func (c *Client) RunSlashingProtectionScript(validatorContainer string, image string, shell string, dockerNetwork string, envVars map[string]string, action string, interchangePath string) (string, error) {
+
+	interchangeFolder, interchangeFilename := filepath.Split(interchangePath)
+	envArgs := ""
+	for key, value := range envVars {
+		envArgs += fmt.Sprintf("-e %s=%s ", key, shellescape.Quote(value))
+	}
+	cmd := fmt.Sprintf("docker run --rm --volumes-from %s -v %s:/slashing-protection --network %s %s--entrypoint %s %s /setup/slashing-protection.sh %s %s 2>&1",
+		shellescape.Quote(validatorContainer),
+		shellescape.Quote(filepath.Clean(interchangeFolder)),
+		shellescape.Quote(dockerNetwork),
+		envArgs,
+		shell,
+		shellescape.Quote(image),
+		action,
+		shellescape.Quote("/slashing-protection/"+interchangeFilename),
+	)
+	output, err := c.readOutput(cmd)
+	outputString := strings.TrimSpace(string(output))
+	if err != nil {
+		return outputString, fmt.Errorf("Error running slashing protection %s: %w\n%s", action, err, outputString)
+	}
+	return outputString, nil
+
+}
+
 // Curls the Nethermind admin URL to trigger pruning
 func (c *Client) RunNethermindPruneStarter(executionContainerName string) error {
 	retryCount := 5
@@ -1025,6 +1077,11 @@ func (c *Client) AssignGasSettings(maxFee float64, maxPrioFee float64, gasLimit
 	c.gasLimit = gasLimit
 }
 
+// Check if transactions are being exported for offline signing instead of being sent
+func (c *Client) IsOfflineTx() bool {
+	return c.offlineTx
+}
+
 // Set the flags for ignoring EC and CC sync checks and forcing fallbacks to prevent unnecessary duplication of effort by the API during CLI commands
 func (c *Client) SetClientStatusFlags(ignoreSyncCheck bool, forceFallbacks bool) {
 	c.ignoreSyncCheck = ignoreSyncCheck
@@ -1248,6 +1305,15 @@ func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir strin
 
 // Call the Rocket Pool API
 func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
+	// Use the API server if one is running
+	serverAddress, err := c.getApiServerAddress()
+	if err != nil {
+		return []byte{}, err
+	}
+	if serverAddress != "" {
+		return c.callAPIServer(serverAddress, args, otherArgs...)
+	}
+
 	// Sanitize and parse the args
 	ignoreSyncCheckFlag, forceFallbackECFlag, args := c.getApiCallArgs(args, otherArgs...)
 
@@ -1258,15 +1324,17 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getOfflineTxFlag(), c.getNodeFlag(), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getOfflineTxFlag(),
+			c.getNodeFlag(),
 			args)
 	}
 
@@ -1291,13 +1359,13 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getOfflineTxFlag(), c.getNodeFlag(), args)
 	} else {
 		envArgs := ""
 		for key, value := range envVars {
 			envArgs += fmt.Sprintf("%s=%s ", key, shellescape.Quote(value))
 		}
-		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s %s %s api %s",
 			envArgs,
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
@@ -1305,6 +1373,8 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getOfflineTxFlag(),
+			c.getNodeFlag(),
 			args)
 	}
 
@@ -1396,6 +1466,25 @@ func (c *Client) getCustomNonce() string {
 	return nonce
 }
 
+func (c *Client) getOfflineTxFlag() string {
+	if c.offlineTx {
+		return "--offline-tx"
+	}
+	return ""
+}
+
+func (c *Client) getNodeFlag() string {
+	if c.node != "" {
+		return fmt.Sprintf("--node %s", shellescape.Quote(c.node))
+	}
+	return ""
+}
+
+// Get the name of the node the CLI is running commands for, which is empty for the primary node
+func (c *Client) GetNode() string {
+	return c.node
+}
+
 // Run a command and print its output
 func (c *Client) printOutput(cmdText string) error {
 
@@ -234,6 +234,54 @@ func (c *Client) ExitValidator(validatorId uint64) (api.ExitValidatorResponse, e
 	return response, nil
 }
 
+// Plan the consolidation of the megapool's validators into compounding validators
+func (c *Client) GetConsolidationPlan() (api.MegapoolConsolidationPlanResponse, error) {
+	responseBytes, err := c.callAPI("megapool get-consolidation-plan")
+	if err != nil {
+		return api.MegapoolConsolidationPlanResponse{}, fmt.Errorf("Could not get consolidation plan: %w", err)
+	}
+	var response api.MegapoolConsolidationPlanResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolConsolidationPlanResponse{}, fmt.Errorf("Could not decode consolidation plan response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MegapoolConsolidationPlanResponse{}, fmt.Errorf("Could not get consolidation plan: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a megapool validator can be consolidated into another one
+func (c *Client) CanConsolidateValidators(sourceId uint32, targetId uint32) (api.CanConsolidateValidatorsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("megapool can-consolidate %d %d", sourceId, targetId))
+	if err != nil {
+		return api.CanConsolidateValidatorsResponse{}, fmt.Errorf("Could not get can consolidate status: %w", err)
+	}
+	var response api.CanConsolidateValidatorsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanConsolidateValidatorsResponse{}, fmt.Errorf("Could not decode can consolidate response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanConsolidateValidatorsResponse{}, fmt.Errorf("Could not get can consolidate status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Request the consolidation of a megapool validator into another one
+func (c *Client) ConsolidateValidators(sourceId uint32, targetId uint32) (api.ConsolidateValidatorsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("megapool consolidate %d %d", sourceId, targetId))
+	if err != nil {
+		return api.ConsolidateValidatorsResponse{}, fmt.Errorf("Could not consolidate megapool validators: %w", err)
+	}
+	var response api.ConsolidateValidatorsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ConsolidateValidatorsResponse{}, fmt.Errorf("Could not decode consolidate response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ConsolidateValidatorsResponse{}, fmt.Errorf("Could not consolidate megapool validators: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether we can notify a validator exit
 func (c *Client) CanNotifyValidatorExit(validatorId uint64) (api.CanNotifyValidatorExitResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("megapool can-notify-validator-exit %d", validatorId))
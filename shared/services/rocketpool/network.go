@@ -24,6 +24,22 @@ func (c *Client) NodeFee() (api.NodeFeeResponse, error) {
 	return response, nil
 }
 
+// Get the current gas fee suggestions from the oracle the user selected
+func (c *Client) GasSuggestions() (api.GasSuggestionsResponse, error) {
+	responseBytes, err := c.callAPI("network gas-suggestions")
+	if err != nil {
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not get gas suggestions: %w", err)
+	}
+	var response api.GasSuggestionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not decode gas suggestions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not get gas suggestions: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get network RPL price
 func (c *Client) RplPrice() (api.RplPriceResponse, error) {
 	responseBytes, err := c.callAPI("network rpl-price")
@@ -1366,3 +1366,67 @@ func (c *Client) ClaimUnclaimedRewards(nodeAddress common.Address) (api.ClaimUnc
 	}
 	return response, nil
 }
+
+// Get the transactions the node and watchtower daemons are tracking
+func (c *Client) NodeTxQueue() (api.NodeTxQueueResponse, error) {
+	responseBytes, err := c.callAPI("node tx-queue")
+	if err != nil {
+		return api.NodeTxQueueResponse{}, fmt.Errorf("Could not get transaction queue: %w", err)
+	}
+	var response api.NodeTxQueueResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeTxQueueResponse{}, fmt.Errorf("Could not decode transaction queue response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeTxQueueResponse{}, fmt.Errorf("Could not get transaction queue: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the status of the node and watchtower daemons' tasks
+func (c *Client) NodeTasks() (api.NodeTasksResponse, error) {
+	responseBytes, err := c.callAPI("node tasks")
+	if err != nil {
+		return api.NodeTasksResponse{}, fmt.Errorf("Could not get task status: %w", err)
+	}
+	var response api.NodeTasksResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeTasksResponse{}, fmt.Errorf("Could not decode task status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeTasksResponse{}, fmt.Errorf("Could not get task status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the transactions the node and watchtower daemons simulated in dry-run mode
+func (c *Client) NodeDryRun() (api.NodeDryRunResponse, error) {
+	responseBytes, err := c.callAPI("node dry-run")
+	if err != nil {
+		return api.NodeDryRunResponse{}, fmt.Errorf("Could not get dry run transactions: %w", err)
+	}
+	var response api.NodeDryRunResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeDryRunResponse{}, fmt.Errorf("Could not decode dry run response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeDryRunResponse{}, fmt.Errorf("Could not get dry run transactions: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Send transactions that were signed offline
+func (c *Client) NodeBroadcast(signedTransactions string) (api.NodeBroadcastResponse, error) {
+	responseBytes, err := c.callAPI("node broadcast", signedTransactions)
+	if err != nil {
+		return api.NodeBroadcastResponse{}, fmt.Errorf("Could not broadcast transactions: %w", err)
+	}
+	var response api.NodeBroadcastResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeBroadcastResponse{}, fmt.Errorf("Could not decode broadcast response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeBroadcastResponse{}, fmt.Errorf("Could not broadcast transactions: %s", response.Error)
+	}
+	return response, nil
+}
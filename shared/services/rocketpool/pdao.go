@@ -203,6 +203,38 @@ func (c *Client) PDAOProposeSetting(contract string, setting string, value strin
 	return response, nil
 }
 
+// Check whether the node can propose a bundle of PDAO setting changes
+func (c *Client) PDAOCanProposeSettingsBundle(contracts []string, settings []string, values []string) (api.CanProposePDAOSettingsBundleResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("pdao can-propose-settings-bundle %s %s %s", strings.Join(contracts, ","), strings.Join(settings, ","), strings.Join(values, ",")))
+	if err != nil {
+		return api.CanProposePDAOSettingsBundleResponse{}, fmt.Errorf("Could not get protocol DAO can-propose-settings-bundle: %w", err)
+	}
+	var response api.CanProposePDAOSettingsBundleResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanProposePDAOSettingsBundleResponse{}, fmt.Errorf("Could not decode protocol DAO can-propose-settings-bundle response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanProposePDAOSettingsBundleResponse{}, fmt.Errorf("Could not get protocol DAO can-propose-settings-bundle: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Propose a bundle of PDAO setting changes (use can-propose-settings-bundle to get the pollard)
+func (c *Client) PDAOProposeSettingsBundle(contracts []string, settings []string, values []string, blockNumber uint32) (api.ProposePDAOSettingResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("pdao propose-settings-bundle %s %s %s %d", strings.Join(contracts, ","), strings.Join(settings, ","), strings.Join(values, ","), blockNumber))
+	if err != nil {
+		return api.ProposePDAOSettingResponse{}, fmt.Errorf("Could not get protocol DAO propose-settings-bundle: %w", err)
+	}
+	var response api.ProposePDAOSettingResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ProposePDAOSettingResponse{}, fmt.Errorf("Could not decode protocol DAO propose-settings-bundle response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ProposePDAOSettingResponse{}, fmt.Errorf("Could not get protocol DAO propose-settings-bundle: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get the allocation percentages of RPL rewards for the Oracle DAO, the Protocol DAO, and the node operators
 func (c *Client) PDAOGetRewardsPercentages() (api.PDAOGetRewardsPercentagesResponse, error) {
 	responseBytes, err := c.callAPI("pdao get-rewards-percentages")
@@ -2,10 +2,12 @@ package rocketpool
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
 
+	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -25,6 +27,22 @@ func (c *Client) WalletStatus() (api.WalletStatusResponse, error) {
 	return response, nil
 }
 
+// Get the nodes this installation manages and their wallets
+func (c *Client) WalletNodes() (api.WalletNodesResponse, error) {
+	responseBytes, err := c.callAPI("wallet nodes")
+	if err != nil {
+		return api.WalletNodesResponse{}, fmt.Errorf("Could not get nodes: %w", err)
+	}
+	var response api.WalletNodesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.WalletNodesResponse{}, fmt.Errorf("Could not decode wallet nodes response: %w", err)
+	}
+	if response.Error != "" {
+		return api.WalletNodesResponse{}, fmt.Errorf("Could not get nodes: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Set wallet password
 func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 	responseBytes, err := c.callAPI("wallet set-password", password)
@@ -165,6 +183,22 @@ func (c *Client) RebuildWallet() (api.RebuildWalletResponse, error) {
 	return response, nil
 }
 
+// Remove the node's validator keys from the Validator Client
+func (c *Client) UnloadValidatorKeys() (api.UnloadValidatorKeysResponse, error) {
+	responseBytes, err := c.callAPI("wallet unload-validator-keys")
+	if err != nil {
+		return api.UnloadValidatorKeysResponse{}, fmt.Errorf("Could not unload validator keys: %w", err)
+	}
+	var response api.UnloadValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.UnloadValidatorKeysResponse{}, fmt.Errorf("Could not decode unload validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.UnloadValidatorKeysResponse{}, fmt.Errorf("Could not unload validator keys: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Estimate the gas required to set an ENS reverse record to a name
 func (c *Client) EstimateGasSetEnsName(name string) (api.SetEnsNameResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("wallet estimate-gas-set-ens-name %s", name))
@@ -213,6 +247,62 @@ func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
 	return response, nil
 }
 
+// Export validator keys as EIP-2335 keystores with a slashing protection interchange, or all of the node's keys if no pubkeys are provided
+func (c *Client) ExportValidatorKeys(pubkeys []types.ValidatorPubkey) (api.ExportValidatorKeysResponse, error) {
+	pubkeysArg := "all"
+	if len(pubkeys) > 0 {
+		pubkeyStrings := make([]string, len(pubkeys))
+		for i, pubkey := range pubkeys {
+			pubkeyStrings[i] = pubkey.Hex()
+		}
+		pubkeysArg = strings.Join(pubkeyStrings, ",")
+	}
+	responseBytes, err := c.callAPI("wallet export-validator-keys", pubkeysArg)
+	if err != nil {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not export validator keys: %w", err)
+	}
+	var response api.ExportValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not decode export validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not export validator keys: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Import a validator key from an EIP-2335 keystore
+func (c *Client) ImportValidatorKey(keystore string, password string) (api.ImportValidatorKeyResponse, error) {
+	responseBytes, err := c.callAPI("wallet import-validator-key", keystore, password)
+	if err != nil {
+		return api.ImportValidatorKeyResponse{}, fmt.Errorf("Could not import validator key: %w", err)
+	}
+	var response api.ImportValidatorKeyResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ImportValidatorKeyResponse{}, fmt.Errorf("Could not decode import validator key response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ImportValidatorKeyResponse{}, fmt.Errorf("Could not import validator key: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Sign transactions that were exported for offline signing
+func (c *Client) SignOfflineTransactions(transactions string) (api.SignOfflineTransactionsResponse, error) {
+	responseBytes, err := c.callAPI("wallet sign-offline", transactions)
+	if err != nil {
+		return api.SignOfflineTransactionsResponse{}, fmt.Errorf("Could not sign offline transactions: %w", err)
+	}
+	var response api.SignOfflineTransactionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SignOfflineTransactionsResponse{}, fmt.Errorf("Could not decode sign offline transactions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SignOfflineTransactionsResponse{}, fmt.Errorf("Could not sign offline transactions: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Set the node address to an arbitrary address
 func (c *Client) Masquerade(address common.Address) (api.MasqueradeResponse, error) {
 	responseBytes, err := c.callAPI("wallet masquerade", address.Hex())
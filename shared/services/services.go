@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/docker/docker/client"
@@ -16,13 +17,23 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/contracts"
+	"github.com/rocket-pool/smartnode/shared/services/dryrun"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/proofs"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	kmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/keymanager"
 	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	lokeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lodestar"
 	nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	w3skeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/web3signer"
+	"github.com/rocket-pool/smartnode/shared/services/web3signer"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -33,28 +44,31 @@ const (
 
 // Service instances & initializers
 var (
-	cfg                  *config.RocketPoolConfig
-	passwordManager      *passwords.PasswordManager
-	addressManager       *wallet.AddressManager
-	nodeWallet           wallet.Wallet
-	ecManager            *ExecutionClientManager
-	bcManager            *BeaconClientManager
-	rocketPool           *rocketpool.RocketPool
-	rocketSignerRegistry *contracts.RocketSignerRegistry
-	beaconClient         beacon.Client
-	docker               *client.Client
-
-	initCfg                  sync.Once
-	initPasswordManager      sync.Once
-	initAddressManager       sync.Once
-	initNodeWallet           sync.Once
-	initECManager            sync.Once
-	initBCManager            sync.Once
-	initRocketPool           sync.Once
-	initOneInchOracle        sync.Once
-	initRocketSignerRegistry sync.Once
-	initBeaconClient         sync.Once
-	initDocker               sync.Once
+	cfg                         *config.RocketPoolConfig
+	passwordManagers            = map[string]*passwords.PasswordManager{}
+	addressManagers             = map[string]*wallet.AddressManager{}
+	nodeWallets                 = map[string]wallet.Wallet{}
+	nodeWalletsIgnoreMasquerade = map[string]bool{}
+	ecManagers                  = map[clientOptions]*ExecutionClientManager{}
+	bcManagers                  = map[clientOptions]*BeaconClientManager{}
+	rocketPools                 = map[clientOptions]*rocketpool.RocketPool{}
+	rocketSignerRegistries      = map[clientOptions]*contracts.RocketSignerRegistry{}
+	beaconClient                beacon.Client
+	remoteSigner                *web3signer.Client
+	docker                      *client.Client
+	dryRunRecorders             = map[string]*dryrun.Recorder{}
+	proofServices               = map[clientOptions]*proofs.Service{}
+	beaconEventHub              *tasks.EventHub
+
+	cfgLock           sync.Mutex
+	nodeManagersLock  sync.Mutex
+	nodeWalletLock    sync.Mutex
+	clientsLock       sync.Mutex
+	initOneInchOracle sync.Once
+	initBeaconClient  sync.Once
+	initRemoteSigner  sync.Once
+	initDocker        sync.Once
+	initEventHub      sync.Once
 )
 
 //
@@ -70,7 +84,11 @@ func GetPasswordManager(c *cli.Context) (*passwords.PasswordManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	return getPasswordManager(cfg), nil
+	node, err := GetNodeName(c)
+	if err != nil {
+		return nil, err
+	}
+	return getPasswordManager(cfg, node), nil
 }
 
 func GetWallet(c *cli.Context) (wallet.Wallet, error) {
@@ -78,9 +96,22 @@ func GetWallet(c *cli.Context) (wallet.Wallet, error) {
 	if err != nil {
 		return nil, err
 	}
-	pm := getPasswordManager(cfg)
-	am := getAddressManager(cfg)
-	return getWallet(c, cfg, pm, am, false)
+	node, err := GetNodeName(c)
+	if err != nil {
+		return nil, err
+	}
+	pm := getPasswordManager(cfg, node)
+	am := getAddressManager(cfg, node)
+	w, err := getWallet(c, cfg, node, pm, am, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Export transactions for signing on another machine instead of sending them
+	if c.GlobalBool("offline-tx") {
+		return wallet.NewOfflineWallet(w, os.ExpandEnv(cfg.Smartnode.GetOfflineTransactionsPath(true))), nil
+	}
+	return wrapDryRunWallet(node, w), nil
 }
 
 func GetHdWallet(c *cli.Context) (wallet.Wallet, error) {
@@ -88,9 +119,30 @@ func GetHdWallet(c *cli.Context) (wallet.Wallet, error) {
 	if err != nil {
 		return nil, err
 	}
-	pm := getPasswordManager(cfg)
-	am := getAddressManager(cfg)
-	return getWallet(c, cfg, pm, am, true)
+	node, err := GetNodeName(c)
+	if err != nil {
+		return nil, err
+	}
+	pm := getPasswordManager(cfg, node)
+	am := getAddressManager(cfg, node)
+	w, err := getWallet(c, cfg, node, pm, am, true)
+	if err != nil {
+		return nil, err
+	}
+	return wrapDryRunWallet(node, w), nil
+}
+
+// Simulate every transaction sent through the selected node's wallet with the provided recorder instead of sending it.
+// Only the daemons call this, so transactions the user sends manually through the API are never affected.
+func EnableDryRun(c *cli.Context, recorder *dryrun.Recorder) error {
+	node, err := GetNodeName(c)
+	if err != nil {
+		return err
+	}
+	nodeWalletLock.Lock()
+	defer nodeWalletLock.Unlock()
+	dryRunRecorders[node] = recorder
+	return nil
 }
 
 func GetEthClient(c *cli.Context) (*ExecutionClientManager, error) {
@@ -129,7 +181,7 @@ func GetRocketPool(c *cli.Context) (*rocketpool.RocketPool, error) {
 		return nil, err
 	}
 
-	return getRocketPool(cfg, ec)
+	return getRocketPool(c, cfg, ec)
 }
 
 func GetRocketSignerRegistry(c *cli.Context) (*contracts.RocketSignerRegistry, error) {
@@ -141,7 +193,7 @@ func GetRocketSignerRegistry(c *cli.Context) (*contracts.RocketSignerRegistry, e
 	if err != nil {
 		return nil, err
 	}
-	return getRocketSignerRegistry(cfg, ec)
+	return getRocketSignerRegistry(c, cfg, ec)
 }
 
 func GetBeaconClient(c *cli.Context) (*BeaconClientManager, error) {
@@ -152,6 +204,53 @@ func GetBeaconClient(c *cli.Context) (*BeaconClientManager, error) {
 	return getBeaconClient(c, cfg)
 }
 
+// Get the hub that shares one Beacon event stream between the task schedulers in this process
+func GetBeaconEventHub(c *cli.Context, logger *log.ColorLogger) (*tasks.EventHub, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := getBeaconClient(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+	initEventHub.Do(func() {
+		beaconEventHub = tasks.NewEventHub(bc, logger)
+	})
+	return beaconEventHub, nil
+}
+
+// Get the service that generates beacon state proofs for validators
+func GetProofService(c *cli.Context) (*proofs.Service, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := getBeaconClient(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return getProofService(c, cfg, bc), nil
+}
+
+// Get the remote signer the validator keys are kept in, or nil if the node uses local keystores
+func GetRemoteSigner(c *cli.Context) (*web3signer.Client, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteSigner(cfg), nil
+}
+
+// Get the keymanager API of the running Validator Client, or nil if it isn't enabled or its token hasn't been created yet
+func GetKeymanager(c *cli.Context) (*keymanager.Client, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getKeymanager(cfg), nil
+}
+
 func GetDocker(c *cli.Context) (*client.Client, error) {
 	var err error
 	initDocker.Do(func() {
@@ -165,129 +264,284 @@ func GetDocker(c *cli.Context) (*client.Client, error) {
 //
 
 func getConfig(c *cli.Context) (*config.RocketPoolConfig, error) {
-	var err error
-	initCfg.Do(func() {
-		settingsFile := os.ExpandEnv(c.GlobalString("settings"))
-		cfg, err = rp.LoadConfigFromFile(settingsFile)
-		if cfg == nil && err == nil {
-			err = fmt.Errorf("Settings file [%s] not found.", settingsFile)
-		}
-	})
-	return cfg, err
+	cfgLock.Lock()
+	defer cfgLock.Unlock()
+
+	// A failed load isn't cached, so the API server can start before the user configures the node. Once it's loaded,
+	// the config is kept for the life of the process; changes to the settings file need a restart to take effect.
+	if cfg != nil {
+		return cfg, nil
+	}
+	settingsFile := os.ExpandEnv(c.GlobalString("settings"))
+	loadedCfg, err := rp.LoadConfigFromFile(settingsFile)
+	if err != nil {
+		return nil, err
+	}
+	if loadedCfg == nil {
+		return nil, fmt.Errorf("Settings file [%s] not found.", settingsFile)
+	}
+	cfg = loadedCfg
+	return cfg, nil
 }
 
-func getPasswordManager(cfg *config.RocketPoolConfig) *passwords.PasswordManager {
-	initPasswordManager.Do(func() {
-		passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
-	})
-	return passwordManager
+func getPasswordManager(cfg *config.RocketPoolConfig, node string) *passwords.PasswordManager {
+	nodeManagersLock.Lock()
+	defer nodeManagersLock.Unlock()
+	pm, exists := passwordManagers[node]
+	if !exists {
+		pm = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPathForNode(node)))
+		passwordManagers[node] = pm
+	}
+	return pm
 }
 
-func getAddressManager(cfg *config.RocketPoolConfig) *wallet.AddressManager {
-	initAddressManager.Do(func() {
-		addressManager = wallet.NewAddressManager(os.ExpandEnv(cfg.Smartnode.GetNodeAddressPath()))
-	})
-	return addressManager
+func getAddressManager(cfg *config.RocketPoolConfig, node string) *wallet.AddressManager {
+	nodeManagersLock.Lock()
+	defer nodeManagersLock.Unlock()
+	am, exists := addressManagers[node]
+	if !exists {
+		am = wallet.NewAddressManager(os.ExpandEnv(cfg.Smartnode.GetNodeAddressPathForNode(node)))
+		addressManagers[node] = am
+	}
+	return am
 }
 
-func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.PasswordManager, am *wallet.AddressManager, ignoreMasquerade bool) (wallet.Wallet, error) {
-	var err error
-	initNodeWallet.Do(func() {
-		var maxFee *big.Int
-		maxFeeFloat := c.GlobalFloat64("maxFee")
-		if maxFeeFloat == 0 {
-			maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
-		}
-		if maxFeeFloat != 0 {
-			maxFee = eth.GweiToWei(maxFeeFloat)
-		}
+func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, node string, pm *passwords.PasswordManager, am *wallet.AddressManager, ignoreMasquerade bool) (wallet.Wallet, error) {
+	nodeWalletLock.Lock()
+	defer nodeWalletLock.Unlock()
 
-		var maxPriorityFee *big.Int
-		maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
-		if maxPriorityFeeFloat == 0 {
-			maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
+	// Long-running processes like the API server outlive masquerade changes, so rebuild the wallet if its mode is stale
+	addressPath := os.ExpandEnv(cfg.Smartnode.GetNodeAddressPathForNode(node))
+	nodeWallet := nodeWallets[node]
+	if nodeWallet != nil && !nodeWalletsIgnoreMasquerade[node] {
+		_, err := os.Stat(addressPath)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("error checking address file path: %w", err)
 		}
-		if maxPriorityFeeFloat != 0 {
-			maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
+		if nodeWallet.IsNodeMasquerading() == os.IsNotExist(err) {
+			nodeWallet = nil
 		}
+	}
+
+	// Gas settings can change with every command the API server runs, so they're refreshed on each call
+	maxFee, maxPriorityFee := getGasSettings(c, cfg)
+	if nodeWallet != nil {
+		nodeWallet.SetGasSettings(maxFee, maxPriorityFee, 0)
+		return nodeWallet, nil
+	}
 
-		chainId := cfg.Smartnode.GetChainID()
+	chainId := cfg.Smartnode.GetChainID()
+	walletPath := os.ExpandEnv(cfg.Smartnode.GetWalletPathForNode(node))
+	var w wallet.Wallet
+	var err error
+	if ignoreMasquerade {
+		w, err = wallet.NewHdWallet(walletPath, chainId, maxFee, maxPriorityFee, 0, pm, am)
+	} else {
+		w, err = wallet.NewWallet(addressPath, walletPath, chainId, maxFee, maxPriorityFee, 0, pm, am)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-		if ignoreMasquerade {
-			nodeWallet, err = wallet.NewHdWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm, am)
-		} else {
-			nodeWallet, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetNodeAddressPath()), os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm, am)
+	// Keystores
+	km := getKeymanager(cfg)
+	consensusClient, _ := cfg.GetSelectedConsensusClient()
+	if signer := getRemoteSigner(cfg); signer != nil {
+		// The other clients get the list of keys from the signer itself
+		signerUrl := cfg.Smartnode.RemoteSignerUrl.Value.(string)
+		w.AddKeystore("web3signer", w3skeystore.NewKeystore(signer))
+		var lighthouseKeystore keystore.Keystore = lhkeystore.NewRemoteKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), signerUrl)
+		if km != nil {
+			// Register new keys with the running Validator Client so it doesn't have to wait for a restart to pick them up
+			if consensusClient == cfgtypes.ConsensusClient_Lighthouse {
+				lighthouseKeystore = kmkeystore.NewKeystore(lighthouseKeystore, km, signerUrl)
+			} else {
+				w.AddKeystore("keymanager", kmkeystore.NewKeystore(nil, km, signerUrl))
+			}
 		}
-		if err != nil {
-			return
+		w.AddKeystore("lighthouse", lighthouseKeystore)
+		nodeWallets[node] = w
+		nodeWalletsIgnoreMasquerade[node] = ignoreMasquerade
+		return w, nil
+	}
+	keystores := map[string]keystore.Keystore{
+		"lighthouse": lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm),
+		"lodestar":   lokeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm),
+		"nimbus":     nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm),
+		"prysm":      prkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm),
+		"teku":       tkkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm),
+	}
+	for name, ks := range keystores {
+		// Load new keys into the running Validator Client, falling back to its keystore files if that doesn't work
+		if km != nil && name == string(consensusClient) {
+			ks = kmkeystore.NewKeystore(ks, km, "")
 		}
+		w.AddKeystore(name, ks)
+	}
 
-		// Keystores
-		lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		lodestarKeystore := lokeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		prysmKeystore := prkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		tekuKeystore := tkkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		nodeWallet.AddKeystore("lighthouse", lighthouseKeystore)
-		nodeWallet.AddKeystore("lodestar", lodestarKeystore)
-		nodeWallet.AddKeystore("nimbus", nimbusKeystore)
-		nodeWallet.AddKeystore("prysm", prysmKeystore)
-		nodeWallet.AddKeystore("teku", tekuKeystore)
-	})
-	return nodeWallet, err
+	nodeWallets[node] = w
+	nodeWalletsIgnoreMasquerade[node] = ignoreMasquerade
+	return w, nil
+}
+
+// Get the max fee and max priority fee from the command line, falling back to the config
+func getGasSettings(c *cli.Context, cfg *config.RocketPoolConfig) (*big.Int, *big.Int) {
+	var maxFee *big.Int
+	maxFeeFloat := c.GlobalFloat64("maxFee")
+	if maxFeeFloat == 0 {
+		maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
+	}
+	if maxFeeFloat != 0 {
+		maxFee = eth.GweiToWei(maxFeeFloat)
+	}
+
+	var maxPriorityFee *big.Int
+	maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
+	if maxPriorityFeeFloat == 0 {
+		maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
+	}
+	if maxPriorityFeeFloat != 0 {
+		maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
+	}
+
+	return maxFee, maxPriorityFee
+}
+
+// The client settings a command can be run with.
+// The API server runs commands with different settings in one process, so each combination gets its own clients.
+type clientOptions struct {
+	ignoreSyncCheck bool
+	forceFallbacks  bool
+}
+
+func getClientOptions(c *cli.Context) clientOptions {
+	return clientOptions{
+		ignoreSyncCheck: c.GlobalBool("ignore-sync-check"),
+		forceFallbacks:  c.GlobalBool("force-fallbacks"),
+	}
 }
 
 func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClientManager, error) {
-	var err error
-	initECManager.Do(func() {
-		// Create a new client manager
-		ecManager, err = NewExecutionClientManager(cfg)
-		if err == nil {
-			// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
-			if c.GlobalBool("ignore-sync-check") {
-				ecManager.ignoreSyncCheck = true
-			}
-			if c.GlobalBool("force-fallbacks") {
-				ecManager.primaryReady = false
-			}
-		}
-	})
-	return ecManager, err
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	options := getClientOptions(c)
+	if ecManager, exists := ecManagers[options]; exists {
+		return ecManager, nil
+	}
+
+	// Create a new client manager
+	ecManager, err := NewExecutionClientManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
+	ecManager.ignoreSyncCheck = options.ignoreSyncCheck
+	if options.forceFallbacks {
+		ecManager.primaryReady = false
+	}
+	ecManagers[options] = ecManager
+	return ecManager, nil
 }
 
-func getRocketPool(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*rocketpool.RocketPool, error) {
-	var err error
-	initRocketPool.Do(func() {
-		rocketPool, err = rocketpool.NewRocketPool(client, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
-	})
-	return rocketPool, err
+func getRocketPool(c *cli.Context, cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*rocketpool.RocketPool, error) {
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	options := getClientOptions(c)
+	if rocketPool, exists := rocketPools[options]; exists {
+		return rocketPool, nil
+	}
+	rocketPool, err := rocketpool.NewRocketPool(client, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+	if err != nil {
+		return nil, err
+	}
+	rocketPools[options] = rocketPool
+	return rocketPool, nil
 }
 
-func getRocketSignerRegistry(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*contracts.RocketSignerRegistry, error) {
-	var err error
-	initRocketSignerRegistry.Do(func() {
-		address := cfg.Smartnode.GetRocketSignerRegistryAddress()
-		if address != "" {
-			rocketSignerRegistry, err = contracts.NewRocketSignerRegistry(common.HexToAddress(address), client)
+func getRocketSignerRegistry(c *cli.Context, cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*contracts.RocketSignerRegistry, error) {
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	options := getClientOptions(c)
+	if registry, exists := rocketSignerRegistries[options]; exists {
+		return registry, nil
+	}
+	var registry *contracts.RocketSignerRegistry
+	address := cfg.Smartnode.GetRocketSignerRegistryAddress()
+	if address != "" {
+		var err error
+		registry, err = contracts.NewRocketSignerRegistry(common.HexToAddress(address), client)
+		if err != nil {
+			return nil, err
 		}
-	})
-	return rocketSignerRegistry, err
+	}
+	rocketSignerRegistries[options] = registry
+	return registry, nil
 }
 
 func getBeaconClient(c *cli.Context, cfg *config.RocketPoolConfig) (*BeaconClientManager, error) {
-	var err error
-	initBCManager.Do(func() {
-		// Create a new client manager
-		bcManager, err = NewBeaconClientManager(cfg)
-		if err == nil {
-			// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
-			if c.GlobalBool("ignore-sync-check") {
-				bcManager.ignoreSyncCheck = true
-			}
-			if c.GlobalBool("force-fallbacks") {
-				bcManager.primaryReady = false
-			}
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	options := getClientOptions(c)
+	if bcManager, exists := bcManagers[options]; exists {
+		return bcManager, nil
+	}
+
+	// Create a new client manager
+	bcManager, err := NewBeaconClientManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
+	bcManager.ignoreSyncCheck = options.ignoreSyncCheck
+	if options.forceFallbacks {
+		bcManager.primaryReady = false
+	}
+	bcManagers[options] = bcManager
+	return bcManager, nil
+}
+
+func getProofService(c *cli.Context, cfg *config.RocketPoolConfig, bc beacon.Client) *proofs.Service {
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	options := getClientOptions(c)
+	proofService, exists := proofServices[options]
+	if !exists {
+		proofService = proofs.NewService(cfg, bc)
+		proofServices[options] = proofService
+	}
+	return proofService
+}
+
+func getKeymanager(cfg *config.RocketPoolConfig) *keymanager.Client {
+	if cfg.IsNativeMode || cfg.Smartnode.UseKeymanagerApi.Value != true {
+		return nil
+	}
+
+	// The token is read every time since the node daemon may not have created it when a long-running process first asks for it
+	token, err := os.ReadFile(os.ExpandEnv(cfg.Smartnode.GetKeymanagerTokenPath()))
+	if err != nil {
+		return nil
+	}
+	return keymanager.NewClient(cfg.Smartnode.GetKeymanagerApiUrl(), strings.TrimSpace(string(token)))
+}
+
+func getRemoteSigner(cfg *config.RocketPoolConfig) *web3signer.Client {
+	initRemoteSigner.Do(func() {
+		if cfg.Smartnode.UseRemoteSigner.Value == true {
+			remoteSigner = web3signer.NewClient(cfg.Smartnode.RemoteSignerUrl.Value.(string))
 		}
 	})
-	return bcManager, err
+	return remoteSigner
+}
+
+func wrapDryRunWallet(node string, w wallet.Wallet) wallet.Wallet {
+	nodeWalletLock.Lock()
+	defer nodeWalletLock.Unlock()
+	recorder, exists := dryRunRecorders[node]
+	if !exists {
+		return w
+	}
+	return wallet.NewDryRunWallet(w, recorder)
 }
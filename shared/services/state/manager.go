@@ -3,6 +3,7 @@ package state
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -17,8 +18,9 @@ type NetworkStateManager struct {
 	bc  beacon.Client
 	log *log.ColorLogger
 
-	// Memoized Beacon config
-	beaconConfig *beacon.Eth2Config
+	// Memoized Beacon config, which is shared by every goroutine using the manager
+	beaconConfig     *beacon.Eth2Config
+	beaconConfigLock sync.Mutex
 
 	// Multicaller and batch balance contract addresses
 	multicaller    common.Address
@@ -44,6 +46,8 @@ func NewNetworkStateManager(
 }
 
 func (m *NetworkStateManager) getBeaconConfig() (*beacon.Eth2Config, error) {
+	m.beaconConfigLock.Lock()
+	defer m.beaconConfigLock.Unlock()
 	if m.beaconConfig != nil {
 		return m.beaconConfig, nil
 	}
@@ -60,7 +64,7 @@ func (m *NetworkStateManager) getBeaconConfig() (*beacon.Eth2Config, error) {
 
 // Get the state of the network using the latest Execution layer block
 func (m *NetworkStateManager) GetHeadState() (*NetworkState, error) {
-	targetSlot, err := m.getHeadSlot()
+	targetSlot, err := m.GetHeadSlot()
 	if err != nil {
 		return nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
 	}
@@ -69,11 +73,17 @@ func (m *NetworkStateManager) GetHeadState() (*NetworkState, error) {
 
 // Get the state of the network for a single node using the latest Execution layer block, along with the total effective RPL stake for the network
 func (m *NetworkStateManager) GetHeadStateForNode(nodeAddress common.Address) (*NetworkState, error) {
-	targetSlot, err := m.getHeadSlot()
+	targetSlot, err := m.GetHeadSlot()
 	if err != nil {
 		return nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
 	}
-	return m.createNetworkStateForNode(targetSlot, nodeAddress)
+	return m.GetStateForNodes(targetSlot, []common.Address{nodeAddress})
+}
+
+// Get the state of the network for the provided nodes at the provided Beacon slot.
+// The network details are only loaded once no matter how many nodes there are.
+func (m *NetworkStateManager) GetStateForNodes(slotNumber uint64, nodeAddresses []common.Address) (*NetworkState, error) {
+	return m.createNetworkStateForNodes(slotNumber, nodeAddresses)
 }
 
 // Get the state of the network at the provided Beacon slot
@@ -83,7 +93,7 @@ func (m *NetworkStateManager) GetStateForSlot(slotNumber uint64) (*NetworkState,
 
 // Gets the latest valid block
 func (m *NetworkStateManager) GetLatestBeaconBlock() (beacon.BeaconBlock, error) {
-	targetSlot, err := m.getHeadSlot()
+	targetSlot, err := m.GetHeadSlot()
 	if err != nil {
 		return beacon.BeaconBlock{}, fmt.Errorf("error getting head slot: %w", err)
 	}
@@ -105,7 +115,7 @@ func (m *NetworkStateManager) GetLatestFinalizedBeaconBlock() (beacon.BeaconBloc
 }
 
 // Gets the Beacon slot for the latest execution layer block
-func (m *NetworkStateManager) getHeadSlot() (uint64, error) {
+func (m *NetworkStateManager) GetHeadSlot() (uint64, error) {
 	beaconConfig, err := m.getBeaconConfig()
 	if err != nil {
 		return 0, fmt.Errorf("error getting Beacon config: %w", err)
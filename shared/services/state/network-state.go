@@ -3,6 +3,7 @@ package state
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math/big"
 	"time"
 
@@ -106,6 +107,10 @@ type NetworkState struct {
 	// Protocol DAO proposals
 	ProtocolDaoProposalDetails []protocol.ProtocolDaoProposalDetails `json:"protocol_dao_proposal_details,omitempty"`
 
+	// The Beacon Chain's pending deposits, partial withdrawals and consolidations for Rocket Pool validators.
+	// This is nil if the Beacon Node couldn't provide them, e.g. for states before Electra, and isn't marshaled to JSON.
+	PendingQueues *PendingQueues `json:"-"`
+
 	IsSaturnDeployed bool
 }
 
@@ -255,6 +260,7 @@ func (m *NetworkStateManager) createNetworkState(slotNumber uint64) (*NetworkSta
 		state.MinipoolDetailsByNode[details.NodeAddress] = nodeList
 	}
 
+	var megapoolNodes map[common.Address]common.Address
 	if isSaturnDeployed {
 		state.MegapoolValidatorGlobalIndex, err = rpstate.GetAllMegapoolValidators(m.rp, contracts)
 		if err != nil {
@@ -282,6 +288,7 @@ func (m *NetworkStateManager) createNetworkState(slotNumber uint64) (*NetworkSta
 
 		// initialize state.MegapoolDetails
 		state.MegapoolDetails = make(map[common.Address]rpstate.NativeMegapoolDetails)
+		megapoolNodes = make(map[common.Address]common.Address, len(megapoolAddressMap))
 		// Sync
 		var wg errgroup.Group
 		// Iterate the maps and query megapool details
@@ -303,6 +310,7 @@ func (m *NetworkStateManager) createNetworkState(slotNumber uint64) (*NetworkSta
 					return err
 				}
 				state.MegapoolDetails[megapoolAddress] = megapoolDetails
+				megapoolNodes[megapoolAddress] = nodeAddress
 				return nil
 			})
 			if err := wg.Wait(); err != nil {
@@ -352,12 +360,26 @@ func (m *NetworkStateManager) createNetworkState(slotNumber uint64) (*NetworkSta
 	state.MinipoolValidatorDetails = statusMap
 	m.logLine("6/6 - Calculated complete node and user balance shares (total time: %s)", time.Since(start))
 
+	// Get the pending Beacon Chain queues
+	nodeAddresses := make(map[types.ValidatorPubkey]common.Address, len(pubkeys)+len(state.MegapoolValidatorDetails))
+	for _, mpd := range state.MinipoolDetails {
+		if mpd.Pubkey != emptyPubkey {
+			nodeAddresses[mpd.Pubkey] = mpd.NodeAddress
+		}
+	}
+	for megapoolAddress, megapoolPubkeys := range state.MegapoolToPubkeysMap {
+		for _, pubkey := range megapoolPubkeys {
+			nodeAddresses[pubkey] = megapoolNodes[megapoolAddress]
+		}
+	}
+	m.loadPendingQueues(state, nodeAddresses)
+
 	return state, nil
 }
 
-// Creates a snapshot of the Rocket Pool network, but only for a single node
-func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeAddress common.Address) (*NetworkState, error) {
-	steps := 5
+// Creates a snapshot of the Rocket Pool network, but only for the provided nodes
+func (m *NetworkStateManager) createNetworkStateForNodes(slotNumber uint64, nodeAddresses []common.Address) (*NetworkState, error) {
+	steps := 7
 
 	// Get the execution block for the given slot
 	beaconBlock, exists, err := m.bc.GetBeaconBlock(fmt.Sprintf("%d", slotNumber))
@@ -409,17 +431,23 @@ func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeA
 	m.logLine("1/%d - Retrieved network details (%s so far)", steps, time.Since(start))
 
 	// Node details
-	nodeDetails, err := rpstate.GetNativeNodeDetails(m.rp, contracts, nodeAddress)
-	if err != nil {
-		return nil, fmt.Errorf("error getting node details: %w", err)
+	state.NodeDetails = make([]rpstate.NativeNodeDetails, len(nodeAddresses))
+	for i, nodeAddress := range nodeAddresses {
+		state.NodeDetails[i], err = rpstate.GetNativeNodeDetails(m.rp, contracts, nodeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("error getting node details for %s: %w", nodeAddress.Hex(), err)
+		}
 	}
-	state.NodeDetails = []rpstate.NativeNodeDetails{nodeDetails}
 	m.logLine("2/%d - Retrieved node details (%s so far)", steps, time.Since(start))
 
 	// Minipool details
-	state.MinipoolDetails, err = rpstate.GetNodeNativeMinipoolDetails(m.rp, contracts, nodeAddress)
-	if err != nil {
-		return nil, fmt.Errorf("error getting all minipool details: %w", err)
+	state.MinipoolDetails = []rpstate.NativeMinipoolDetails{}
+	for _, nodeAddress := range nodeAddresses {
+		minipoolDetails, err := rpstate.GetNodeNativeMinipoolDetails(m.rp, contracts, nodeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("error getting minipool details for node %s: %w", nodeAddress.Hex(), err)
+		}
+		state.MinipoolDetails = append(state.MinipoolDetails, minipoolDetails...)
 	}
 	m.logLine("3/%d - Retrieved minipool details (%s so far)", steps, time.Since(start))
 
@@ -485,6 +513,41 @@ func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeA
 	m.logLine("%d/%d - Calculated complete node and user balance shares (total time: %s)", currentStep, steps, time.Since(start))
 	currentStep++
 
+	// Get the nodes' megapool validators and the pending Beacon Chain queues
+	validatorNodes := make(map[types.ValidatorPubkey]common.Address, len(pubkeys))
+	for _, mpd := range state.MinipoolDetails {
+		if mpd.Pubkey != emptyPubkey {
+			validatorNodes[mpd.Pubkey] = mpd.NodeAddress
+		}
+	}
+	if isSaturnDeployed {
+		state.MegapoolToPubkeysMap = map[common.Address][]types.ValidatorPubkey{}
+		megapoolPubkeys := []types.ValidatorPubkey{}
+		for _, nodeAddress := range nodeAddresses {
+			megapoolAddress, nodePubkeys, err := m.getNodeMegapoolPubkeys(nodeAddress, opts)
+			if err != nil {
+				return nil, fmt.Errorf("error getting megapool validators for node %s: %w", nodeAddress.Hex(), err)
+			}
+			if len(nodePubkeys) == 0 {
+				continue
+			}
+			state.MegapoolToPubkeysMap[megapoolAddress] = nodePubkeys
+			megapoolPubkeys = append(megapoolPubkeys, nodePubkeys...)
+			for _, pubkey := range nodePubkeys {
+				validatorNodes[pubkey] = nodeAddress
+			}
+		}
+		state.MegapoolValidatorDetails, err = m.bc.GetValidatorStatuses(megapoolPubkeys, &beacon.ValidatorStatusOptions{
+			Slot: &slotNumber,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	m.loadPendingQueues(state, validatorNodes)
+	m.logLine("%d/%d - Retrieved pending Beacon Chain queues (total time: %s)", currentStep, steps, time.Since(start))
+	currentStep++
+
 	// Get the protocol DAO proposals
 	state.ProtocolDaoProposalDetails, err = rpstate.GetAllProtocolDaoProposalDetails(m.rp, contracts)
 	if err != nil {
@@ -496,6 +559,67 @@ func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeA
 	return state, nil
 }
 
+// Load the pending Beacon Chain queues into the state, indexed by the nodes that own the provided validators.
+// The queues were added in Electra, so older states are left without them instead of failing.
+func (m *NetworkStateManager) loadPendingQueues(state *NetworkState, nodeAddresses map[types.ValidatorPubkey]common.Address) {
+	statuses := make(map[types.ValidatorPubkey]beacon.ValidatorStatus, len(state.MinipoolValidatorDetails)+len(state.MegapoolValidatorDetails))
+	maps.Copy(statuses, state.MinipoolValidatorDetails)
+	maps.Copy(statuses, state.MegapoolValidatorDetails)
+
+	queues, err := GetPendingQueues(m.bc, fmt.Sprint(state.BeaconSlotNumber), state.BeaconConfig.SlotToEpoch(state.BeaconSlotNumber), nodeAddresses, statuses)
+	if err != nil {
+		m.logLine("WARNING: pending Beacon Chain queues are not available for slot %d: %s", state.BeaconSlotNumber, err.Error())
+		return
+	}
+	state.PendingQueues = queues
+}
+
+// Get the address and validator pubkeys of a node's megapool
+func (m *NetworkStateManager) getNodeMegapoolPubkeys(nodeAddress common.Address, opts *bind.CallOpts) (common.Address, []types.ValidatorPubkey, error) {
+	deployed, err := megapool.GetMegapoolDeployed(m.rp, nodeAddress, opts)
+	if err != nil || !deployed {
+		return common.Address{}, nil, err
+	}
+	megapoolAddress, err := megapool.GetMegapoolExpectedAddress(m.rp, nodeAddress, opts)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	mp, err := megapool.NewMegaPoolV1(m.rp, megapoolAddress, opts)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	validatorCount, err := mp.GetValidatorCount(opts)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	pubkeys := make([]types.ValidatorPubkey, validatorCount)
+	var wg errgroup.Group
+	wg.SetLimit(threadLimit)
+	for i := range validatorCount {
+		wg.Go(func() error {
+			info, err := mp.GetValidatorInfoAndPubkey(i, opts)
+			if err == nil {
+				pubkeys[i] = types.BytesToValidatorPubkey(info.Pubkey)
+			}
+			return err
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return common.Address{}, nil, err
+	}
+
+	// Validators without a pubkey can't be on the Beacon Chain yet
+	emptyPubkey := types.ValidatorPubkey{}
+	filteredPubkeys := make([]types.ValidatorPubkey, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		if pubkey != emptyPubkey {
+			filteredPubkeys = append(filteredPubkeys, pubkey)
+		}
+	}
+	return megapoolAddress, filteredPubkeys, nil
+}
+
 func (s *NetworkState) GetStakedRplValueInEthAndPercentOfBorrowedEth(eligibleBorrowedEth *big.Int, nodeStake *big.Int) (*big.Int, *big.Int) {
 
 	rplPrice := s.NetworkDetails.RplPrice
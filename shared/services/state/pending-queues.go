@@ -0,0 +1,232 @@
+package state
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// The epoch used by the Beacon Chain for events that haven't been scheduled yet
+	farFutureEpoch uint64 = math.MaxUint64
+
+	// The most balance that can be activated or exited per epoch, in gwei. The churn limit scales with the total active
+	// balance but is capped at this value once more than ~16.7 million ETH is staked, which is the case on every
+	// network Rocket Pool runs on, so it's used for the estimates instead of loading every validator.
+	estimatedActivationExitChurn uint64 = 256e9
+
+	// The most pending deposits the Beacon Chain applies per epoch
+	maxPendingDepositsPerEpoch uint64 = 16
+
+	// The balance a validator needs before it can be activated, in gwei
+	minActivationBalance uint64 = 32e9
+
+	// How many epochs it normally takes for an epoch to be finalized; deposits are only applied, and validators are
+	// only activated, once the epoch they were queued in is finalized
+	finalityDelayEpochs uint64 = 2
+
+	// How many epochs after being processed an activation or exit takes effect
+	maxSeedLookahead uint64 = 4
+)
+
+// A Rocket Pool validator with entries in the Beacon Chain's pending queues, or an activation or withdrawal in progress
+type ValidatorQueueDetails struct {
+	Pubkey      types.ValidatorPubkey
+	NodeAddress common.Address
+
+	// The deposits waiting to be applied to the validator; DepositPosition is the position of the last one in the queue
+	PendingDepositCount   int
+	PendingDepositBalance uint64
+	DepositPosition       int
+
+	// The partial withdrawals waiting to be processed for the validator
+	PendingWithdrawalBalance uint64
+
+	// True if the validator is the source of a pending consolidation
+	Consolidating bool
+
+	// The estimated epoch the validator will be activated at, or 0 if no activation is pending
+	EstimatedActivationEpoch uint64
+
+	// The estimated epoch the validator's pending withdrawals will be processed from, or 0 if none are pending.
+	// The balance is paid out by the next withdrawal sweep that reaches the validator after this epoch.
+	EstimatedWithdrawableEpoch uint64
+}
+
+// The pending deposit, partial withdrawal and consolidation queues added to the beacon state in Electra
+type PendingQueues struct {
+	// The epoch the queues were loaded at
+	Epoch uint64
+
+	// The size of each queue across the whole network
+	DepositCount             int
+	DepositBalance           uint64
+	PartialWithdrawalCount   int
+	PartialWithdrawalBalance uint64
+	ConsolidationCount       int
+
+	// The estimated number of epochs it takes for a new deposit to be applied
+	DepositQueueEpochs uint64
+
+	// The Rocket Pool validators that have something in progress
+	Validators []ValidatorQueueDetails
+
+	// Indexes over Validators
+	ValidatorsByPubkey map[types.ValidatorPubkey]*ValidatorQueueDetails
+	ValidatorsByNode   map[common.Address][]*ValidatorQueueDetails
+}
+
+// Load the Beacon Chain's pending queues for the given state and estimate when the provided validators' entries will be processed.
+// nodeAddresses maps each validator to the node that owns it, and statuses holds their Beacon Chain status in the same state.
+func GetPendingQueues(bc beacon.Client, stateId string, currentEpoch uint64, nodeAddresses map[types.ValidatorPubkey]common.Address, statuses map[types.ValidatorPubkey]beacon.ValidatorStatus) (*PendingQueues, error) {
+
+	// Get the queues
+	var wg errgroup.Group
+	var deposits []beacon.PendingDeposit
+	var partialWithdrawals []beacon.PendingPartialWithdrawal
+	var consolidations []beacon.PendingConsolidation
+	wg.Go(func() error {
+		var err error
+		deposits, err = bc.GetPendingDeposits(stateId)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		partialWithdrawals, err = bc.GetPendingPartialWithdrawals(stateId)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		consolidations, err = bc.GetPendingConsolidations(stateId)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, fmt.Errorf("error getting pending Beacon Chain queues: %w", err)
+	}
+
+	queues := &PendingQueues{
+		Epoch:                  currentEpoch,
+		DepositCount:           len(deposits),
+		PartialWithdrawalCount: len(partialWithdrawals),
+		ConsolidationCount:     len(consolidations),
+	}
+	details := map[types.ValidatorPubkey]*ValidatorQueueDetails{}
+	getDetails := func(pubkey types.ValidatorPubkey) *ValidatorQueueDetails {
+		validator, exists := details[pubkey]
+		if !exists {
+			validator = &ValidatorQueueDetails{
+				Pubkey:      pubkey,
+				NodeAddress: nodeAddresses[pubkey],
+			}
+			details[pubkey] = validator
+		}
+		return validator
+	}
+
+	// Partial withdrawals and consolidations refer to validators by index
+	indexPubkeys := make(map[string]types.ValidatorPubkey, len(statuses))
+	for pubkey, status := range statuses {
+		if _, exists := nodeAddresses[pubkey]; exists && status.Exists {
+			indexPubkeys[status.Index] = pubkey
+		}
+	}
+
+	// Deposits are applied in order, limited by the churn and the number of deposits per epoch
+	depositsAppliedEpoch := map[types.ValidatorPubkey]uint64{}
+	for i, deposit := range deposits {
+		balanceAhead := queues.DepositBalance
+		queues.DepositBalance += deposit.Amount
+		if _, exists := nodeAddresses[deposit.Pubkey]; !exists {
+			continue
+		}
+		validator := getDetails(deposit.Pubkey)
+		validator.PendingDepositCount++
+		validator.PendingDepositBalance += deposit.Amount
+		validator.DepositPosition = i
+		depositsAppliedEpoch[deposit.Pubkey] = currentEpoch + getDepositQueueEpochs(balanceAhead+deposit.Amount, uint64(i+1))
+	}
+	queues.DepositQueueEpochs = getDepositQueueEpochs(queues.DepositBalance, uint64(len(deposits)+1))
+
+	for _, withdrawal := range partialWithdrawals {
+		queues.PartialWithdrawalBalance += withdrawal.Amount
+		pubkey, exists := indexPubkeys[withdrawal.ValidatorIndex]
+		if !exists {
+			continue
+		}
+		validator := getDetails(pubkey)
+		validator.PendingWithdrawalBalance += withdrawal.Amount
+		validator.EstimatedWithdrawableEpoch = max(validator.EstimatedWithdrawableEpoch, withdrawal.WithdrawableEpoch)
+	}
+
+	for _, consolidation := range consolidations {
+		pubkey, exists := indexPubkeys[consolidation.SourceIndex]
+		if !exists {
+			continue
+		}
+		getDetails(pubkey).Consolidating = true
+	}
+
+	// Estimate when the validators will be activated and when exiting ones will be withdrawable
+	for pubkey := range nodeAddresses {
+		status := statuses[pubkey]
+		appliedEpoch, hasDeposits := depositsAppliedEpoch[pubkey]
+
+		var activationEpoch uint64
+		switch {
+		case status.Exists && status.ActivationEpoch != farFutureEpoch:
+			if status.ActivationEpoch > currentEpoch {
+				activationEpoch = status.ActivationEpoch
+			}
+		case status.Exists && status.ActivationEligibilityEpoch != farFutureEpoch:
+			activationEpoch = getActivationEpoch(status.ActivationEligibilityEpoch, currentEpoch)
+		case hasDeposits && status.Balance+details[pubkey].PendingDepositBalance >= minActivationBalance:
+			activationEpoch = getActivationEpoch(appliedEpoch+1, currentEpoch)
+		}
+		if activationEpoch != 0 {
+			getDetails(pubkey).EstimatedActivationEpoch = activationEpoch
+		}
+
+		if status.Exists && status.ExitEpoch != farFutureEpoch && status.WithdrawableEpoch > currentEpoch {
+			validator := getDetails(pubkey)
+			validator.EstimatedWithdrawableEpoch = max(validator.EstimatedWithdrawableEpoch, status.WithdrawableEpoch)
+		}
+	}
+
+	// Index the validators by pubkey and by node
+	queues.Validators = make([]ValidatorQueueDetails, 0, len(details))
+	for _, validator := range details {
+		queues.Validators = append(queues.Validators, *validator)
+	}
+	queues.ValidatorsByPubkey = make(map[types.ValidatorPubkey]*ValidatorQueueDetails, len(queues.Validators))
+	queues.ValidatorsByNode = map[common.Address][]*ValidatorQueueDetails{}
+	for i, validator := range queues.Validators {
+		queues.ValidatorsByPubkey[validator.Pubkey] = &queues.Validators[i]
+		queues.ValidatorsByNode[validator.NodeAddress] = append(queues.ValidatorsByNode[validator.NodeAddress], &queues.Validators[i])
+	}
+	return queues, nil
+
+}
+
+// Get the details of the node's validators that have something in progress
+func (q *PendingQueues) GetNodeValidators(nodeAddress common.Address) []*ValidatorQueueDetails {
+	return q.ValidatorsByNode[nodeAddress]
+}
+
+// Get the number of epochs until the deposit queue has applied the given balance and number of deposits
+func getDepositQueueEpochs(balance uint64, count uint64) uint64 {
+	epochs := max(
+		(balance+estimatedActivationExitChurn-1)/estimatedActivationExitChurn,
+		(count+maxPendingDepositsPerEpoch-1)/maxPendingDepositsPerEpoch,
+	)
+	return max(epochs, finalityDelayEpochs)
+}
+
+// Get the epoch a validator that became eligible for activation at the given epoch will be activated at
+func getActivationEpoch(eligibilityEpoch uint64, currentEpoch uint64) uint64 {
+	processedEpoch := max(eligibilityEpoch+finalityDelayEpochs, currentEpoch)
+	return processedEpoch + 1 + maxSeedLookahead
+}
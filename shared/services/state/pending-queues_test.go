@@ -0,0 +1,47 @@
+package state
+
+import "testing"
+
+func TestGetDepositQueueEpochs(t *testing.T) {
+	tests := []struct {
+		name     string
+		balance  uint64
+		count    uint64
+		expected uint64
+	}{
+		{"empty queue waits for finality", 0, 1, finalityDelayEpochs},
+		{"limited by churn", 5 * estimatedActivationExitChurn, 1, 5},
+		{"partial epoch of churn", 5*estimatedActivationExitChurn + 1, 1, 6},
+		{"limited by deposit count", minActivationBalance, 100, 7},
+		{"exact deposit count", minActivationBalance, 3 * maxPendingDepositsPerEpoch, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			epochs := getDepositQueueEpochs(test.balance, test.count)
+			if epochs != test.expected {
+				t.Fatalf("expected %d epochs, got %d", test.expected, epochs)
+			}
+		})
+	}
+}
+
+func TestGetActivationEpoch(t *testing.T) {
+	tests := []struct {
+		name             string
+		eligibilityEpoch uint64
+		currentEpoch     uint64
+		expected         uint64
+	}{
+		{"eligible in the future", 100, 50, 107},
+		{"waiting for finality", 100, 101, 107},
+		{"finalized in the past", 100, 200, 205},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			epoch := getActivationEpoch(test.eligibilityEpoch, test.currentEpoch)
+			if epoch != test.expected {
+				t.Fatalf("expected epoch %d, got %d", test.expected, epoch)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace string = "rocketpool"
+	subsystem string = "tasks"
+)
+
+// Represents the collector for a daemon's scheduled tasks
+type TaskCollector struct {
+	// Whether each task is enabled
+	enabled *prometheus.Desc
+
+	// When each task last ran, as a Unix timestamp
+	lastRun *prometheus.Desc
+
+	// How long each task's last run took, in seconds
+	lastDuration *prometheus.Desc
+
+	// Whether each task's last run failed
+	lastFailed *prometheus.Desc
+
+	// When each task will run next, as a Unix timestamp
+	nextRun *prometheus.Desc
+
+	// The number of times each task has run
+	runsTotal *prometheus.Desc
+
+	// The number of times each task has failed
+	errorsTotal *prometheus.Desc
+
+	// The scheduler running the tasks
+	scheduler *Scheduler
+
+	// The daemon the scheduler belongs to
+	daemonName string
+}
+
+// Create a new TaskCollector instance
+func NewTaskCollector(scheduler *Scheduler, daemonName string) *TaskCollector {
+	labels := []string{"daemon", "task"}
+	return &TaskCollector{
+		enabled: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "enabled"),
+			"Whether each task is enabled",
+			labels, nil,
+		),
+		lastRun: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_run_timestamp_seconds"),
+			"When each task last ran, as a Unix timestamp",
+			labels, nil,
+		),
+		lastDuration: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_duration_seconds"),
+			"How long each task's last run took, in seconds",
+			labels, nil,
+		),
+		lastFailed: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_failed"),
+			"Whether each task's last run failed",
+			labels, nil,
+		),
+		nextRun: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "next_run_timestamp_seconds"),
+			"When each task will run next, as a Unix timestamp",
+			labels, nil,
+		),
+		runsTotal: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "runs_total"),
+			"The number of times each task has run",
+			labels, nil,
+		),
+		errorsTotal: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "errors_total"),
+			"The number of times each task has failed",
+			labels, nil,
+		),
+		scheduler:  scheduler,
+		daemonName: daemonName,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *TaskCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.enabled
+	channel <- collector.lastRun
+	channel <- collector.lastDuration
+	channel <- collector.lastFailed
+	channel <- collector.nextRun
+	channel <- collector.runsTotal
+	channel <- collector.errorsTotal
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *TaskCollector) Collect(channel chan<- prometheus.Metric) {
+	for _, status := range collector.scheduler.GetStatus() {
+		enabled := float64(0)
+		if status.Enabled {
+			enabled = 1
+		}
+		lastFailed := float64(0)
+		if status.LastError != "" {
+			lastFailed = 1
+		}
+
+		channel <- prometheus.MustNewConstMetric(
+			collector.enabled, prometheus.GaugeValue, enabled, collector.daemonName, status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.runsTotal, prometheus.CounterValue, float64(status.RunCount), collector.daemonName, status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.errorsTotal, prometheus.CounterValue, float64(status.ErrorCount), collector.daemonName, status.Name)
+		if !status.LastRun.IsZero() {
+			channel <- prometheus.MustNewConstMetric(
+				collector.lastRun, prometheus.GaugeValue, float64(status.LastRun.Unix()), collector.daemonName, status.Name)
+			channel <- prometheus.MustNewConstMetric(
+				collector.lastDuration, prometheus.GaugeValue, status.LastDuration.Seconds(), collector.daemonName, status.Name)
+			channel <- prometheus.MustNewConstMetric(
+				collector.lastFailed, prometheus.GaugeValue, lastFailed, collector.daemonName, status.Name)
+		}
+		if status.Enabled && !status.NextRun.IsZero() {
+			channel <- prometheus.MustNewConstMetric(
+				collector.nextRun, prometheus.GaugeValue, float64(status.NextRun.Unix()), collector.daemonName, status.Name)
+		}
+	}
+}
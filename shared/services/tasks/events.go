@@ -0,0 +1,107 @@
+package tasks
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Shares one Beacon event stream between every scheduler in the process, so the daemons' task loops don't each hold their own
+type EventHub struct {
+	bc         beacon.Client
+	log        *log.ColorLogger
+	schedulers []*Scheduler
+	topics     []beacon.EventTopic
+	cancel     context.CancelFunc
+	started    bool
+	lock       sync.Mutex
+}
+
+// Create a new event hub. The stream is opened when the first scheduler that listens for events is added.
+func NewEventHub(bc beacon.Client, logger *log.ColorLogger) *EventHub {
+	return &EventHub{
+		bc:  bc,
+		log: logger,
+	}
+}
+
+// Pass the Beacon events a scheduler's tasks react to on to it.
+// The stream is reopened if the scheduler needs topics it doesn't include yet.
+func (h *EventHub) Listen(s *Scheduler) {
+	topics := s.getEventTopics()
+	if len(topics) == 0 {
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.schedulers = append(h.schedulers, s)
+	newTopics := false
+	for _, topic := range topics {
+		if !slices.Contains(h.topics, topic) {
+			h.topics = append(h.topics, topic)
+			newTopics = true
+		}
+	}
+	if !h.started {
+		h.started = true
+		go h.run()
+	} else if newTopics && h.cancel != nil {
+		h.cancel()
+	}
+}
+
+// Hand an event to every scheduler; each one ignores the topics its tasks don't listen for
+func (h *EventHub) dispatch(event beacon.Event) {
+	h.lock.Lock()
+	schedulers := slices.Clone(h.schedulers)
+	h.lock.Unlock()
+
+	for _, s := range schedulers {
+		s.HandleEvent(event)
+	}
+}
+
+// Keep the stream open, reconnecting whenever it fails. This never returns.
+// The tasks still run on their intervals, so the daemons fall back to polling while the stream is down.
+func (h *EventHub) run() {
+	events := make(chan beacon.Event, 16)
+	go func() {
+		for event := range events {
+			h.dispatch(event)
+		}
+	}()
+
+	retryDelay := eventRetryMin
+	for {
+		h.lock.Lock()
+		topics := slices.Clone(h.topics)
+		ctx, cancel := context.WithCancel(context.Background())
+		h.cancel = cancel
+		h.lock.Unlock()
+
+		start := time.Now()
+		err := h.bc.SubscribeEvents(ctx, topics, events)
+		reopened := ctx.Err() != nil
+		cancel()
+		if reopened {
+			// A scheduler added new topics
+			continue
+		}
+
+		if time.Since(start) > eventRetryMax {
+			// The stream was healthy for a while, so this is a new problem
+			retryDelay = eventRetryMin
+		}
+		if err != nil {
+			h.log.Printlnf("Beacon event stream failed, tasks will only run on their intervals until it reconnects in %s: %s", retryDelay, err.Error())
+		}
+		time.Sleep(retryDelay)
+		retryDelay = min(retryDelay*2, eventRetryMax)
+	}
+}
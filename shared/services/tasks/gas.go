@@ -0,0 +1,32 @@
+package tasks
+
+import (
+	"math/big"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// The priority fee to use if the user didn't set one, in gwei
+const defaultPriorityFeeGwei float64 = 2
+
+// Get the max fee and priority fee the user wants the daemon's automatic transactions to use.
+// A nil max fee means it should be estimated when the transaction is sent.
+func GetGasSettings(cfg *config.RocketPoolConfig, logger *log.ColorLogger) (*big.Int, *big.Int) {
+	// Get the user-requested max fee
+	var maxFee *big.Int
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	if maxFeeGwei != 0 {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	if priorityFeeGwei == 0 {
+		logger.Printlnf("WARNING: priority fee was missing or 0, setting a default of %.0f.", defaultPriorityFeeGwei)
+		priorityFeeGwei = defaultPriorityFeeGwei
+	}
+	return maxFee, eth.GweiToWei(priorityFeeGwei)
+}
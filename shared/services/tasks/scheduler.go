@@ -0,0 +1,380 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	// The names of the daemons that run scheduled tasks
+	NodeDaemonName       string = "node"
+	WatchtowerDaemonName string = "watchtower"
+
+	// The minimum time between runs of a task that are triggered by Beacon events, so busy topics like head don't run it every slot
+	eventRunSpacing time.Duration = time.Minute
+
+	// How long to wait before reconnecting to the Beacon event stream after it fails, doubling up to the maximum
+	eventRetryMin time.Duration = 15 * time.Second
+	eventRetryMax time.Duration = 5 * time.Minute
+
+	statusFileMode os.FileMode = 0644
+)
+
+// Get the name the node daemon uses for a node's task, transaction and dry-run files. The primary node has an empty name.
+func GetNodeDaemonName(node string) string {
+	if node == "" {
+		return NodeDaemonName
+	}
+	return NodeDaemonName + "-" + node
+}
+
+// Returned by a task when it doesn't apply to this node right now, such as an Oracle DAO duty on a node that isn't a member
+var ErrNotActive = errors.New("task is not active for this node")
+
+// The function that runs a task. The network state is nil for tasks that run while the daemon doesn't have one.
+type Runner func(state *state.NetworkState) error
+
+// The status of a task, as shown by `rocketpool node tasks` and exported to Prometheus
+type TaskStatus struct {
+	Name         string              `json:"name"`
+	Enabled      bool                `json:"enabled"`
+	Active       bool                `json:"active"`
+	Interval     time.Duration       `json:"interval"`
+	LastRun      time.Time           `json:"lastRun"`
+	LastDuration time.Duration       `json:"lastDuration"`
+	LastError    string              `json:"lastError"`
+	NextRun      time.Time           `json:"nextRun"`
+	RunCount     uint64              `json:"runCount"`
+	ErrorCount   uint64              `json:"errorCount"`
+	Events       []beacon.EventTopic `json:"events,omitempty"`
+}
+
+type scheduledTask struct {
+	status       TaskStatus
+	run          Runner
+	lastEventRun time.Time
+	stateless    bool
+}
+
+// Runs a daemon's tasks on their own intervals, keeping a panic or error in one of them from affecting the others
+type Scheduler struct {
+	tasks      []*scheduledTask
+	statusPath string
+	cooldown   time.Duration
+	jitter     time.Duration
+	disabled   map[string]bool
+	intervals  map[string]time.Duration
+	log        *log.ColorLogger
+	current    string
+	wake       chan struct{}
+	lock       sync.Mutex
+}
+
+// Create a new scheduler for a daemon.
+// The cooldown is how long to wait between tasks that run back-to-back, and a random delay of up to the jitter is added to every interval.
+func NewScheduler(cfg *config.RocketPoolConfig, daemonName string, cooldown time.Duration, jitter time.Duration, errorLog *log.ColorLogger) (*Scheduler, error) {
+	intervals, err := cfg.Smartnode.GetTaskIntervals()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing task intervals: %w", err)
+	}
+	return &Scheduler{
+		tasks:      []*scheduledTask{},
+		statusPath: cfg.Smartnode.GetTaskStatusPath(daemonName),
+		cooldown:   cooldown,
+		jitter:     jitter,
+		disabled:   cfg.Smartnode.GetDisabledTasks(),
+		intervals:  intervals,
+		log:        errorLog,
+		wake:       make(chan struct{}, 1),
+	}, nil
+}
+
+// Add a task to the scheduler. It runs at the default interval unless the user set a custom one, and only if it's enabled and the user didn't disable it.
+func (s *Scheduler) Add(name string, defaultInterval time.Duration, enabled bool, run Runner) {
+	interval, exists := s.intervals[name]
+	if !exists {
+		interval = defaultInterval
+	}
+	s.tasks = append(s.tasks, &scheduledTask{
+		status: TaskStatus{
+			Name:     name,
+			Enabled:  enabled && !s.disabled[name],
+			Active:   true,
+			Interval: interval,
+		},
+		run: run,
+	})
+}
+
+// Also run a task as soon as one of the provided Beacon events arrives, instead of only on its interval.
+// Tasks that listen for head events also run when the chain reorganizes.
+func (s *Scheduler) RunOnEvents(name string, topics ...beacon.EventTopic) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, task := range s.tasks {
+		if task.status.Name == name {
+			task.status.Events = append(task.status.Events, topics...)
+		}
+	}
+}
+
+// Mark tasks that don't use the network state, so the daemon can skip building it when they're the only ones due
+func (s *Scheduler) RunWithoutState(names ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, task := range s.tasks {
+		if slices.Contains(names, task.status.Name) {
+			task.stateless = true
+		}
+	}
+}
+
+// Check whether any of the tasks that are due use the network state
+func (s *Scheduler) DueTasksNeedState() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	for _, task := range s.tasks {
+		if task.status.Enabled && !task.stateless && !now.Before(task.status.NextRun) {
+			return true
+		}
+	}
+	return false
+}
+
+// Make the enabled tasks that listen for an event due, and wake the daemon loop if any of them are
+func (s *Scheduler) HandleEvent(event beacon.Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	topic := event.Topic
+	if topic == beacon.EventTopic_ChainReorg {
+		topic = beacon.EventTopic_Head
+	}
+
+	now := time.Now()
+	triggered := false
+	for _, task := range s.tasks {
+		// Tasks that don't apply to this node don't need to react quickly
+		if !task.status.Enabled || !task.status.Active || !slices.Contains(task.status.Events, topic) {
+			continue
+		}
+		if now.Sub(task.lastEventRun) < eventRunSpacing || now.Sub(task.status.LastRun) < eventRunSpacing {
+			continue
+		}
+		task.lastEventRun = now
+		if task.status.NextRun.After(now) {
+			task.status.NextRun = now
+		}
+		triggered = true
+	}
+
+	if triggered {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Wait until the next task is due, or until a Beacon event makes one due sooner
+func (s *Scheduler) WaitForNextRun() {
+	timer := time.NewTimer(s.TimeUntilNextRun())
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-s.wake:
+	}
+}
+
+// Get the time until the next task needs to run
+func (s *Scheduler) TimeUntilNextRun() time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var next time.Time
+	for _, task := range s.tasks {
+		if task.status.Enabled && (next.IsZero() || task.status.NextRun.Before(next)) {
+			next = task.status.NextRun
+		}
+	}
+	if next.IsZero() {
+		// Nothing is enabled, so just check back later in case that changes
+		return s.cooldown
+	}
+	return max(time.Until(next), s.cooldown)
+}
+
+// Run every enabled task that's due, in the order they were added.
+// Tasks that become due while the others run wait for the next call, since the state may not have been built for them.
+func (s *Scheduler) RunDueTasks(state *state.NetworkState) {
+	now := time.Now()
+	first := true
+	for _, task := range s.tasks {
+		s.lock.Lock()
+		due := task.status.Enabled && !now.Before(task.status.NextRun)
+		s.lock.Unlock()
+		if !due {
+			continue
+		}
+
+		if !first {
+			time.Sleep(s.cooldown)
+		}
+		first = false
+
+		s.lock.Lock()
+		s.current = task.status.Name
+		s.lock.Unlock()
+
+		start := time.Now()
+		err := runTask(task.run, state)
+		s.recordRun(task, start, err)
+		if err != nil && !errors.Is(err, ErrNotActive) {
+			s.log.Printlnf("Task %s failed: %s", task.status.Name, err.Error())
+		}
+	}
+
+	if err := s.save(); err != nil {
+		s.log.Println(err)
+	}
+}
+
+// Get the name of the task that's running, or an empty string if none is
+func (s *Scheduler) CurrentTask() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.current
+}
+
+// Get the status of every task
+func (s *Scheduler) GetStatus() []TaskStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	statuses := make([]TaskStatus, len(s.tasks))
+	for i, task := range s.tasks {
+		statuses[i] = task.status
+	}
+	return statuses
+}
+
+// Load the task statuses a daemon saved, returning an empty list if it hasn't saved any yet
+func LoadStatus(path string) ([]TaskStatus, error) {
+	statuses := []TaskStatus{}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return statuses, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading task status file [%s]: %w", path, err)
+	}
+
+	err = json.Unmarshal(bytes, &statuses)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing task status file [%s]: %w", path, err)
+	}
+	return statuses, nil
+}
+
+// Get the Beacon event topics the enabled tasks listen for
+func (s *Scheduler) getEventTopics() []beacon.EventTopic {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	topics := []beacon.EventTopic{}
+	for _, task := range s.tasks {
+		if !task.status.Enabled {
+			continue
+		}
+		for _, topic := range task.status.Events {
+			if !slices.Contains(topics, topic) {
+				topics = append(topics, topic)
+			}
+		}
+		if slices.Contains(task.status.Events, beacon.EventTopic_Head) && !slices.Contains(topics, beacon.EventTopic_ChainReorg) {
+			topics = append(topics, beacon.EventTopic_ChainReorg)
+		}
+	}
+	return topics
+}
+
+// Run a task, turning a panic into an error so it can't take down the daemon
+func runTask(run Runner, state *state.NetworkState) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return run(state)
+}
+
+// Record the result of a task run and schedule its next one
+func (s *Scheduler) recordRun(task *scheduledTask, start time.Time, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.current = ""
+	now := time.Now()
+	task.status.NextRun = now.Add(task.status.Interval)
+	if s.jitter > 0 {
+		task.status.NextRun = task.status.NextRun.Add(time.Duration(rand.Int63n(int64(s.jitter))))
+	}
+	if errors.Is(err, ErrNotActive) {
+		task.status.Active = false
+		return
+	}
+
+	task.status.Active = true
+	task.status.LastRun = start
+	task.status.LastDuration = now.Sub(start)
+	task.status.RunCount++
+	if err != nil {
+		task.status.LastError = err.Error()
+		task.status.ErrorCount++
+	} else {
+		task.status.LastError = ""
+	}
+}
+
+// Save the task statuses to disk, replacing the file atomically so readers never see a partial write
+func (s *Scheduler) save() error {
+	bytes, err := json.Marshal(s.GetStatus())
+	if err != nil {
+		return fmt.Errorf("error serializing task status: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.statusPath), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating task status directory: %w", err)
+	}
+
+	tmpPath := s.statusPath + ".tmp"
+	err = os.WriteFile(tmpPath, bytes, statusFileMode)
+	if err != nil {
+		return fmt.Errorf("error writing task status file [%s]: %w", tmpPath, err)
+	}
+	err = os.Rename(tmpPath, s.statusPath)
+	if err != nil {
+		return fmt.Errorf("error moving task status file [%s] into place: %w", s.statusPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+func newTestScheduler(t *testing.T) *Scheduler {
+	errorLog := log.NewColorLogger(color.FgRed)
+	return &Scheduler{
+		tasks:      []*scheduledTask{},
+		statusPath: filepath.Join(t.TempDir(), "tasks.json"),
+		disabled:   map[string]bool{"disabled-by-user": true},
+		intervals:  map[string]time.Duration{"custom-interval": time.Hour},
+		log:        &errorLog,
+	}
+}
+
+func TestRunDueTasksIsolatesFailures(t *testing.T) {
+	s := newTestScheduler(t)
+	ran := map[string]bool{}
+	s.Add("panics", time.Minute, true, func(state *state.NetworkState) error {
+		panic("boom")
+	})
+	s.Add("fails", time.Minute, true, func(state *state.NetworkState) error {
+		return errors.New("failed")
+	})
+	s.Add("inactive", time.Minute, true, func(state *state.NetworkState) error {
+		return ErrNotActive
+	})
+	s.Add("succeeds", time.Minute, true, func(state *state.NetworkState) error {
+		ran["succeeds"] = true
+		return nil
+	})
+	s.Add("disabled-by-user", time.Minute, true, func(state *state.NetworkState) error {
+		ran["disabled-by-user"] = true
+		return nil
+	})
+	s.Add("custom-interval", time.Minute, true, func(state *state.NetworkState) error {
+		return nil
+	})
+
+	s.RunDueTasks(nil)
+	if !ran["succeeds"] {
+		t.Error("task after a panicking task didn't run")
+	}
+	if ran["disabled-by-user"] {
+		t.Error("disabled task ran")
+	}
+
+	statuses, err := LoadStatus(s.statusPath)
+	if err != nil {
+		t.Fatalf("error loading task status: %s", err.Error())
+	}
+	byName := map[string]TaskStatus{}
+	for _, status := range statuses {
+		byName[status.Name] = status
+	}
+
+	if byName["panics"].LastError == "" || byName["panics"].ErrorCount != 1 {
+		t.Errorf("panicking task wasn't recorded as failed: %+v", byName["panics"])
+	}
+	if byName["fails"].LastError != "failed" {
+		t.Errorf("unexpected error for failing task: %s", byName["fails"].LastError)
+	}
+	if byName["inactive"].Active || byName["inactive"].RunCount != 0 {
+		t.Errorf("inactive task was recorded as a run: %+v", byName["inactive"])
+	}
+	if byName["succeeds"].RunCount != 1 || byName["succeeds"].LastError != "" {
+		t.Errorf("unexpected status for succeeding task: %+v", byName["succeeds"])
+	}
+	if byName["disabled-by-user"].Enabled {
+		t.Error("task disabled by the user was reported as enabled")
+	}
+	if byName["custom-interval"].Interval != time.Hour {
+		t.Errorf("expected custom interval of 1h, got %s", byName["custom-interval"].Interval)
+	}
+	if !byName["custom-interval"].NextRun.After(time.Now().Add(59 * time.Minute)) {
+		t.Errorf("task with a custom interval was scheduled too early: %s", byName["custom-interval"].NextRun)
+	}
+}
+
+func TestHandleEventTriggersListeningTasks(t *testing.T) {
+	s := newTestScheduler(t)
+	s.wake = make(chan struct{}, 1)
+	noop := func(state *state.NetworkState) error {
+		return nil
+	}
+	s.Add("on-head", time.Hour, true, noop)
+	s.Add("on-exit", time.Hour, true, noop)
+	s.Add("not-listening", time.Hour, true, noop)
+	s.RunOnEvents("on-head", beacon.EventTopic_Head)
+	s.RunOnEvents("on-exit", beacon.EventTopic_VoluntaryExit)
+
+	// Schedule everything an hour out, as if the tasks ran a while ago
+	for _, task := range s.tasks {
+		task.status.LastRun = time.Now().Add(-time.Hour)
+		task.status.NextRun = time.Now().Add(time.Hour)
+	}
+
+	// Reorgs count as head events
+	s.HandleEvent(beacon.Event{Topic: beacon.EventTopic_ChainReorg})
+	select {
+	case <-s.wake:
+	default:
+		t.Fatal("event for a listening task didn't wake the loop")
+	}
+
+	byName := map[string]TaskStatus{}
+	for _, status := range s.GetStatus() {
+		byName[status.Name] = status
+	}
+	if byName["on-head"].NextRun.After(time.Now()) {
+		t.Error("task listening for head events wasn't made due by a reorg")
+	}
+	if !byName["on-exit"].NextRun.After(time.Now()) || !byName["not-listening"].NextRun.After(time.Now()) {
+		t.Error("task that doesn't listen for head events was made due")
+	}
+
+	// A second event right away is ignored so busy topics don't run tasks every slot
+	s.tasks[0].status.NextRun = time.Now().Add(time.Hour)
+	s.HandleEvent(beacon.Event{Topic: beacon.EventTopic_Head})
+	if !s.tasks[0].status.NextRun.After(time.Now()) {
+		t.Error("task was triggered again before the event spacing passed")
+	}
+	select {
+	case <-s.wake:
+		t.Error("ignored event woke the loop")
+	default:
+	}
+}
+
+func TestDueTasksNeedState(t *testing.T) {
+	s := newTestScheduler(t)
+	noop := func(state *state.NetworkState) error {
+		return nil
+	}
+	s.Add("stateless", time.Hour, true, noop)
+	s.Add("uses-state", time.Hour, true, noop)
+	s.RunWithoutState("stateless")
+
+	// Only the stateless task is due
+	s.tasks[1].status.NextRun = time.Now().Add(time.Hour)
+	if s.DueTasksNeedState() {
+		t.Error("expected the state not to be needed when only stateless tasks are due")
+	}
+
+	// The task that uses the state is due too
+	s.tasks[1].status.NextRun = time.Now()
+	if !s.DueTasksNeedState() {
+		t.Error("expected the state to be needed when a task that uses it is due")
+	}
+
+	// Disabled tasks don't count
+	s.tasks[1].status.Enabled = false
+	if s.DueTasksNeedState() {
+		t.Error("expected a disabled task not to need the state")
+	}
+}
+
+// A Beacon client that records its event subscriptions and streams the events the test sends
+type testEventClient struct {
+	beacon.Client
+	subscriptions chan []beacon.EventTopic
+	events        chan beacon.Event
+}
+
+func (c *testEventClient) SubscribeEvents(ctx context.Context, topics []beacon.EventTopic, events chan<- beacon.Event) error {
+	c.subscriptions <- topics
+	for {
+		select {
+		case event := <-c.events:
+			events <- event
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func TestEventHubSharesStream(t *testing.T) {
+	bc := &testEventClient{
+		subscriptions: make(chan []beacon.EventTopic, 4),
+		events:        make(chan beacon.Event),
+	}
+	logger := log.NewColorLogger(color.FgWhite)
+	hub := NewEventHub(bc, &logger)
+
+	noop := func(state *state.NetworkState) error {
+		return nil
+	}
+	schedulers := []*Scheduler{newTestScheduler(t), newTestScheduler(t)}
+	for _, s := range schedulers {
+		s.wake = make(chan struct{}, 1)
+		s.Add("on-head", time.Hour, true, noop)
+		s.RunOnEvents("on-head", beacon.EventTopic_Head)
+		s.tasks[0].status.NextRun = time.Now().Add(time.Hour)
+		hub.Listen(s)
+	}
+
+	// The second scheduler doesn't need new topics, so the stream isn't reopened for it
+	select {
+	case topics := <-bc.subscriptions:
+		if !slices.Contains(topics, beacon.EventTopic_Head) || !slices.Contains(topics, beacon.EventTopic_ChainReorg) {
+			t.Fatalf("unexpected topics: %v", topics)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the hub didn't subscribe to events")
+	}
+	bc.events <- beacon.Event{Topic: beacon.EventTopic_Head}
+	for i, s := range schedulers {
+		select {
+		case <-s.wake:
+		case <-time.After(time.Second):
+			t.Fatalf("scheduler %d wasn't woken by the shared stream", i)
+		}
+	}
+	select {
+	case topics := <-bc.subscriptions:
+		t.Fatalf("expected one subscription, got another one for %v", topics)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// A scheduler that needs a new topic reopens the stream with every topic
+	s := newTestScheduler(t)
+	s.wake = make(chan struct{}, 1)
+	s.Add("on-exit", time.Hour, true, noop)
+	s.RunOnEvents("on-exit", beacon.EventTopic_VoluntaryExit)
+	hub.Listen(s)
+	select {
+	case topics := <-bc.subscriptions:
+		if !slices.Contains(topics, beacon.EventTopic_Head) || !slices.Contains(topics, beacon.EventTopic_VoluntaryExit) {
+			t.Fatalf("unexpected topics after reopening: %v", topics)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the hub didn't reopen the stream for the new topic")
+	}
+}
@@ -0,0 +1,529 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	// Replacements must raise both fees by at least 10% to be accepted by the mempool; use a bit more for headroom
+	feeBumpNumerator   int64 = 1125
+	feeBumpDenominator int64 = 1000
+	minBumpNumerator   int64 = 1100
+
+	// The lock file every daemon's transaction manager holds while assigning a nonce and sending a transaction
+	nonceLockFilename string = "nonce.lock"
+)
+
+// Called when a tracked transaction is included in a block or dropped
+type CompletionHandler func(tx TrackedTransaction)
+
+// Sends transactions for a daemon, assigning their nonces centrally and speeding them up if they get stuck
+type TxManager struct {
+	cfg       *config.RocketPoolConfig
+	w         wallet.Wallet
+	ec        rocketpool.ExecutionClient
+	log       *log.ColorLogger
+	logPrefix string
+	path      string
+	queue     *TransactionQueue
+	lock      *sync.Mutex
+	handlers  map[string]CompletionHandler
+}
+
+// Create a transaction manager for the given daemon, loading any transactions it was tracking before a restart
+func NewTxManager(cfg *config.RocketPoolConfig, w wallet.Wallet, ec rocketpool.ExecutionClient, logger *log.ColorLogger, daemonName string) (*TxManager, error) {
+	path := cfg.Smartnode.GetTransactionQueuePath(daemonName)
+	queue, err := LoadTransactionQueue(path)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &TxManager{
+		cfg:       cfg,
+		w:         w,
+		ec:        ec,
+		log:       logger,
+		logPrefix: "[TX Manager]",
+		path:      path,
+		queue:     queue,
+		lock:      &sync.Mutex{},
+		handlers:  map[string]CompletionHandler{},
+	}
+	if len(queue.Pending) > 0 {
+		m.log.Printlnf("%s Resuming tracking of %d pending transaction(s).", m.logPrefix, len(queue.Pending))
+	}
+	return m, nil
+}
+
+// Register a handler for the transactions with keys starting with the given prefix once they're included in a block or dropped.
+// Handlers are registered when the daemon starts, so transactions that were pending before a restart still reach them.
+func (m *TxManager) OnCompletion(keyPrefix string, handler CompletionHandler) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.handlers[keyPrefix] = handler
+}
+
+// Check if a transaction for the given key is still waiting to be included in a block
+func (m *TxManager) IsPending(key string) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.getPending(key) != nil
+}
+
+// Get a copy of the queue for reporting
+func (m *TxManager) GetQueue() TransactionQueue {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	queue := *m.queue
+	queue.Pending = copyTransactions(m.queue.Pending)
+	queue.Completed = copyTransactions(m.queue.Completed)
+	return queue
+}
+
+// Submit a transaction and start tracking it without waiting for it to be included in a block.
+// The key identifies the action the transaction performs, so callers can avoid submitting it twice.
+// The send function should send the transaction with the provided opts; the manager assigns its nonce.
+func (m *TxManager) Submit(key string, description string, opts *bind.TransactOpts, send func(opts *bind.TransactOpts) error) (*TrackedTransaction, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.getPending(key) != nil {
+		return nil, fmt.Errorf("a transaction to %s is already pending", description)
+	}
+
+	// Keep the other daemons from assigning a nonce until this transaction has been sent and saved
+	unlock, err := m.lockNonces()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// Assign the nonce
+	nonce, err := m.getNextNonce(opts.From)
+	if err != nil {
+		return nil, err
+	}
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+
+	// Capture the signed transaction so it can be replaced later
+	var signedTx *types.Transaction
+	signer := opts.Signer
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		signed, err := signer(address, tx)
+		if err == nil {
+			signedTx = signed
+		}
+		return signed, err
+	}
+	err = send(opts)
+	opts.Signer = signer
+	if err != nil {
+		return nil, err
+	}
+	if signedTx == nil {
+		return nil, fmt.Errorf("error submitting transaction to %s: the transaction was not signed", description)
+	}
+
+	// Dry-run transactions were only simulated, so there's nothing to track
+	now := time.Now()
+	if m.cfg.Smartnode.DryRun.Value == true {
+		m.log.Printlnf("%s Dry run: would have submitted transaction to %s with nonce %d: %s", m.logPrefix, description, signedTx.Nonce(), signedTx.Hash().Hex())
+		return &TrackedTransaction{
+			Key:           key,
+			Description:   description,
+			Status:        TransactionStatus_Pending,
+			From:          opts.From,
+			To:            signedTx.To(),
+			Nonce:         signedTx.Nonce(),
+			Value:         signedTx.Value(),
+			Data:          signedTx.Data(),
+			GasLimit:      signedTx.Gas(),
+			GasFeeCap:     signedTx.GasFeeCap(),
+			GasTipCap:     signedTx.GasTipCap(),
+			Hashes:        []common.Hash{signedTx.Hash()},
+			SubmittedTime: now,
+		}, nil
+	}
+
+	// Track it
+	tx := &TrackedTransaction{
+		Key:               key,
+		Description:       description,
+		Status:            TransactionStatus_Pending,
+		From:              opts.From,
+		To:                signedTx.To(),
+		Nonce:             signedTx.Nonce(),
+		Value:             signedTx.Value(),
+		Data:              signedTx.Data(),
+		GasLimit:          signedTx.Gas(),
+		GasFeeCap:         signedTx.GasFeeCap(),
+		GasTipCap:         signedTx.GasTipCap(),
+		MaxFeeCeiling:     m.getMaxFeeCeiling(signedTx.GasFeeCap()),
+		Hashes:            []common.Hash{signedTx.Hash()},
+		SubmittedTime:     now,
+		LastBroadcastTime: now,
+	}
+	m.queue.Pending = append(m.queue.Pending, tx)
+	m.log.Printlnf("%s Submitted transaction to %s with nonce %d: %s", m.logPrefix, description, tx.Nonce, tx.LatestHash().Hex())
+
+	err = m.queue.save(m.path)
+	if err != nil {
+		return nil, err
+	}
+	txCopy := *tx
+	return &txCopy, nil
+}
+
+// Check the pending transactions for inclusion, and speed up any that have been stuck for too long
+func (m *TxManager) Update() error {
+	completed, err := m.updatePending()
+
+	// Run the handlers outside of the lock so they can use the manager
+	for _, tx := range completed {
+		for prefix, handler := range m.getHandlers() {
+			if strings.HasPrefix(tx.Key, prefix) {
+				handler(tx)
+			}
+		}
+	}
+	return err
+}
+
+// Check the pending transactions for inclusion and speed up stuck ones, returning the transactions that finished
+func (m *TxManager) updatePending() ([]TrackedTransaction, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if len(m.queue.Pending) == 0 {
+		return nil, nil
+	}
+
+	timeout := time.Duration(m.cfg.Smartnode.StuckTxTimeout.Value.(uint64)) * time.Minute
+	latestNonces := map[common.Address]uint64{}
+	changed := false
+	completed := []TrackedTransaction{}
+	errs := []error{}
+
+	for i := 0; i < len(m.queue.Pending); i++ {
+		tx := m.queue.Pending[i]
+
+		// Check if any of its broadcasts made it into a block
+		receipt, err := m.getReceipt(tx)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if receipt != nil {
+			completed = append(completed, m.completeMined(i, receipt))
+			i--
+			changed = true
+			continue
+		}
+
+		// Check if the nonce was used by a different transaction
+		latestNonce, exists := latestNonces[tx.From]
+		if !exists {
+			latestNonce, err = m.ec.NonceAt(context.Background(), tx.From, nil)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error getting latest nonce for %s: %w", tx.From.Hex(), err))
+				continue
+			}
+			latestNonces[tx.From] = latestNonce
+		}
+		if tx.Nonce < latestNonce {
+			// It may have been included after its receipt was checked, so check again before calling it dropped
+			receipt, err = m.getReceipt(tx)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if receipt != nil {
+				completed = append(completed, m.completeMined(i, receipt))
+			} else {
+				m.log.Printlnf("%s Transaction to %s was dropped because nonce %d was used by another transaction.", m.logPrefix, tx.Description, tx.Nonce)
+				m.queue.complete(i, TransactionStatus_Dropped)
+				completed = append(completed, *tx)
+			}
+			i--
+			changed = true
+			continue
+		}
+
+		// Speed it up if it's stuck
+		if timeout == 0 || time.Since(tx.LastBroadcastTime) < timeout {
+			continue
+		}
+		replaced, err := m.speedUp(tx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error speeding up transaction to %s: %w", tx.Description, err))
+			continue
+		}
+		if replaced {
+			m.queue.ReplacementCount++
+		}
+		changed = true
+	}
+
+	if changed {
+		err := m.queue.save(m.path)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return completed, errors.Join(errs...)
+}
+
+// Move a pending transaction that was included in a block into the history, returning a copy of it
+func (m *TxManager) completeMined(index int, receipt *types.Receipt) TrackedTransaction {
+	tx := m.queue.Pending[index]
+	tx.MinedHash = receipt.TxHash
+	tx.BlockNumber = receipt.BlockNumber.Uint64()
+	status := TransactionStatus_Confirmed
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		status = TransactionStatus_Reverted
+	}
+	m.log.Printlnf("%s Transaction to %s was included in block %d with status %s: %s", m.logPrefix, tx.Description, tx.BlockNumber, status, tx.MinedHash.Hex())
+	m.queue.complete(index, status)
+	return *tx
+}
+
+// Get a copy of the completion handlers
+func (m *TxManager) getHandlers() map[string]CompletionHandler {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	handlers := make(map[string]CompletionHandler, len(m.handlers))
+	for prefix, handler := range m.handlers {
+		handlers[prefix] = handler
+	}
+	return handlers
+}
+
+// Get the pending transaction for a key
+func (m *TxManager) getPending(key string) *TrackedTransaction {
+	for _, tx := range m.queue.Pending {
+		if tx.Key == key {
+			return tx
+		}
+	}
+	return nil
+}
+
+// Take the lock shared by every daemon's transaction manager, returning a function that releases it
+func (m *TxManager) lockNonces() (func(), error) {
+	lockPath := filepath.Join(filepath.Dir(m.path), nonceLockFilename)
+	err := os.MkdirAll(filepath.Dir(lockPath), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("error creating transaction queue directory: %w", err)
+	}
+	file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, queueFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("error opening nonce lock file [%s]: %w", lockPath, err)
+	}
+	err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error locking nonce lock file [%s]: %w", lockPath, err)
+	}
+	return func() {
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		file.Close()
+	}, nil
+}
+
+// Get the next nonce to use, accounting for pending transactions the client may have dropped from its mempool.
+// The node and watchtower daemons send from the same account, so the pending transactions in every daemon's queue are used.
+func (m *TxManager) getNextNonce(from common.Address) (uint64, error) {
+	nonce, err := m.ec.PendingNonceAt(context.Background(), from)
+	if err != nil {
+		return 0, fmt.Errorf("error getting pending nonce for %s: %w", from.Hex(), err)
+	}
+	pending, err := m.getAllPending()
+	if err != nil {
+		return 0, err
+	}
+	for _, tx := range pending {
+		if tx.From == from && tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+	return nonce, nil
+}
+
+// Get the pending transactions of this daemon and every other daemon that keeps a queue next to this one
+func (m *TxManager) getAllPending() ([]*TrackedTransaction, error) {
+	pending := append([]*TrackedTransaction{}, m.queue.Pending...)
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(m.path), fmt.Sprintf(config.TransactionQueueFilenameFormat, "*")))
+	if err != nil {
+		return nil, fmt.Errorf("error finding transaction queues: %w", err)
+	}
+	for _, path := range paths {
+		if path == m.path {
+			continue
+		}
+		queue, err := LoadTransactionQueue(path)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, queue.Pending...)
+	}
+	return pending, nil
+}
+
+// Get the receipt for whichever broadcast of a transaction was included in a block, or nil if none were
+func (m *TxManager) getReceipt(tx *TrackedTransaction) (*types.Receipt, error) {
+	for i := len(tx.Hashes) - 1; i >= 0; i-- {
+		receipt, err := m.ec.TransactionReceipt(context.Background(), tx.Hashes[i])
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting receipt for transaction %s: %w", tx.Hashes[i].Hex(), err)
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+	}
+	return nil, nil
+}
+
+// Get the most a transaction's max fee can be raised to when it's sped up
+func (m *TxManager) getMaxFeeCeiling(originalMaxFee *big.Int) *big.Int {
+	ceiling := originalMaxFee
+	if manualMaxFee := m.cfg.Smartnode.ManualMaxFee.Value.(float64); manualMaxFee > 0 {
+		ceiling = eth.GweiToWei(manualMaxFee)
+	} else if threshold := m.cfg.Smartnode.AutoTxGasThreshold.Value.(float64); threshold > 0 {
+		ceiling = eth.GweiToWei(threshold)
+	}
+
+	// Never lower the fee the transaction was originally sent with
+	if ceiling.Cmp(originalMaxFee) < 0 {
+		ceiling = originalMaxFee
+	}
+	return new(big.Int).Set(ceiling)
+}
+
+// Replace a stuck transaction with a copy that pays higher fees. Returns false if the fees can't be raised enough;
+// the transaction is broadcast again at the same fees instead, so a client that dropped it from its mempool doesn't leave a gap at its nonce.
+func (m *TxManager) speedUp(tx *TrackedTransaction) (bool, error) {
+	newTipCap, newFeeCap, err := m.getReplacementFees(tx)
+	if err != nil {
+		return false, err
+	}
+	replaced := newFeeCap.Cmp(bumpFee(tx.GasFeeCap, minBumpNumerator)) >= 0 && newTipCap.Cmp(bumpFee(tx.GasTipCap, minBumpNumerator)) >= 0
+	if !replaced {
+		newTipCap = tx.GasTipCap
+		newFeeCap = tx.GasFeeCap
+	}
+
+	// Sign the new broadcast
+	opts, err := m.w.GetNodeAccountTransactor()
+	if err != nil {
+		return false, err
+	}
+	if opts.From != tx.From {
+		return false, fmt.Errorf("the node wallet is now %s, but the transaction was sent from %s", opts.From.Hex(), tx.From.Hex())
+	}
+	signedTx, err := opts.Signer(tx.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   new(big.Int).SetUint64(uint64(m.cfg.Smartnode.GetChainID())),
+		Nonce:     tx.Nonce,
+		GasTipCap: newTipCap,
+		GasFeeCap: newFeeCap,
+		Gas:       tx.GasLimit,
+		To:        tx.To,
+		Value:     tx.Value,
+		Data:      tx.Data,
+	}))
+	if err != nil {
+		return false, fmt.Errorf("error signing replacement transaction: %w", err)
+	}
+
+	// Send it
+	err = m.ec.SendTransaction(context.Background(), signedTx)
+	if err != nil && !strings.Contains(err.Error(), "already known") {
+		return false, fmt.Errorf("error sending replacement transaction: %w", err)
+	}
+	tx.LastBroadcastTime = time.Now()
+
+	if !replaced {
+		m.log.Printlnf("%s Transaction to %s has been pending since %s but is already at the max fee of %.6f gwei, so it was broadcast again without speeding it up.", m.logPrefix, tx.Description, tx.SubmittedTime.Format(time.RFC3339), eth.WeiToGwei(tx.MaxFeeCeiling))
+		if signedTx.Hash() != tx.LatestHash() {
+			tx.Hashes = append(tx.Hashes, signedTx.Hash())
+		}
+		return false, nil
+	}
+
+	m.log.Printlnf("%s Transaction to %s was stuck, sped it up with a max fee of %.6f gwei and a priority fee of %.6f gwei: %s", m.logPrefix, tx.Description, eth.WeiToGwei(newFeeCap), eth.WeiToGwei(newTipCap), signedTx.Hash().Hex())
+	tx.GasFeeCap = newFeeCap
+	tx.GasTipCap = newTipCap
+	tx.Hashes = append(tx.Hashes, signedTx.Hash())
+	return true, nil
+}
+
+// Get the fees to replace a stuck transaction with, which cover the current base fee and stay within its max fee ceiling
+func (m *TxManager) getReplacementFees(tx *TrackedTransaction) (*big.Int, *big.Int, error) {
+	newTipCap := bumpFee(tx.GasTipCap, feeBumpNumerator)
+	newFeeCap := bumpFee(tx.GasFeeCap, feeBumpNumerator)
+
+	// Make sure the new max fee covers the current base fee with room to spare
+	header, err := m.ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting latest block header: %w", err)
+	}
+	if header.BaseFee != nil {
+		minFeeCap := new(big.Int).Mul(header.BaseFee, big.NewInt(2))
+		minFeeCap.Add(minFeeCap, newTipCap)
+		if newFeeCap.Cmp(minFeeCap) < 0 {
+			newFeeCap = minFeeCap
+		}
+	}
+
+	// Stay within the configured max fee
+	if newFeeCap.Cmp(tx.MaxFeeCeiling) > 0 {
+		newFeeCap = new(big.Int).Set(tx.MaxFeeCeiling)
+	}
+	if newTipCap.Cmp(newFeeCap) > 0 {
+		newTipCap = new(big.Int).Set(newFeeCap)
+	}
+	return newTipCap, newFeeCap, nil
+}
+
+// Raise a fee by the given amount, in thousandths
+func bumpFee(fee *big.Int, numerator int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(numerator))
+	bumped.Div(bumped, big.NewInt(feeBumpDenominator))
+	return bumped.Add(bumped, big.NewInt(1))
+}
+
+// Make shallow copies of transactions so callers can't modify the queue
+func copyTransactions(txs []*TrackedTransaction) []*TrackedTransaction {
+	copies := make([]*TrackedTransaction, len(txs))
+	for i, tx := range txs {
+		txCopy := *tx
+		txCopy.Hashes = append([]common.Hash{}, tx.Hashes...)
+		copies[i] = &txCopy
+	}
+	return copies
+}
@@ -0,0 +1,296 @@
+package txmanager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fatih/color"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// An Execution Client with a fixed chain state
+type testExecutionClient struct {
+	rocketpool.ExecutionClient
+	pendingNonce uint64
+	latestNonce  uint64
+	baseFee      *big.Int
+	receipts     map[common.Hash]*types.Receipt
+	sent         []*types.Transaction
+}
+
+func (c *testExecutionClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *testExecutionClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return c.latestNonce, nil
+}
+
+func (c *testExecutionClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, exists := c.receipts[txHash]
+	if !exists {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (c *testExecutionClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: c.baseFee}, nil
+}
+
+func (c *testExecutionClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.sent = append(c.sent, tx)
+	return nil
+}
+
+// A node wallet that signs with a fixed key
+type testWallet struct {
+	wallet.Wallet
+	opts *bind.TransactOpts
+}
+
+func (w *testWallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
+	return w.opts, nil
+}
+
+// Create a manager with its queue in a temporary folder
+func newTestManager(t *testing.T, ec *testExecutionClient) (*TxManager, *testWallet) {
+	cfg := config.NewRocketPoolConfig("", false)
+	cfg.Smartnode.StuckTxTimeout.Value = uint64(1)
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, new(big.Int).SetUint64(uint64(cfg.Smartnode.GetChainID())))
+	if err != nil {
+		t.Fatalf("error creating transactor: %v", err)
+	}
+	logger := log.NewColorLogger(color.FgWhite)
+	w := &testWallet{opts: opts}
+	return &TxManager{
+		cfg:      cfg,
+		w:        w,
+		ec:       ec,
+		log:      &logger,
+		path:     filepath.Join(t.TempDir(), fmt.Sprintf(config.TransactionQueueFilenameFormat, NodeDaemonName)),
+		queue:    &TransactionQueue{Pending: []*TrackedTransaction{}, Completed: []*TrackedTransaction{}},
+		lock:     &sync.Mutex{},
+		handlers: map[string]CompletionHandler{},
+	}, w
+}
+
+// Create a pending transaction that was last broadcast long enough ago to be stuck
+func newPendingTransaction(from common.Address, nonce uint64, fee int64) *TrackedTransaction {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	submitted := time.Now().Add(-time.Hour)
+	return &TrackedTransaction{
+		Key:               fmt.Sprintf("test-%d", nonce),
+		Description:       fmt.Sprintf("test %d", nonce),
+		Status:            TransactionStatus_Pending,
+		From:              from,
+		To:                &to,
+		Nonce:             nonce,
+		Value:             big.NewInt(0),
+		GasLimit:          21000,
+		GasFeeCap:         big.NewInt(fee),
+		GasTipCap:         big.NewInt(fee / 10),
+		MaxFeeCeiling:     big.NewInt(fee),
+		Hashes:            []common.Hash{common.BigToHash(new(big.Int).SetUint64(nonce + 1))},
+		SubmittedTime:     submitted,
+		LastBroadcastTime: submitted,
+	}
+}
+
+func TestBumpFee(t *testing.T) {
+	tests := []struct {
+		fee       int64
+		numerator int64
+		expected  int64
+	}{
+		{0, feeBumpNumerator, 1},
+		{1000, feeBumpNumerator, 1126},
+		{1000, minBumpNumerator, 1101},
+		{999, minBumpNumerator, 1099},
+	}
+	for _, test := range tests {
+		bumped := bumpFee(big.NewInt(test.fee), test.numerator)
+		if bumped.Cmp(big.NewInt(test.expected)) != 0 {
+			t.Fatalf("expected %d bumped by %d to be %d, got %s", test.fee, test.numerator, test.expected, bumped)
+		}
+	}
+}
+
+func TestQueueComplete(t *testing.T) {
+	from := common.HexToAddress("0x01")
+	queue := &TransactionQueue{}
+	for i := range uint64(completedHistoryLimit + 3) {
+		queue.Pending = append(queue.Pending, newPendingTransaction(from, i, 1000))
+	}
+
+	queue.complete(1, TransactionStatus_Confirmed)
+	queue.complete(0, TransactionStatus_Reverted)
+	queue.complete(0, TransactionStatus_Dropped)
+	if queue.ConfirmedCount != 1 || queue.RevertedCount != 1 || queue.DroppedCount != 1 {
+		t.Fatalf("expected one of each status, got %d confirmed, %d reverted and %d dropped", queue.ConfirmedCount, queue.RevertedCount, queue.DroppedCount)
+	}
+	if queue.Pending[0].Nonce != 3 {
+		t.Fatalf("expected nonce 3 to be the first pending transaction, got %d", queue.Pending[0].Nonce)
+	}
+	if queue.Completed[0].Nonce != 1 || queue.Completed[0].Status != TransactionStatus_Confirmed || queue.Completed[0].CompletedTime.IsZero() {
+		t.Fatalf("expected nonce 1 to be confirmed first, got nonce %d with status %s", queue.Completed[0].Nonce, queue.Completed[0].Status)
+	}
+
+	// Only the most recent history is kept
+	for len(queue.Pending) > 0 {
+		queue.complete(0, TransactionStatus_Confirmed)
+	}
+	if len(queue.Completed) != completedHistoryLimit {
+		t.Fatalf("expected %d completed transactions, got %d", completedHistoryLimit, len(queue.Completed))
+	}
+	if last := queue.Completed[len(queue.Completed)-1]; last.Nonce != uint64(completedHistoryLimit+2) {
+		t.Fatalf("expected the last completed transaction to have nonce %d, got %d", completedHistoryLimit+2, last.Nonce)
+	}
+}
+
+func TestGetNextNonce(t *testing.T) {
+	ec := &testExecutionClient{pendingNonce: 5}
+	m, w := newTestManager(t, ec)
+	from := w.opts.From
+	other := common.HexToAddress("0x02")
+
+	nonce, err := m.getNextNonce(from)
+	if err != nil {
+		t.Fatalf("error getting nonce: %v", err)
+	}
+	if nonce != 5 {
+		t.Fatalf("expected the client's pending nonce of 5, got %d", nonce)
+	}
+
+	// Pending transactions the client may have dropped still hold their nonces
+	m.queue.Pending = append(m.queue.Pending,
+		newPendingTransaction(from, 3, 1000),
+		newPendingTransaction(from, 6, 1000),
+		newPendingTransaction(other, 20, 1000),
+	)
+	nonce, err = m.getNextNonce(from)
+	if err != nil {
+		t.Fatalf("error getting nonce: %v", err)
+	}
+	if nonce != 7 {
+		t.Fatalf("expected nonce 7, got %d", nonce)
+	}
+
+	// So do the ones in the other daemons' queues
+	watchtowerQueue := &TransactionQueue{Pending: []*TrackedTransaction{newPendingTransaction(from, 9, 1000)}}
+	err = watchtowerQueue.save(filepath.Join(filepath.Dir(m.path), fmt.Sprintf(config.TransactionQueueFilenameFormat, WatchtowerDaemonName)))
+	if err != nil {
+		t.Fatalf("error saving queue: %v", err)
+	}
+	nonce, err = m.getNextNonce(from)
+	if err != nil {
+		t.Fatalf("error getting nonce: %v", err)
+	}
+	if nonce != 10 {
+		t.Fatalf("expected nonce 10, got %d", nonce)
+	}
+}
+
+func TestUpdatePending(t *testing.T) {
+	ec := &testExecutionClient{
+		latestNonce: 3,
+		receipts:    map[common.Hash]*types.Receipt{},
+	}
+	m, w := newTestManager(t, ec)
+	m.cfg.Smartnode.StuckTxTimeout.Value = uint64(0)
+	from := w.opts.From
+
+	confirmed := newPendingTransaction(from, 0, 1000)
+	reverted := newPendingTransaction(from, 1, 1000)
+	dropped := newPendingTransaction(from, 2, 1000)
+	pending := newPendingTransaction(from, 3, 1000)
+	ec.receipts[confirmed.LatestHash()] = &types.Receipt{TxHash: confirmed.LatestHash(), BlockNumber: big.NewInt(100), Status: types.ReceiptStatusSuccessful}
+	ec.receipts[reverted.LatestHash()] = &types.Receipt{TxHash: reverted.LatestHash(), BlockNumber: big.NewInt(101), Status: types.ReceiptStatusFailed}
+	m.queue.Pending = append(m.queue.Pending, confirmed, reverted, dropped, pending)
+
+	completed, err := m.updatePending()
+	if err != nil {
+		t.Fatalf("error updating transactions: %v", err)
+	}
+	expected := map[uint64]TransactionStatus{
+		0: TransactionStatus_Confirmed,
+		1: TransactionStatus_Reverted,
+		2: TransactionStatus_Dropped,
+	}
+	if len(completed) != len(expected) {
+		t.Fatalf("expected %d completed transactions, got %d", len(expected), len(completed))
+	}
+	for _, tx := range completed {
+		if tx.Status != expected[tx.Nonce] {
+			t.Fatalf("expected nonce %d to be %s, got %s", tx.Nonce, expected[tx.Nonce], tx.Status)
+		}
+	}
+	if completed[0].BlockNumber != 100 || completed[0].MinedHash != confirmed.LatestHash() {
+		t.Fatalf("expected nonce 0 to be mined in block 100, got block %d", completed[0].BlockNumber)
+	}
+	if len(m.queue.Pending) != 1 || m.queue.Pending[0].Nonce != 3 {
+		t.Fatalf("expected only nonce 3 to still be pending, got %d pending", len(m.queue.Pending))
+	}
+
+	// The queue is saved with the results
+	queue, err := LoadTransactionQueue(m.path)
+	if err != nil {
+		t.Fatalf("error loading queue: %v", err)
+	}
+	if queue.ConfirmedCount != 1 || queue.RevertedCount != 1 || queue.DroppedCount != 1 || len(queue.Pending) != 1 {
+		t.Fatalf("expected the saved queue to match, got %d confirmed, %d reverted, %d dropped and %d pending", queue.ConfirmedCount, queue.RevertedCount, queue.DroppedCount, len(queue.Pending))
+	}
+}
+
+func TestSpeedUp(t *testing.T) {
+	ec := &testExecutionClient{baseFee: big.NewInt(100)}
+	m, w := newTestManager(t, ec)
+
+	// A transaction with room under its ceiling is replaced with a higher fee
+	tx := newPendingTransaction(w.opts.From, 0, 1000)
+	tx.MaxFeeCeiling = big.NewInt(2000)
+	replaced, err := m.speedUp(tx)
+	if err != nil {
+		t.Fatalf("error speeding up transaction: %v", err)
+	}
+	if !replaced || tx.GasFeeCap.Cmp(big.NewInt(1126)) != 0 || tx.GasTipCap.Cmp(big.NewInt(113)) != 0 {
+		t.Fatalf("expected the fees to be bumped to 1126 and 113, got %s and %s", tx.GasFeeCap, tx.GasTipCap)
+	}
+	if len(ec.sent) != 1 || len(tx.Hashes) != 2 || tx.LatestHash() != ec.sent[0].Hash() {
+		t.Fatalf("expected the replacement to be sent and tracked, got %d sent and %d hashes", len(ec.sent), len(tx.Hashes))
+	}
+
+	// A transaction at its ceiling is broadcast again at the same fee so its nonce isn't left empty
+	tx = newPendingTransaction(w.opts.From, 1, 1000)
+	lastBroadcast := tx.LastBroadcastTime
+	replaced, err = m.speedUp(tx)
+	if err != nil {
+		t.Fatalf("error rebroadcasting transaction: %v", err)
+	}
+	if replaced {
+		t.Fatal("expected the transaction not to be replaced")
+	}
+	if len(ec.sent) != 2 || ec.sent[1].Nonce() != 1 || ec.sent[1].GasFeeCap().Cmp(big.NewInt(1000)) != 0 || ec.sent[1].GasTipCap().Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("expected nonce 1 to be sent again with the same fees")
+	}
+	if !tx.LastBroadcastTime.After(lastBroadcast) || tx.LatestHash() != ec.sent[1].Hash() {
+		t.Fatal("expected the rebroadcast to be tracked")
+	}
+}
@@ -0,0 +1,150 @@
+package txmanager
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+)
+
+const (
+	// The names of the daemons that keep their own transaction queues
+	NodeDaemonName       string = "node"
+	WatchtowerDaemonName string = "watchtower"
+
+	// The number of finished transactions to keep in the queue's history
+	completedHistoryLimit int = 50
+
+	queueFileMode os.FileMode = 0644
+)
+
+// The status of a tracked transaction
+type TransactionStatus string
+
+const (
+	TransactionStatus_Pending   TransactionStatus = "pending"
+	TransactionStatus_Confirmed TransactionStatus = "confirmed"
+	TransactionStatus_Reverted  TransactionStatus = "reverted"
+	TransactionStatus_Dropped   TransactionStatus = "dropped"
+)
+
+// A transaction submitted through the manager, along with everything needed to replace it
+type TrackedTransaction struct {
+	Key               string            `json:"key"`
+	Description       string            `json:"description"`
+	Status            TransactionStatus `json:"status"`
+	From              common.Address    `json:"from"`
+	To                *common.Address   `json:"to"`
+	Nonce             uint64            `json:"nonce"`
+	Value             *big.Int          `json:"value"`
+	Data              hexutil.Bytes     `json:"data"`
+	GasLimit          uint64            `json:"gasLimit"`
+	GasFeeCap         *big.Int          `json:"gasFeeCap"`
+	GasTipCap         *big.Int          `json:"gasTipCap"`
+	MaxFeeCeiling     *big.Int          `json:"maxFeeCeiling"`
+	Hashes            []common.Hash     `json:"hashes"`
+	MinedHash         common.Hash       `json:"minedHash"`
+	BlockNumber       uint64            `json:"blockNumber"`
+	SubmittedTime     time.Time         `json:"submittedTime"`
+	LastBroadcastTime time.Time         `json:"lastBroadcastTime"`
+	CompletedTime     time.Time         `json:"completedTime"`
+}
+
+// Get the hash of the most recent broadcast of the transaction
+func (t *TrackedTransaction) LatestHash() common.Hash {
+	if len(t.Hashes) == 0 {
+		return common.Hash{}
+	}
+	return t.Hashes[len(t.Hashes)-1]
+}
+
+// Get the number of times the transaction has been replaced with a higher fee
+func (t *TrackedTransaction) ReplacementCount() int {
+	if len(t.Hashes) == 0 {
+		return 0
+	}
+	return len(t.Hashes) - 1
+}
+
+// A daemon's transaction queue, as stored on disk
+type TransactionQueue struct {
+	Pending          []*TrackedTransaction `json:"pending"`
+	Completed        []*TrackedTransaction `json:"completed"`
+	ConfirmedCount   uint64                `json:"confirmedCount"`
+	RevertedCount    uint64                `json:"revertedCount"`
+	DroppedCount     uint64                `json:"droppedCount"`
+	ReplacementCount uint64                `json:"replacementCount"`
+}
+
+// Load a transaction queue from disk, returning an empty queue if it doesn't exist yet
+func LoadTransactionQueue(path string) (*TransactionQueue, error) {
+	queue := &TransactionQueue{
+		Pending:   []*TrackedTransaction{},
+		Completed: []*TrackedTransaction{},
+	}
+
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return queue, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading transaction queue file [%s]: %w", path, err)
+	}
+
+	err = json.Unmarshal(bytes, queue)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing transaction queue file [%s]: %w", path, err)
+	}
+	return queue, nil
+}
+
+// Save the queue to disk, replacing the file atomically so readers never see a partial write
+func (q *TransactionQueue) save(path string) error {
+	bytes, err := json.Marshal(q)
+	if err != nil {
+		return fmt.Errorf("error serializing transaction queue: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating transaction queue directory: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	err = os.WriteFile(tmpPath, bytes, queueFileMode)
+	if err != nil {
+		return fmt.Errorf("error writing transaction queue file [%s]: %w", tmpPath, err)
+	}
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return fmt.Errorf("error moving transaction queue file [%s] into place: %w", path, err)
+	}
+	return nil
+}
+
+// Move a finished transaction from the pending list into the history
+func (q *TransactionQueue) complete(index int, status TransactionStatus) {
+	tx := q.Pending[index]
+	tx.Status = status
+	tx.CompletedTime = time.Now()
+	q.Pending = append(q.Pending[:index], q.Pending[index+1:]...)
+
+	switch status {
+	case TransactionStatus_Confirmed:
+		q.ConfirmedCount++
+	case TransactionStatus_Reverted:
+		q.RevertedCount++
+	case TransactionStatus_Dropped:
+		q.DroppedCount++
+	}
+
+	q.Completed = append(q.Completed, tx)
+	if len(q.Completed) > completedHistoryLimit {
+		q.Completed = q.Completed[len(q.Completed)-completedHistoryLimit:]
+	}
+}
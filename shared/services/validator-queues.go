@@ -0,0 +1,62 @@
+package services
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get estimates of when the pending Beacon Chain operations of a node's validators will take effect
+func GetValidatorQueueEstimates(bc beacon.Client, nodeAddress common.Address, pubkeys []types.ValidatorPubkey) (map[types.ValidatorPubkey]api.ValidatorQueueEstimates, error) {
+
+	estimates := map[types.ValidatorPubkey]api.ValidatorQueueEstimates{}
+	if len(pubkeys) == 0 {
+		return estimates, nil
+	}
+
+	// Get the Beacon Chain details
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the queues
+	nodeAddresses := make(map[types.ValidatorPubkey]common.Address, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		nodeAddresses[pubkey] = nodeAddress
+	}
+	queues, err := state.GetPendingQueues(bc, "head", head.Epoch, nodeAddresses, statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, validator := range queues.GetNodeValidators(nodeAddress) {
+		estimate := api.ValidatorQueueEstimates{
+			PendingDepositBalance:      validator.PendingDepositBalance,
+			DepositQueuePosition:       validator.DepositPosition,
+			EstimatedActivationEpoch:   validator.EstimatedActivationEpoch,
+			PendingWithdrawalBalance:   validator.PendingWithdrawalBalance,
+			Consolidating:              validator.Consolidating,
+			EstimatedWithdrawableEpoch: validator.EstimatedWithdrawableEpoch,
+		}
+		if estimate.EstimatedActivationEpoch != 0 {
+			estimate.EstimatedActivationTime = eth2Config.GetSlotTime(eth2Config.FirstSlotOfEpoch(estimate.EstimatedActivationEpoch))
+		}
+		if estimate.EstimatedWithdrawableEpoch != 0 {
+			estimate.EstimatedWithdrawableTime = eth2Config.GetSlotTime(eth2Config.FirstSlotOfEpoch(estimate.EstimatedWithdrawableEpoch))
+		}
+		estimates[validator.Pubkey] = estimate
+	}
+	return estimates, nil
+
+}
@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrIsDryRun = errors.New("The daemon is in dry-run mode, so it can't sign raw transactions.")
+
+// Simulates transactions instead of sending them
+type Simulator interface {
+	Simulate(from common.Address, tx *types.Transaction) error
+}
+
+// dryRunWallet wraps a wallet so its transactions are simulated instead of being sent
+type dryRunWallet struct {
+	Wallet
+	simulator Simulator
+}
+
+// Create a wallet that hands the node's transactions to the simulator instead of broadcasting them
+func NewDryRunWallet(w Wallet, simulator Simulator) Wallet {
+	return &dryRunWallet{
+		Wallet:    w,
+		simulator: simulator,
+	}
+}
+
+// Get a transactor for the node account that simulates transactions instead of sending them
+func (w *dryRunWallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
+	opts, err := w.Wallet.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign the transaction as usual so it gets its real hash, but never broadcast it
+	signer := opts.Signer
+	opts.NoSend = true
+	opts.Signer = func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		signedTx, err := signer(from, tx)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.simulator.Simulate(from, signedTx); err != nil {
+			return nil, fmt.Errorf("error simulating transaction: %w", err)
+		}
+		return signedTx, nil
+	}
+	return opts, nil
+}
+
+// Sign a serialized transaction
+func (w *dryRunWallet) Sign(serializedTx []byte) ([]byte, error) {
+	return nil, ErrIsDryRun
+}
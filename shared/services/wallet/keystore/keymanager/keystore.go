@@ -0,0 +1,110 @@
+package keymanager
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+)
+
+// Keystore for the running Validator Client, which loads new keys into it through its keymanager API.
+// The Validator Client persists keys it loads this way on its own, so they're only written to the wrapped
+// file keystore if the API can't be used; those keys are picked up the next time the Validator Client restarts.
+type Keystore struct {
+	keystore   keystore.Keystore
+	keymanager *keymanager.Client
+	signerUrl  string
+}
+
+// Create a new keymanager keystore that falls back to the provided file keystore.
+// If a remote signer URL is provided, keys are registered as remote keys that sign through it instead of being sent to the Validator Client.
+// The fallback keystore can be nil if the Validator Client finds keys without one.
+func NewKeystore(fileKeystore keystore.Keystore, km *keymanager.Client, signerUrl string) *Keystore {
+	return &Keystore{
+		keystore:   fileKeystore,
+		keymanager: km,
+		signerUrl:  signerUrl,
+	}
+}
+
+// Get the keystore directory
+func (ks *Keystore) GetKeystoreDir() string {
+	if ks.keystore == nil {
+		return ""
+	}
+	return ks.keystore.GetKeystoreDir()
+}
+
+// Store a validator key
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Create a keystore for the Validator Client
+	password, err := keystore.GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("Could not generate random password: %w", err)
+	}
+	validatorKeystore, err := keystore.EncryptValidatorKey(key, derivationPath, password)
+	if err != nil {
+		return err
+	}
+
+	// Fall back to the file keystore if the Validator Client couldn't load it
+	if ks.loadValidatorKey(validatorKeystore, password) || ks.keystore == nil {
+		return nil
+	}
+	return ks.keystore.StoreValidatorKey(key, derivationPath)
+
+}
+
+// Import a validator key from a standard EIP-2335 keystore
+func (ks *Keystore) ImportValidatorKey(key *eth2types.BLSPrivateKey, validatorKeystore *keystore.ValidatorKeystore, password string) error {
+
+	// Fall back to the file keystore if the Validator Client couldn't load it
+	if ks.loadValidatorKey(validatorKeystore, password) || ks.keystore == nil {
+		return nil
+	}
+	return ks.keystore.ImportValidatorKey(key, validatorKeystore, password)
+
+}
+
+// Load a private key
+func (ks *Keystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+	if ks.keystore == nil {
+		return nil, nil
+	}
+	return ks.keystore.LoadValidatorKey(pubkey)
+}
+
+// Load a key into the running Validator Client, returning whether it has the key afterwards.
+// Keys it already has are left alone, since rewriting them could leave it with a keystore that doesn't match its password.
+func (ks *Keystore) loadValidatorKey(validatorKeystore *keystore.ValidatorKeystore, password string) bool {
+
+	loaded, err := ks.keymanager.IsValidatorLoaded(validatorKeystore.Pubkey)
+	if err != nil {
+		return false
+	}
+	if loaded {
+		return true
+	}
+
+	// Register it with the remote signer
+	if ks.signerUrl != "" {
+		remoteKey := keymanager.RemoteKey{
+			Pubkey: validatorKeystore.Pubkey.Bytes(),
+			Url:    ks.signerUrl,
+		}
+		return ks.keymanager.ImportRemoteKeys([]keymanager.RemoteKey{remoteKey}) == nil
+	}
+
+	// Send it the keystore
+	keystoreBytes, err := json.Marshal(validatorKeystore)
+	if err != nil {
+		return false
+	}
+	return ks.keymanager.ImportKeystores([]string{string(keystoreBytes)}, []string{password}, "") == nil
+
+}
@@ -1,9 +1,16 @@
 package keystore
 
 import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/sethvargo/go-password/password"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
 )
 
 // Generates a random password
@@ -21,6 +28,87 @@ func GenerateRandomPassword() (string, error) {
 // Validator keystore interface
 type Keystore interface {
 	StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error
+	ImportValidatorKey(key *eth2types.BLSPrivateKey, validatorKeystore *ValidatorKeystore, password string) error
 	LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
 	GetKeystoreDir() string
 }
+
+// A standard EIP-2335 validator keystore
+type ValidatorKeystore struct {
+	Crypto      map[string]interface{} `json:"crypto"`
+	Description string                 `json:"description,omitempty"`
+	Pubkey      types.ValidatorPubkey  `json:"pubkey"`
+	Path        string                 `json:"path"`
+	UUID        uuid.UUID              `json:"uuid"`
+	Version     uint                   `json:"version"`
+}
+
+// Encrypt a validator key into a standard EIP-2335 keystore
+func EncryptValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string, password string) (*ValidatorKeystore, error) {
+	encryptor := eth2ks.New(eth2ks.WithCipher("scrypt"))
+	encryptedKey, err := encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	return &ValidatorKeystore{
+		Crypto:  encryptedKey,
+		Pubkey:  types.BytesToValidatorPubkey(key.PublicKey().Marshal()),
+		Path:    derivationPath,
+		UUID:    uuid.New(),
+		Version: encryptor.Version(),
+	}, nil
+}
+
+// Decrypt a standard EIP-2335 keystore, checking that the key matches the pubkey it claims to be for
+func DecryptValidatorKeystore(keystoreBytes []byte, password string) (*eth2types.BLSPrivateKey, *ValidatorKeystore, error) {
+
+	// The pubkey is optional in EIP-2335 and may have a prefix, so it's parsed separately
+	var keystore struct {
+		Crypto      map[string]interface{} `json:"crypto"`
+		Description string                 `json:"description"`
+		Pubkey      string                 `json:"pubkey"`
+		Path        string                 `json:"path"`
+		UUID        uuid.UUID              `json:"uuid"`
+		Version     uint                   `json:"version"`
+	}
+	if err := json.Unmarshal(keystoreBytes, &keystore); err != nil {
+		return nil, nil, fmt.Errorf("error deserializing keystore: %w", err)
+	}
+	encryptor := eth2ks.New()
+	if keystore.Version != encryptor.Version() {
+		return nil, nil, fmt.Errorf("unsupported keystore version %d", keystore.Version)
+	}
+
+	// Decrypt the private key
+	decryptedKey, err := encryptor.Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decrypting keystore: %w", err)
+	}
+	privateKey, err := eth2types.BLSPrivateKeyFromBytes(decryptedKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error recreating private key: %w", err)
+	}
+
+	// Verify the private key matches the public key
+	pubkey := types.BytesToValidatorPubkey(privateKey.PublicKey().Marshal())
+	if keystore.Pubkey != "" {
+		claimedPubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(keystore.Pubkey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing keystore pubkey: %w", err)
+		}
+		if claimedPubkey != pubkey {
+			return nil, nil, fmt.Errorf("keystore claims to be for validator %s but it's for validator %s", claimedPubkey.Hex(), pubkey.Hex())
+		}
+	}
+
+	return privateKey, &ValidatorKeystore{
+		Crypto:      keystore.Crypto,
+		Description: keystore.Description,
+		Pubkey:      pubkey,
+		Path:        keystore.Path,
+		UUID:        keystore.UUID,
+		Version:     keystore.Version,
+	}, nil
+
+}
@@ -0,0 +1,89 @@
+package keystore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+const testDerivationPath string = "m/12381/3600/0/0/0"
+
+func newTestKeystore(t *testing.T, password string) (*eth2types.BLSPrivateKey, []byte) {
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatal(err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keystore, err := EncryptValidatorKey(key, testDerivationPath, password)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bytes, err := json.Marshal(keystore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, bytes
+}
+
+func TestValidatorKeystoreRoundTrip(t *testing.T) {
+	key, bytes := newTestKeystore(t, "password")
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	decryptedKey, keystore, err := DecryptValidatorKeystore(bytes, "password")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(decryptedKey.Marshal()) != string(key.Marshal()) {
+		t.Fatal("expected the decrypted key to match the original key")
+	}
+	if keystore.Pubkey != pubkey {
+		t.Fatalf("expected pubkey %s, got %s", pubkey.Hex(), keystore.Pubkey.Hex())
+	}
+	if keystore.Path != testDerivationPath {
+		t.Fatalf("expected path %s, got %s", testDerivationPath, keystore.Path)
+	}
+}
+
+func TestDecryptValidatorKeystoreErrors(t *testing.T) {
+	_, bytes := newTestKeystore(t, "password")
+	_, otherBytes := newTestKeystore(t, "password")
+
+	// Swap in another validator's pubkey
+	var keystore map[string]interface{}
+	if err := json.Unmarshal(bytes, &keystore); err != nil {
+		t.Fatal(err)
+	}
+	var otherKeystore map[string]interface{}
+	if err := json.Unmarshal(otherBytes, &otherKeystore); err != nil {
+		t.Fatal(err)
+	}
+	keystore["pubkey"] = otherKeystore["pubkey"]
+	mismatchedBytes, err := json.Marshal(keystore)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		keystore []byte
+		password string
+		errorMsg string
+	}{
+		{name: "wrong password", keystore: bytes, password: "wrong", errorMsg: "error decrypting keystore"},
+		{name: "mismatched pubkey", keystore: mismatchedBytes, password: "password", errorMsg: "claims to be for validator"},
+		{name: "invalid json", keystore: []byte("{"), password: "password", errorMsg: "error deserializing keystore"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := DecryptValidatorKeystore(test.keystore, test.password)
+			if err == nil || !strings.Contains(err.Error(), test.errorMsg) {
+				t.Fatalf("expected an error containing %q, got %v", test.errorMsg, err)
+			}
+		})
+	}
+}
@@ -89,6 +89,28 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 		return fmt.Errorf("Could not encode validator key: %w", err)
 	}
 
+	// Write it to disk
+	return ks.writeValidatorKey(pubkey, keyStoreBytes, password)
+
+}
+
+// Import a validator key from a standard EIP-2335 keystore, keeping its original encryption and password
+func (ks *Keystore) ImportValidatorKey(key *eth2types.BLSPrivateKey, validatorKeystore *keystore.ValidatorKeystore, password string) error {
+
+	// Encode key store
+	keyStoreBytes, err := json.Marshal(validatorKeystore)
+	if err != nil {
+		return fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	// Write it to disk
+	return ks.writeValidatorKey(validatorKeystore.Pubkey, keyStoreBytes, password)
+
+}
+
+// Write a validator key and its password to disk
+func (ks *Keystore) writeValidatorKey(pubkey types.ValidatorPubkey, keyStoreBytes []byte, password string) error {
+
 	// Get secret file path
 	secretFilePath := filepath.Join(ks.keystorePath, KeystoreDir, SecretsDir, hexutil.AddPrefix(pubkey.Hex()))
 
@@ -0,0 +1,102 @@
+package lighthouse
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	"gopkg.in/yaml.v2"
+
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	DefinitionsFileName     = "validator_definitions.yml"
+	definitionPubkeyKey     = "voting_public_key"
+	web3SignerSigningMethod = "web3signer"
+)
+
+// Lighthouse keystore for validators whose keys live in a remote signer.
+// Lighthouse can't discover the keys a signer holds on its own, so each one is registered in its validator definitions file.
+type RemoteKeystore struct {
+	keystorePath string
+	signerUrl    string
+}
+
+// Create new remote Lighthouse keystore
+func NewRemoteKeystore(keystorePath string, signerUrl string) *RemoteKeystore {
+	return &RemoteKeystore{
+		keystorePath: keystorePath,
+		signerUrl:    signerUrl,
+	}
+}
+
+// Get the keystore directory
+func (ks *RemoteKeystore) GetKeystoreDir() string {
+	return filepath.Join(ks.keystorePath, KeystoreDir)
+}
+
+// Store a validator key
+func (ks *RemoteKeystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+	return ks.addDefinition(types.BytesToValidatorPubkey(key.PublicKey().Marshal()))
+}
+
+// Import a validator key from a standard EIP-2335 keystore
+func (ks *RemoteKeystore) ImportValidatorKey(key *eth2types.BLSPrivateKey, validatorKeystore *keystore.ValidatorKeystore, password string) error {
+	return ks.addDefinition(validatorKeystore.Pubkey)
+}
+
+// Load a private key; the key is in the remote signer, so this never finds one
+func (ks *RemoteKeystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+	return nil, nil
+}
+
+// Add a definition for a validator that signs with the remote signer, unless it already has one
+func (ks *RemoteKeystore) addDefinition(pubkey types.ValidatorPubkey) error {
+
+	// Read the existing definitions, keeping any fields Lighthouse added as they are
+	definitionsPath := filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir, DefinitionsFileName)
+	definitions := []map[string]interface{}{}
+	bytes, err := os.ReadFile(definitionsPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("Could not read Lighthouse validator definitions: %w", err)
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(bytes, &definitions); err != nil {
+			return fmt.Errorf("Could not parse Lighthouse validator definitions: %w", err)
+		}
+	}
+
+	// Check for an existing definition
+	pubkeyString := hexutil.AddPrefix(pubkey.Hex())
+	for _, definition := range definitions {
+		if definition[definitionPubkeyKey] == pubkeyString {
+			return nil
+		}
+	}
+
+	// Add the definition and write the file
+	definitions = append(definitions, map[string]interface{}{
+		"enabled":           true,
+		definitionPubkeyKey: pubkeyString,
+		"type":              web3SignerSigningMethod,
+		"url":               ks.signerUrl,
+	})
+	bytes, err = yaml.Marshal(definitions)
+	if err != nil {
+		return fmt.Errorf("Could not encode Lighthouse validator definitions: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(definitionsPath), DirMode); err != nil {
+		return fmt.Errorf("Could not create validator key folder: %w", err)
+	}
+	if err := os.WriteFile(definitionsPath, bytes, FileMode); err != nil {
+		return fmt.Errorf("Could not write Lighthouse validator definitions to disk: %w", err)
+	}
+	return nil
+
+}
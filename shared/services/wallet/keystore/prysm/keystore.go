@@ -161,6 +161,12 @@ func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPa
 
 }
 
+// Import a validator key from a standard EIP-2335 keystore
+// Prysm keeps every key in a single account store, so the key is re-encrypted with the store's password
+func (ks *Keystore) ImportValidatorKey(key *eth2types.BLSPrivateKey, validatorKeystore *rpkeystore.ValidatorKeystore, password string) error {
+	return ks.StoreValidatorKey(key, validatorKeystore.Path)
+}
+
 // Initialize the account store
 func (ks *Keystore) initialize() error {
 
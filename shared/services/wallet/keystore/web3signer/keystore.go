@@ -0,0 +1,71 @@
+package web3signer
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/services/web3signer"
+)
+
+// Web3Signer keystore, which keeps validator keys in a remote signer instead of on disk
+type Keystore struct {
+	signer *web3signer.Client
+}
+
+// Create new Web3Signer keystore
+func NewKeystore(signer *web3signer.Client) *Keystore {
+	return &Keystore{
+		signer: signer,
+	}
+}
+
+// Get the keystore directory; keys live in the remote signer, so there isn't one
+func (ks *Keystore) GetKeystoreDir() string {
+	return ""
+}
+
+// Store a validator key
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Create a new password
+	password, err := keystore.GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("Could not generate random password: %w", err)
+	}
+
+	// Encrypt key
+	validatorKeystore, err := keystore.EncryptValidatorKey(key, derivationPath, password)
+	if err != nil {
+		return err
+	}
+
+	// Send it to the signer
+	return ks.ImportValidatorKey(key, validatorKeystore, password)
+
+}
+
+// Import a validator key from a standard EIP-2335 keystore, keeping its original encryption and password
+func (ks *Keystore) ImportValidatorKey(key *eth2types.BLSPrivateKey, validatorKeystore *keystore.ValidatorKeystore, password string) error {
+
+	// Encode key store
+	keyStoreBytes, err := json.Marshal(validatorKeystore)
+	if err != nil {
+		return fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	// Send it to the signer
+	if err := ks.signer.ImportKeystores([]string{string(keyStoreBytes)}, []string{password}, ""); err != nil {
+		return fmt.Errorf("Could not import validator key %s: %w", validatorKeystore.Pubkey.Hex(), err)
+	}
+	return nil
+
+}
+
+// Load a private key; keys can't be read back out of the remote signer, so this never finds one
+func (ks *Keystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+	return nil, nil
+}
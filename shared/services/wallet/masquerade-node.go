@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
 
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
@@ -47,6 +48,13 @@ func (w *masqueradeWallet) GetNodeAccountTransactor() (*bind.TransactOpts, error
 	return transactor, err
 }
 
+// Set the gas settings used by new node account transactors
+func (w *masqueradeWallet) SetGasSettings(maxFee *big.Int, maxPriorityFee *big.Int, gasLimit uint64) {
+	w.maxFee = maxFee
+	w.maxPriorityFee = maxPriorityFee
+	w.gasLimit = gasLimit
+}
+
 // Get the node account private key bytes
 func (w *masqueradeWallet) GetNodePrivateKeyBytes() ([]byte, error) {
 	return nil, ErrIsMasquerading
@@ -35,6 +35,11 @@ func (w *masqueradeWallet) StoreValidatorKey(key *eth2types.BLSPrivateKey, path
 	return ErrIsMasquerading
 }
 
+// Imports a validator key from a standard EIP-2335 keystore into all of the wallet's keystores
+func (w *masqueradeWallet) ImportValidatorKey(keystoreBytes []byte, password string) (types.ValidatorPubkey, error) {
+	return types.ValidatorPubkey{}, ErrIsMasquerading
+}
+
 // Loads a validator key from the wallet's keystores
 func (w *masqueradeWallet) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
 	return nil, ErrIsMasquerading
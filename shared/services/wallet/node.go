@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
@@ -68,6 +69,13 @@ func (w *hdWallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
 }
 
+// Set the gas settings used by new node account transactors
+func (w *hdWallet) SetGasSettings(maxFee *big.Int, maxPriorityFee *big.Int, gasLimit uint64) {
+	w.maxFee = maxFee
+	w.maxPriorityFee = maxPriorityFee
+	w.gasLimit = gasLimit
+}
+
 // Get the node account private key bytes
 func (w *hdWallet) GetNodePrivateKeyBytes() ([]byte, error) {
 
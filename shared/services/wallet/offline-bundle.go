@@ -0,0 +1,184 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+)
+
+// A transaction built by a node without its private key, to be signed on another machine
+type OfflineTransaction struct {
+	From      common.Address  `json:"from"`
+	To        *common.Address `json:"to"`
+	Nonce     uint64          `json:"nonce"`
+	Value     *big.Int        `json:"value"`
+	GasLimit  uint64          `json:"gasLimit"`
+	GasFeeCap *big.Int        `json:"gasFeeCap"`
+	GasTipCap *big.Int        `json:"gasTipCap"`
+	Data      hexutil.Bytes   `json:"data"`
+	Signed    hexutil.Bytes   `json:"signed,omitempty"`
+}
+
+// A set of offline transactions for a single chain
+type OfflineTransactionBundle struct {
+	ChainID      uint64                `json:"chainId"`
+	Transactions []*OfflineTransaction `json:"transactions"`
+}
+
+// Load a bundle from disk, returning an empty one if the file doesn't exist yet
+func LoadOfflineTransactionBundle(path string, chainID *big.Int) (*OfflineTransactionBundle, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &OfflineTransactionBundle{
+			ChainID:      chainID.Uint64(),
+			Transactions: []*OfflineTransaction{},
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading offline transactions from %s: %w", path, err)
+	}
+
+	bundle := new(OfflineTransactionBundle)
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("error deserializing offline transactions from %s: %w", path, err)
+	}
+	if bundle.ChainID != chainID.Uint64() {
+		return nil, fmt.Errorf("offline transactions in %s are for chain %d, but the node is on chain %d", path, bundle.ChainID, chainID.Uint64())
+	}
+	return bundle, nil
+}
+
+// Decode a bundle from a blob created by Encode
+func DecodeOfflineTransactionBundle(blob string) (*OfflineTransactionBundle, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(blob))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding offline transactions: %w", err)
+	}
+	bundle := new(OfflineTransactionBundle)
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("error deserializing offline transactions: %w", err)
+	}
+	return bundle, nil
+}
+
+// Read a bundle from a file, or from a blob created by Encode if the input isn't a file
+func ReadOfflineTransactionBundle(input string) (*OfflineTransactionBundle, error) {
+	if _, err := os.Stat(input); err != nil {
+		return DecodeOfflineTransactionBundle(input)
+	}
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return nil, fmt.Errorf("error reading offline transactions from %s: %w", input, err)
+	}
+	bundle := new(OfflineTransactionBundle)
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("error deserializing offline transactions from %s: %w", input, err)
+	}
+	return bundle, nil
+}
+
+// Save the bundle to disk
+func (b *OfflineTransactionBundle) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing offline transactions: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating offline transaction folder: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("error saving offline transactions to %s: %w", path, err)
+	}
+	return nil
+}
+
+// Encode the bundle as a single line of URL-safe text, so it can be moved between machines by QR code or copy and paste
+func (b *OfflineTransactionBundle) Encode() (string, error) {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return "", fmt.Errorf("error serializing offline transactions: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Sign every transaction in the bundle with the provided signer
+func (b *OfflineTransactionBundle) Sign(signer func(common.Address, *types.Transaction) (*types.Transaction, error)) error {
+	chainID := new(big.Int).SetUint64(b.ChainID)
+	for _, tx := range b.Transactions {
+		signedTx, err := signer(tx.From, tx.ToTransaction(chainID))
+		if err != nil {
+			return fmt.Errorf("error signing transaction with nonce %d: %w", tx.Nonce, err)
+		}
+		tx.Signed, err = signedTx.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("error serializing transaction with nonce %d: %w", tx.Nonce, err)
+		}
+	}
+	return nil
+}
+
+// Get the signed transactions in the bundle, checking that each one matches its unsigned details
+func (b *OfflineTransactionBundle) GetSignedTransactions() ([]*types.Transaction, error) {
+	chainID := new(big.Int).SetUint64(b.ChainID)
+	signer := types.LatestSignerForChainID(chainID)
+	txs := make([]*types.Transaction, 0, len(b.Transactions))
+	for _, tx := range b.Transactions {
+		if len(tx.Signed) == 0 {
+			return nil, fmt.Errorf("transaction with nonce %d has not been signed", tx.Nonce)
+		}
+		signedTx := new(types.Transaction)
+		if err := signedTx.UnmarshalBinary(tx.Signed); err != nil {
+			return nil, fmt.Errorf("error deserializing transaction with nonce %d: %w", tx.Nonce, err)
+		}
+		if !tx.matches(signedTx) {
+			return nil, fmt.Errorf("signed transaction with nonce %d does not match its unsigned details", tx.Nonce)
+		}
+		sender, err := types.Sender(signer, signedTx)
+		if err != nil {
+			return nil, fmt.Errorf("error recovering the sender of transaction with nonce %d: %w", tx.Nonce, err)
+		}
+		if sender != tx.From {
+			return nil, fmt.Errorf("transaction with nonce %d was signed by %s instead of %s", tx.Nonce, sender.Hex(), tx.From.Hex())
+		}
+		txs = append(txs, signedTx)
+	}
+	return txs, nil
+}
+
+// Build the unsigned EIP-1559 transaction
+func (t *OfflineTransaction) ToTransaction(chainID *big.Int) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     t.Nonce,
+		GasTipCap: t.GasTipCap,
+		GasFeeCap: t.GasFeeCap,
+		Gas:       t.GasLimit,
+		To:        t.To,
+		Value:     t.Value,
+		Data:      t.Data,
+	})
+}
+
+// Check if a signed transaction was built from these details
+func (t *OfflineTransaction) matches(signedTx *types.Transaction) bool {
+	if signedTx.Nonce() != t.Nonce || signedTx.Gas() != t.GasLimit || !bytes.Equal(signedTx.Data(), t.Data) {
+		return false
+	}
+	if signedTx.Value().Cmp(t.Value) != 0 || signedTx.GasFeeCap().Cmp(t.GasFeeCap) != 0 || signedTx.GasTipCap().Cmp(t.GasTipCap) != 0 {
+		return false
+	}
+	if signedTx.To() == nil || t.To == nil {
+		return signedTx.To() == nil && t.To == nil
+	}
+	return *signedTx.To() == *t.To
+}
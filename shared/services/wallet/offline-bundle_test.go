@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Create a signer that signs with the provided key, like the offline machine's wallet would
+func newTestSigner(key *ecdsa.PrivateKey) func(common.Address, *types.Transaction) (*types.Transaction, error) {
+	return func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return types.SignTx(tx, types.LatestSignerForChainID(tx.ChainId()), key)
+	}
+}
+
+// Create a bundle with a single transaction from the provided key's address
+func newTestBundle(key *ecdsa.PrivateKey) *OfflineTransactionBundle {
+	to := common.HexToAddress("0x1234")
+	return &OfflineTransactionBundle{
+		ChainID: 17000,
+		Transactions: []*OfflineTransaction{{
+			From:      crypto.PubkeyToAddress(key.PublicKey),
+			To:        &to,
+			Nonce:     7,
+			Value:     big.NewInt(1),
+			GasLimit:  21000,
+			GasFeeCap: big.NewInt(2e9),
+			GasTipCap: big.NewInt(1e9),
+			Data:      []byte{0x01, 0x02},
+		}},
+	}
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestOfflineBundleRoundTrip(t *testing.T) {
+	key := newTestKey(t)
+	bundle := newTestBundle(key)
+	if err := bundle.Sign(newTestSigner(key)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Move it to the node the same way a user would
+	blob, err := bundle.Encode()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, err := DecodeOfflineTransactionBundle(blob)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	txs, err := decoded.GetSignedTransactions()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(txs) != 1 || txs[0].Nonce() != 7 || txs[0].ChainId().Uint64() != 17000 {
+		t.Fatalf("expected the signed transaction, got %v", txs)
+	}
+}
+
+func TestOfflineBundleRejectsMismatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		prepare  func(bundle *OfflineTransactionBundle)
+		errorMsg string
+	}{
+		{
+			name:     "unsigned",
+			prepare:  func(bundle *OfflineTransactionBundle) {},
+			errorMsg: "has not been signed",
+		},
+		{
+			name: "wrong sender",
+			prepare: func(bundle *OfflineTransactionBundle) {
+				if err := bundle.Sign(newTestSigner(newTestKey(t))); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+			errorMsg: "was signed by",
+		},
+		{
+			name: "payload changed after signing",
+			prepare: func(bundle *OfflineTransactionBundle) {
+				key := newTestKey(t)
+				bundle.Transactions[0].From = crypto.PubkeyToAddress(key.PublicKey)
+				if err := bundle.Sign(newTestSigner(key)); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				bundle.Transactions[0].Data = []byte{0x03}
+			},
+			errorMsg: "does not match its unsigned details",
+		},
+		{
+			name: "recipient changed after signing",
+			prepare: func(bundle *OfflineTransactionBundle) {
+				key := newTestKey(t)
+				bundle.Transactions[0].From = crypto.PubkeyToAddress(key.PublicKey)
+				if err := bundle.Sign(newTestSigner(key)); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				bundle.Transactions[0].To = nil
+			},
+			errorMsg: "does not match its unsigned details",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bundle := newTestBundle(newTestKey(t))
+			test.prepare(bundle)
+			_, err := bundle.GetSignedTransactions()
+			if err == nil || !strings.Contains(err.Error(), test.errorMsg) {
+				t.Fatalf("expected an error containing %q, got %v", test.errorMsg, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrIsOffline = errors.New("Transactions are being exported for offline signing, so the node wallet can't sign anything on this machine.")
+
+// offlineWallet wraps a wallet so its transactions are exported unsigned instead of being sent
+type offlineWallet struct {
+	Wallet
+	bundlePath string
+}
+
+// Create a wallet that exports the node's transactions to the bundle at the provided path for signing on another machine
+func NewOfflineWallet(w Wallet, bundlePath string) Wallet {
+	return &offlineWallet{
+		Wallet:     w,
+		bundlePath: bundlePath,
+	}
+}
+
+// Get a transactor for the node account that exports transactions instead of sending them
+func (w *offlineWallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
+	opts, err := w.Wallet.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Commands apply a custom nonce after getting the transactor, so check for it when the transaction is built
+	opts.NoSend = true
+	opts.Signer = func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return w.exportTransaction(from, tx, opts.Nonce != nil)
+	}
+	return opts, nil
+}
+
+// Sign a serialized transaction
+func (w *offlineWallet) Sign(serializedTx []byte) ([]byte, error) {
+	return nil, ErrIsOffline
+}
+
+// Signs an arbitrary message
+func (w *offlineWallet) SignMessage(message string) ([]byte, error) {
+	return nil, ErrIsOffline
+}
+
+// Add an unsigned transaction to the bundle
+func (w *offlineWallet) exportTransaction(from common.Address, tx *types.Transaction, hasCustomNonce bool) (*types.Transaction, error) {
+	chainID := w.GetChainID()
+	bundle, err := LoadOfflineTransactionBundle(w.bundlePath, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transactions that were never broadcast stay pending in the bundle, so build on top of them unless the nonce was chosen explicitly
+	nonce := tx.Nonce()
+	transactions := make([]*OfflineTransaction, 0, len(bundle.Transactions)+1)
+	for _, existingTx := range bundle.Transactions {
+		if existingTx.From == from {
+			// An explicit nonce replaces the transaction that uses it
+			if hasCustomNonce && existingTx.Nonce == nonce {
+				continue
+			}
+			// Anything below the account's nonce has been mined or replaced already
+			if !hasCustomNonce && existingTx.Nonce < tx.Nonce() {
+				continue
+			}
+			if !hasCustomNonce && existingTx.Nonce >= nonce {
+				nonce = existingTx.Nonce + 1
+			}
+		}
+		transactions = append(transactions, existingTx)
+	}
+
+	value := tx.Value()
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	offlineTx := &OfflineTransaction{
+		From:      from,
+		To:        tx.To(),
+		Nonce:     nonce,
+		Value:     value,
+		GasLimit:  tx.Gas(),
+		GasFeeCap: tx.GasFeeCap(),
+		GasTipCap: tx.GasTipCap(),
+		Data:      tx.Data(),
+	}
+	bundle.Transactions = append(transactions, offlineTx)
+	if err := bundle.Save(w.bundlePath); err != nil {
+		return nil, fmt.Errorf("error exporting transaction: %w", err)
+	}
+
+	return offlineTx.ToTransaction(chainID), nil
+}
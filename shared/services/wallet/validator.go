@@ -9,6 +9,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/bindings/types"
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 	eth2util "github.com/wealdtech/go-eth2-util"
@@ -111,6 +112,25 @@ func (w *hdWallet) StoreValidatorKey(key *eth2types.BLSPrivateKey, path string)
 
 }
 
+// Imports a validator key from a standard EIP-2335 keystore into all of the wallet's keystores
+func (w *hdWallet) ImportValidatorKey(keystoreBytes []byte, password string) (types.ValidatorPubkey, error) {
+
+	key, validatorKeystore, err := keystore.DecryptValidatorKeystore(keystoreBytes, password)
+	if err != nil {
+		return types.ValidatorPubkey{}, err
+	}
+
+	for name := range w.keystores {
+		if err := w.keystores[name].ImportValidatorKey(key, validatorKeystore, password); err != nil {
+			return types.ValidatorPubkey{}, fmt.Errorf("Could not import %s validator key: %w", name, err)
+		}
+	}
+
+	// Return validator pubkey
+	return validatorKeystore.Pubkey, nil
+
+}
+
 // Loads a validator key from the wallet's keystores
 func (w *hdWallet) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
 
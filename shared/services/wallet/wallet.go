@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -29,6 +30,7 @@ import (
 const (
 	EntropyBits              = 256
 	FileMode                 = 0600
+	DirMode                  = 0700
 	DefaultNodeKeyPath       = "m/44'/60'/0'/0/%d"
 	LedgerLiveNodeKeyPath    = "m/44'/60'/%d/0/0"
 	MyEtherWalletNodeKeyPath = "m/44'/60'/0'/%d"
@@ -49,6 +51,7 @@ type Wallet interface {
 	GetValidatorKeyByPubkey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
 	GetValidatorKeyCount() (uint, error)
 	GetValidatorKeys(startIndex uint, length uint) ([]ValidatorKey, error)
+	ImportValidatorKey(keystoreBytes []byte, password string) (rptypes.ValidatorPubkey, error)
 	Initialize(derivationPath string, walletIndex uint) (string, error)
 	IsInitialized() bool
 	LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
@@ -57,6 +60,7 @@ type Wallet interface {
 	Reload() error
 	Save() error
 	SaveValidatorKey(key ValidatorKey) error
+	SetGasSettings(maxFee *big.Int, maxPriorityFee *big.Int, gasLimit uint64)
 	Sign(serializedTx []byte) ([]byte, error)
 	SignMessage(message string) ([]byte, error)
 	StoreValidatorKey(key *eth2types.BLSPrivateKey, path string) error
@@ -359,6 +363,9 @@ func (w *hdWallet) Save() error {
 	}
 
 	// Write wallet store to disk
+	if err := os.MkdirAll(filepath.Dir(w.walletPath), DirMode); err != nil {
+		return fmt.Errorf("Could not create wallet directory: %w", err)
+	}
 	if err := os.WriteFile(w.walletPath, wsBytes, FileMode); err != nil {
 		return fmt.Errorf("Could not write wallet to disk: %w", err)
 	}
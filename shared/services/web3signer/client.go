@@ -0,0 +1,162 @@
+package web3signer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+)
+
+// Config
+const (
+	RequestTimeout       time.Duration = 30 * time.Second
+	signPathFormat       string        = "/api/v1/eth2/sign/%s"
+	SigningTypeExit      string        = "VOLUNTARY_EXIT"
+	SigningTypeDeposit   string        = "DEPOSIT"
+	maxErrorResponseSize int64         = 1024
+)
+
+// A client for a Web3Signer-compatible remote signer, using its keymanager API to manage keys and its signing API to sign with them
+type Client struct {
+	url        string
+	httpClient *http.Client
+	keymanager *keymanager.Client
+}
+
+// The fork and chain a signature is for, which the signer uses to compute the signing domain
+type ForkInfo struct {
+	Fork                  Fork          `json:"fork"`
+	GenesisValidatorsRoot hexutil.Bytes `json:"genesis_validators_root"`
+}
+
+type Fork struct {
+	PreviousVersion hexutil.Bytes `json:"previous_version"`
+	CurrentVersion  hexutil.Bytes `json:"current_version"`
+	Epoch           uint64        `json:"epoch,string"`
+}
+
+type VoluntaryExit struct {
+	Epoch          uint64 `json:"epoch,string"`
+	ValidatorIndex uint64 `json:"validator_index,string"`
+}
+
+type Deposit struct {
+	Pubkey                hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                uint64        `json:"amount,string"`
+	GenesisForkVersion    hexutil.Bytes `json:"genesis_fork_version"`
+}
+
+type signRequest struct {
+	Type          string         `json:"type"`
+	ForkInfo      *ForkInfo      `json:"fork_info,omitempty"`
+	SigningRoot   hexutil.Bytes  `json:"signingRoot"`
+	VoluntaryExit *VoluntaryExit `json:"voluntary_exit,omitempty"`
+	Deposit       *Deposit       `json:"deposit,omitempty"`
+}
+
+type signResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// Create a new remote signer client
+func NewClient(url string) *Client {
+	return &Client{
+		url: strings.TrimSuffix(url, "/"),
+		httpClient: &http.Client{
+			Timeout: RequestTimeout,
+		},
+		keymanager: keymanager.NewClient(url, ""),
+	}
+}
+
+// Import EIP-2335 keystores into the signer, along with an optional EIP-3076 slashing protection interchange for them.
+// Keys the signer already has are left as they are.
+func (c *Client) ImportKeystores(keystores []string, passwords []string, slashingProtection string) error {
+	if err := c.keymanager.ImportKeystores(keystores, passwords, slashingProtection); err != nil {
+		return fmt.Errorf("error importing keystores into the remote signer: %w", err)
+	}
+	return nil
+}
+
+// Sign a voluntary exit with the key for the provided validator
+func (c *Client) SignVoluntaryExit(pubkey types.ValidatorPubkey, forkInfo ForkInfo, signingRoot []byte, exit VoluntaryExit) (types.ValidatorSignature, error) {
+	request := signRequest{
+		Type:          SigningTypeExit,
+		ForkInfo:      &forkInfo,
+		SigningRoot:   signingRoot,
+		VoluntaryExit: &exit,
+	}
+	signature, err := c.sign(pubkey, request)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("error signing voluntary exit for validator %s with the remote signer: %w", pubkey.Hex(), err)
+	}
+	return signature, nil
+}
+
+// Sign deposit data with the key for the provided validator
+func (c *Client) SignDeposit(pubkey types.ValidatorPubkey, signingRoot []byte, deposit Deposit) (types.ValidatorSignature, error) {
+	request := signRequest{
+		Type:        SigningTypeDeposit,
+		SigningRoot: signingRoot,
+		Deposit:     &deposit,
+	}
+	signature, err := c.sign(pubkey, request)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("error signing deposit data for validator %s with the remote signer: %w", pubkey.Hex(), err)
+	}
+	return signature, nil
+}
+
+// Send a signing request for the provided validator and check the signature the signer returns
+func (c *Client) sign(pubkey types.ValidatorPubkey, request signRequest) (types.ValidatorSignature, error) {
+	var response signResponse
+	if err := c.post(fmt.Sprintf(signPathFormat, hexutil.Encode(pubkey.Bytes())), request, &response); err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	if len(response.Signature) != types.ValidatorSignatureLength {
+		return types.ValidatorSignature{}, fmt.Errorf("remote signer returned a %d-byte signature", len(response.Signature))
+	}
+	return types.BytesToValidatorSignature(response.Signature), nil
+}
+
+// POST a JSON request to the signer and decode its JSON response
+func (c *Client) post(path string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("error serializing request: %w", err)
+	}
+	httpRequest, err := http.NewRequest(http.MethodPost, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("Accept", "application/json")
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode < 200 || httpResponse.StatusCode > 299 {
+		responseBody, _ := io.ReadAll(io.LimitReader(httpResponse.Body, maxErrorResponseSize))
+		return fmt.Errorf("request failed with code %d: %s", httpResponse.StatusCode, string(responseBody))
+	}
+
+	responseBody, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if err := json.Unmarshal(responseBody, response); err != nil {
+		return fmt.Errorf("error deserializing response: %w", err)
+	}
+	return nil
+}
@@ -51,27 +51,28 @@ type MegapoolDetails struct {
 }
 
 type MegapoolValidatorDetails struct {
-	ValidatorId        uint32                 `json:"validatorId"`
-	PubKey             types.ValidatorPubkey  `json:"pubKey"`
-	LastAssignmentTime time.Time              `json:"lastAssignmentTime"`
-	LastRequestedValue uint32                 `json:"lastRequestedValue"`
-	LastRequestedBond  uint32                 `json:"lastRequestedBond"`
-	DepositValue       uint32                 `json:"DepositValue"`
-	Staked             bool                   `json:"staked"`
-	Exited             bool                   `json:"exited"`
-	InQueue            bool                   `json:"inQueue"`
-	QueuePosition      *big.Int               `json:"queuePosition"`
-	InPrestake         bool                   `json:"inPrestake"`
-	ExpressUsed        bool                   `json:"expressUsed"`
-	Dissolved          bool                   `json:"dissolved"`
-	Exiting            bool                   `json:"exiting"`
-	Locked             bool                   `json:"locked"`
-	ValidatorIndex     uint64                 `json:"validatorIndex"`
-	ExitBalance        uint64                 `json:"exitBalance"`
-	WithdrawableEpoch  uint64                 `json:"withdrawableEpoch"`
-	LockedSlot         uint64                 `json:"lockedSlot"`
-	Activated          bool                   `json:"activated"`
-	BeaconStatus       beacon.ValidatorStatus `json:"beaconStatus"`
+	ValidatorId        uint32                  `json:"validatorId"`
+	PubKey             types.ValidatorPubkey   `json:"pubKey"`
+	LastAssignmentTime time.Time               `json:"lastAssignmentTime"`
+	LastRequestedValue uint32                  `json:"lastRequestedValue"`
+	LastRequestedBond  uint32                  `json:"lastRequestedBond"`
+	DepositValue       uint32                  `json:"DepositValue"`
+	Staked             bool                    `json:"staked"`
+	Exited             bool                    `json:"exited"`
+	InQueue            bool                    `json:"inQueue"`
+	QueuePosition      *big.Int                `json:"queuePosition"`
+	InPrestake         bool                    `json:"inPrestake"`
+	ExpressUsed        bool                    `json:"expressUsed"`
+	Dissolved          bool                    `json:"dissolved"`
+	Exiting            bool                    `json:"exiting"`
+	Locked             bool                    `json:"locked"`
+	ValidatorIndex     uint64                  `json:"validatorIndex"`
+	ExitBalance        uint64                  `json:"exitBalance"`
+	WithdrawableEpoch  uint64                  `json:"withdrawableEpoch"`
+	LockedSlot         uint64                  `json:"lockedSlot"`
+	Activated          bool                    `json:"activated"`
+	BeaconStatus       beacon.ValidatorStatus  `json:"beaconStatus"`
+	QueueEstimates     ValidatorQueueEstimates `json:"queueEstimates"`
 }
 
 type MegapoolValidatorMapAndRewardsResponse struct {
@@ -81,6 +82,7 @@ type MegapoolValidatorMapAndRewardsResponse struct {
 	TotalBeaconBalance   *big.Int                              `json:"totalBeaconBalance"`
 	NodeShareOfCLBalance *big.Int                              `json:"nodeShareOfCLBalance"`
 	NodeBond             *big.Int                              `json:"nodeBond"`
+	Warning              string                                `json:"warning"`
 }
 
 type MegapoolRewardSplitResponse struct {
@@ -163,3 +165,54 @@ type ValidatorWithdrawableEpochProof struct {
 	WithdrawableEpoch uint64
 	Witnesses         [][32]byte
 }
+
+type MegapoolConsolidationStep struct {
+	SourceId            uint32                `json:"sourceId"`
+	TargetId            uint32                `json:"targetId"`
+	SourcePubkey        types.ValidatorPubkey `json:"sourcePubkey"`
+	TargetPubkey        types.ValidatorPubkey `json:"targetPubkey"`
+	SourceBalance       uint64                `json:"sourceBalance"`
+	SwitchToCompounding bool                  `json:"switchToCompounding"`
+}
+type MegapoolPendingConsolidation struct {
+	SourceId    uint32 `json:"sourceId"`
+	TargetId    uint32 `json:"targetId"`
+	SourceIndex string `json:"sourceIndex"`
+	TargetIndex string `json:"targetIndex"`
+}
+type MegapoolConsolidationPlanResponse struct {
+	Status                string                         `json:"status"`
+	Error                 string                         `json:"error"`
+	Steps                 []MegapoolConsolidationStep    `json:"steps"`
+	ChurnBalance          uint64                         `json:"churnBalance"`
+	ExitingValidators     int                            `json:"exitingValidators"`
+	PendingChurnBalance   uint64                         `json:"pendingChurnBalance"`
+	EstimatedQueueEpochs  uint64                         `json:"estimatedQueueEpochs"`
+	EstimatedQueueTime    time.Time                      `json:"estimatedQueueTime"`
+	PendingConsolidations []MegapoolPendingConsolidation `json:"pendingConsolidations"`
+	NetworkPendingCount   int                            `json:"networkPendingCount"`
+}
+type CanConsolidateValidatorsResponse struct {
+	Status               string             `json:"status"`
+	Error                string             `json:"error"`
+	CanConsolidate       bool               `json:"canConsolidate"`
+	InvalidStatus        bool               `json:"invalidStatus"`
+	AlreadyExiting       bool               `json:"alreadyExiting"`
+	NotActiveLongEnough  bool               `json:"notActiveLongEnough"`
+	AlreadyCompounding   bool               `json:"alreadyCompounding"`
+	TargetNotCompounding bool               `json:"targetNotCompounding"`
+	BlsCredentials       bool               `json:"blsCredentials"`
+	ContractCredentials  bool               `json:"contractCredentials"`
+	NotCredentialsOwner  bool               `json:"notCredentialsOwner"`
+	CredentialsOwner     common.Address     `json:"credentialsOwner"`
+	EligibleEpoch        uint64             `json:"eligibleEpoch"`
+	Fee                  *big.Int           `json:"fee"`
+	GasInfo              rocketpool.GasInfo `json:"gasInfo"`
+}
+type ConsolidateValidatorsResponse struct {
+	Status       string                `json:"status"`
+	Error        string                `json:"error"`
+	SourcePubkey types.ValidatorPubkey `json:"sourcePubkey"`
+	TargetPubkey types.ValidatorPubkey `json:"targetPubkey"`
+	TxHash       common.Hash           `json:"txHash"`
+}
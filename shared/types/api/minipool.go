@@ -18,33 +18,35 @@ type MinipoolStatusResponse struct {
 	Error          string            `json:"error"`
 	Minipools      []MinipoolDetails `json:"minipools"`
 	LatestDelegate common.Address    `json:"latestDelegate"`
+	Warning        string            `json:"warning"`
 }
 type MinipoolDetails struct {
-	Address               common.Address         `json:"address"`
-	ValidatorPubkey       types.ValidatorPubkey  `json:"validatorPubkey"`
-	Status                minipool.StatusDetails `json:"status"`
-	DepositType           types.MinipoolDeposit  `json:"depositType"`
-	Node                  minipool.NodeDetails   `json:"node"`
-	User                  minipool.UserDetails   `json:"user"`
-	Balances              tokens.Balances        `json:"balances"`
-	NodeShareOfETHBalance *big.Int               `json:"nodeShareOfETHBalance"`
-	Validator             ValidatorDetails       `json:"validator"`
-	CanStake              bool                   `json:"canStake"`
-	CanPromote            bool                   `json:"canPromote"`
-	Queue                 minipool.QueueDetails  `json:"queue"`
-	RefundAvailable       bool                   `json:"refundAvailable"`
-	WithdrawalAvailable   bool                   `json:"withdrawalAvailable"`
-	CloseAvailable        bool                   `json:"closeAvailable"`
-	Finalised             bool                   `json:"finalised"`
-	UseLatestDelegate     bool                   `json:"useLatestDelegate"`
-	Delegate              common.Address         `json:"delegate"`
-	PreviousDelegate      common.Address         `json:"previousDelegate"`
-	EffectiveDelegate     common.Address         `json:"effectiveDelegate"`
-	TimeUntilDissolve     time.Duration          `json:"timeUntilDissolve"`
-	DissolveTimeout       time.Duration          `json:"dissolveTimeout"`
-	Penalties             uint64                 `json:"penalties"`
-	ReduceBondTime        time.Time              `json:"reduceBondTime"`
-	ReduceBondCancelled   bool                   `json:"reduceBondCancelled"`
+	Address               common.Address          `json:"address"`
+	ValidatorPubkey       types.ValidatorPubkey   `json:"validatorPubkey"`
+	Status                minipool.StatusDetails  `json:"status"`
+	DepositType           types.MinipoolDeposit   `json:"depositType"`
+	Node                  minipool.NodeDetails    `json:"node"`
+	User                  minipool.UserDetails    `json:"user"`
+	Balances              tokens.Balances         `json:"balances"`
+	NodeShareOfETHBalance *big.Int                `json:"nodeShareOfETHBalance"`
+	Validator             ValidatorDetails        `json:"validator"`
+	CanStake              bool                    `json:"canStake"`
+	CanPromote            bool                    `json:"canPromote"`
+	Queue                 minipool.QueueDetails   `json:"queue"`
+	RefundAvailable       bool                    `json:"refundAvailable"`
+	WithdrawalAvailable   bool                    `json:"withdrawalAvailable"`
+	CloseAvailable        bool                    `json:"closeAvailable"`
+	Finalised             bool                    `json:"finalised"`
+	UseLatestDelegate     bool                    `json:"useLatestDelegate"`
+	Delegate              common.Address          `json:"delegate"`
+	PreviousDelegate      common.Address          `json:"previousDelegate"`
+	EffectiveDelegate     common.Address          `json:"effectiveDelegate"`
+	TimeUntilDissolve     time.Duration           `json:"timeUntilDissolve"`
+	DissolveTimeout       time.Duration           `json:"dissolveTimeout"`
+	Penalties             uint64                  `json:"penalties"`
+	ReduceBondTime        time.Time               `json:"reduceBondTime"`
+	ReduceBondCancelled   bool                    `json:"reduceBondCancelled"`
+	QueueEstimates        ValidatorQueueEstimates `json:"queueEstimates"`
 }
 type ValidatorDetails struct {
 	Exists      bool     `json:"exists"`
@@ -113,8 +115,9 @@ type ChangeWithdrawalCredentialsResponse struct {
 }
 
 type ImportKeyResponse struct {
-	Status string `json:"status"`
-	Error  string `json:"error"`
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	KeyLoaded bool   `json:"keyLoaded"`
 }
 
 type CanProcessWithdrawalResponse struct {
@@ -165,9 +168,10 @@ type GetMinipoolCloseDetailsForNodeResponse struct {
 	Details                     []MinipoolCloseDetails `json:"details"`
 }
 type CloseMinipoolResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status          string      `json:"status"`
+	Error           string      `json:"error"`
+	TxHash          common.Hash `json:"txHash"`
+	KeyRemovalError string      `json:"keyRemovalError"`
 }
 
 type GetDistributeBalanceDetailsResponse struct {
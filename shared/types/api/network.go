@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/gas/oracle"
 )
 
 type NodeFeeResponse struct {
@@ -15,6 +17,12 @@ type NodeFeeResponse struct {
 	MaxNodeFee    float64 `json:"maxNodeFee"`
 }
 
+type GasSuggestionsResponse struct {
+	Status      string                `json:"status"`
+	Error       string                `json:"error"`
+	Suggestions oracle.GasSuggestions `json:"suggestions"`
+}
+
 type RplPriceResponse struct {
 	Status        string   `json:"status"`
 	Error         string   `json:"error"`
@@ -10,7 +10,10 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/tokens"
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/dryrun"
 	"github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -905,3 +908,28 @@ type ClaimUnclaimedRewardsResponse struct {
 	Error  string      `json:"error"`
 	TxHash common.Hash `json:"txHash"`
 }
+
+type NodeTxQueueResponse struct {
+	Status string                                 `json:"status"`
+	Error  string                                 `json:"error"`
+	Queues map[string]*txmanager.TransactionQueue `json:"queues"`
+}
+
+type NodeTasksResponse struct {
+	Status string                        `json:"status"`
+	Error  string                        `json:"error"`
+	Tasks  map[string][]tasks.TaskStatus `json:"tasks"`
+}
+
+type NodeDryRunResponse struct {
+	Status       string                                   `json:"status"`
+	Error        string                                   `json:"error"`
+	Enabled      bool                                     `json:"enabled"`
+	Transactions map[string][]dryrun.SimulatedTransaction `json:"transactions"`
+}
+
+type NodeBroadcastResponse struct {
+	Status   string        `json:"status"`
+	Error    string        `json:"error"`
+	TxHashes []common.Hash `json:"txHashes"`
+}
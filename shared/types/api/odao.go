@@ -42,9 +42,10 @@ type TNDAOProposalsResponse struct {
 }
 
 type TNDAOProposalResponse struct {
-	Status   string              `json:"status"`
-	Error    string              `json:"error"`
-	Proposal dao.ProposalDetails `json:"proposal"`
+	Status         string                  `json:"status"`
+	Error          string                  `json:"error"`
+	Proposal       dao.ProposalDetails     `json:"proposal"`
+	DecodedPayload *DecodedProposalPayload `json:"decodedPayload"`
 }
 
 type CanProposeTNDAOInviteResponse struct {
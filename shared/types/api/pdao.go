@@ -23,9 +23,10 @@ type PDAOProposalsResponse struct {
 }
 
 type PDAOProposalResponse struct {
-	Status   string                            `json:"status"`
-	Error    string                            `json:"error"`
-	Proposal PDAOProposalWithNodeVoteDirection `json:"proposal"`
+	Status         string                            `json:"status"`
+	Error          string                            `json:"error"`
+	Proposal       PDAOProposalWithNodeVoteDirection `json:"proposal"`
+	DecodedPayload *DecodedProposalPayload           `json:"decodedPayload"`
 }
 
 type CanCancelPDAOProposalResponse struct {
@@ -202,6 +203,20 @@ type ProposePDAOSettingResponse struct {
 	TxHash     common.Hash `json:"txHash"`
 }
 
+type CanProposePDAOSettingsBundleResponse struct {
+	Status                 string                  `json:"status"`
+	Error                  string                  `json:"error"`
+	CanPropose             bool                    `json:"canPropose"`
+	InsufficientRpl        bool                    `json:"proposalCooldownActive"`
+	StakedRpl              *big.Int                `json:"stakedRpl"`
+	LockedRpl              *big.Int                `json:"lockedRpl"`
+	ProposalBond           *big.Int                `json:"proposalBond"`
+	BlockNumber            uint32                  `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo      `json:"gasInfo"`
+	IsRplLockingDisallowed bool                    `json:"isRplLockingDisallowed"`
+	Changes                []ProposalSettingChange `json:"changes"`
+}
+
 type PDAOGetRewardsPercentagesResponse struct {
 	Status      string   `json:"status"`
 	Error       string   `json:"error"`
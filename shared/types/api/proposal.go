@@ -0,0 +1,115 @@
+package api
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The units a proposal setting value is expressed in
+type ProposalSettingUnit string
+
+const (
+	ProposalSettingUnit_None     ProposalSettingUnit = ""
+	ProposalSettingUnit_Bool     ProposalSettingUnit = "bool"
+	ProposalSettingUnit_Count    ProposalSettingUnit = "count"
+	ProposalSettingUnit_Eth      ProposalSettingUnit = "eth"
+	ProposalSettingUnit_Rpl      ProposalSettingUnit = "rpl"
+	ProposalSettingUnit_Percent  ProposalSettingUnit = "percent"
+	ProposalSettingUnit_Duration ProposalSettingUnit = "duration"
+	ProposalSettingUnit_Blocks   ProposalSettingUnit = "blocks"
+	ProposalSettingUnit_Address  ProposalSettingUnit = "address"
+)
+
+// The kind of treasury spend a proposal makes
+type ProposalTreasurySpendType string
+
+const (
+	ProposalTreasurySpendType_OneTime         ProposalTreasurySpendType = "one-time"
+	ProposalTreasurySpendType_NewRecurring    ProposalTreasurySpendType = "new-recurring"
+	ProposalTreasurySpendType_UpdateRecurring ProposalTreasurySpendType = "update-recurring"
+)
+
+// The kind of membership change a proposal makes
+type ProposalMemberAction string
+
+const (
+	ProposalMemberAction_Invite  ProposalMemberAction = "invite"
+	ProposalMemberAction_Kick    ProposalMemberAction = "kick"
+	ProposalMemberAction_Leave   ProposalMemberAction = "leave"
+	ProposalMemberAction_Replace ProposalMemberAction = "replace"
+)
+
+// A proposal payload decoded into the changes it makes if it's executed
+type DecodedProposalPayload struct {
+	DAO       string   `json:"dao"`
+	Function  string   `json:"function"`
+	Arguments []string `json:"arguments"`
+
+	// Only the lists that apply to the payload's function are set
+	SettingChanges []ProposalSettingChange `json:"settingChanges,omitempty"`
+	TreasurySpends []ProposalTreasurySpend `json:"treasurySpends,omitempty"`
+	MemberChanges  []ProposalMemberChange  `json:"memberChanges,omitempty"`
+	Upgrades       []ProposalUpgrade       `json:"upgrades,omitempty"`
+}
+
+// A setting a proposal changes; the values are formatted in the setting's units and the current value is blank if it can't be read
+type ProposalSettingChange struct {
+	ContractName    string              `json:"contractName"`
+	SettingPath     string              `json:"settingPath"`
+	Unit            ProposalSettingUnit `json:"unit"`
+	CurrentValue    string              `json:"currentValue"`
+	NewValue        string              `json:"newValue"`
+	RawCurrentValue string              `json:"rawCurrentValue"`
+	RawNewValue     string              `json:"rawNewValue"`
+}
+
+// A payment from the pDAO treasury that a proposal makes or schedules; amounts are in RPL wei
+type ProposalTreasurySpend struct {
+	Type            ProposalTreasurySpendType `json:"type"`
+	Name            string                    `json:"name"`
+	Recipient       common.Address            `json:"recipient"`
+	Amount          *big.Int                  `json:"amount"`
+	PeriodLength    time.Duration             `json:"periodLength"`
+	StartTime       time.Time                 `json:"startTime"`
+	NumberOfPeriods uint64                    `json:"numberOfPeriods"`
+
+	// The recurring spend being replaced, for updates
+	Current *ProposalRecurringSpend `json:"current,omitempty"`
+}
+
+// The current state of a recurring treasury spend
+type ProposalRecurringSpend struct {
+	Recipient       common.Address `json:"recipient"`
+	AmountPerPeriod *big.Int       `json:"amountPerPeriod"`
+	PeriodLength    time.Duration  `json:"periodLength"`
+	LastPaymentTime time.Time      `json:"lastPaymentTime"`
+	NumberOfPeriods uint64         `json:"numberOfPeriods"`
+	PeriodsPaid     uint64         `json:"periodsPaid"`
+}
+
+// A change to the members of the oDAO or the security council that a proposal makes
+type ProposalMemberChange struct {
+	Action   ProposalMemberAction `json:"action"`
+	Address  common.Address       `json:"address"`
+	ID       string               `json:"id"`
+	Url      string               `json:"url,omitempty"`
+	IsMember bool                 `json:"isMember"`
+
+	// The member replacing the existing one, for replacements
+	NewAddress common.Address `json:"newAddress,omitempty"`
+	NewID      string         `json:"newId,omitempty"`
+	NewUrl     string         `json:"newUrl,omitempty"`
+
+	// The RPL fined from the member's bond, for oDAO kicks
+	RplFine *big.Int `json:"rplFine,omitempty"`
+}
+
+// A network contract upgrade that an oDAO proposal makes
+type ProposalUpgrade struct {
+	Type            string         `json:"type"`
+	ContractName    string         `json:"contractName"`
+	CurrentAddress  common.Address `json:"currentAddress"`
+	ContractAddress common.Address `json:"contractAddress"`
+}
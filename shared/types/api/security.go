@@ -39,9 +39,10 @@ type SecurityProposalsResponse struct {
 }
 
 type SecurityProposalResponse struct {
-	Status   string              `json:"status"`
-	Error    string              `json:"error"`
-	Proposal dao.ProposalDetails `json:"proposal"`
+	Status         string                  `json:"status"`
+	Error          string                  `json:"error"`
+	Proposal       dao.ProposalDetails     `json:"proposal"`
+	DecodedPayload *DecodedProposalPayload `json:"decodedPayload"`
 }
 
 type SecurityCanProposeInviteResponse struct {
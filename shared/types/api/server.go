@@ -0,0 +1,24 @@
+package api
+
+// The version prefix for all of the API server's routes
+const ApiServerRoutePrefix string = "/api/v1"
+
+// A request to run the API command named by the route, such as /api/v1/node/status
+type ApiServerRequest struct {
+	Args            []string `json:"args"`
+	MaxFee          float64  `json:"maxFee"`
+	MaxPrioFee      float64  `json:"maxPrioFee"`
+	GasLimit        uint64   `json:"gasLimit"`
+	Nonce           string   `json:"nonce"`
+	OfflineTx       bool     `json:"offlineTx"`
+	Node            string   `json:"node"`
+	IgnoreSyncCheck bool     `json:"ignoreSyncCheck"`
+	ForceFallbacks  bool     `json:"forceFallbacks"`
+}
+
+type ApiServerRoutesResponse struct {
+	Status  string   `json:"status"`
+	Error   string   `json:"error"`
+	Version string   `json:"version"`
+	Routes  []string `json:"routes"`
+}
@@ -0,0 +1,17 @@
+package api
+
+import (
+	"time"
+)
+
+// Estimates of when a validator's pending Beacon Chain operations will take effect; epochs and times are zero when nothing is pending
+type ValidatorQueueEstimates struct {
+	PendingDepositBalance      uint64    `json:"pendingDepositBalance"`
+	DepositQueuePosition       int       `json:"depositQueuePosition"`
+	EstimatedActivationEpoch   uint64    `json:"estimatedActivationEpoch"`
+	EstimatedActivationTime    time.Time `json:"estimatedActivationTime"`
+	PendingWithdrawalBalance   uint64    `json:"pendingWithdrawalBalance"`
+	Consolidating              bool      `json:"consolidating"`
+	EstimatedWithdrawableEpoch uint64    `json:"estimatedWithdrawableEpoch"`
+	EstimatedWithdrawableTime  time.Time `json:"estimatedWithdrawableTime"`
+}
@@ -17,6 +17,19 @@ type ValidatorKeystore struct {
 	Pubkey  types.ValidatorPubkey  `json:"pubkey"`
 }
 
+type NodeWalletDetails struct {
+	Name              string         `json:"name"`
+	WalletInitialized bool           `json:"walletInitialized"`
+	IsMasquerading    bool           `json:"isMasquerading"`
+	AccountAddress    common.Address `json:"accountAddress"`
+}
+
+type WalletNodesResponse struct {
+	Status string              `json:"status"`
+	Error  string              `json:"error"`
+	Nodes  []NodeWalletDetails `json:"nodes"`
+}
+
 type WalletStatusResponse struct {
 	Status            string `json:"status"`
 	Error             string `json:"error"`
@@ -65,6 +78,13 @@ type RebuildWalletResponse struct {
 	ValidatorKeys []types.ValidatorPubkey `json:"validatorKeys"`
 }
 
+type UnloadValidatorKeysResponse struct {
+	Status             string `json:"status"`
+	Error              string `json:"error"`
+	KeymanagerDisabled bool   `json:"keymanagerDisabled"`
+	RemovedCount       int    `json:"removedCount"`
+}
+
 type ExportWalletResponse struct {
 	Status            string `json:"status"`
 	Error             string `json:"error"`
@@ -103,3 +123,29 @@ type EndMasqueradeResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type SignOfflineTransactionsResponse struct {
+	Status             string `json:"status"`
+	Error              string `json:"error"`
+	SignedTransactions string `json:"signedTransactions"`
+	TransactionCount   int    `json:"transactionCount"`
+}
+
+type ExportedValidatorKey struct {
+	Pubkey   types.ValidatorPubkey `json:"pubkey"`
+	Keystore string                `json:"keystore"`
+}
+
+type ExportValidatorKeysResponse struct {
+	Status      string                  `json:"status"`
+	Error       string                  `json:"error"`
+	Password    string                  `json:"password"`
+	Keystores   []ExportedValidatorKey  `json:"keystores"`
+	MissingKeys []types.ValidatorPubkey `json:"missingKeys"`
+}
+
+type ImportValidatorKeyResponse struct {
+	Status string                `json:"status"`
+	Error  string                `json:"error"`
+	Pubkey types.ValidatorPubkey `json:"pubkey"`
+}
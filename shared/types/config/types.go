@@ -7,10 +7,12 @@ type ParameterType string
 type ExecutionClient string
 type ConsensusClient string
 type RewardsMode string
+type GasOracle string
 type MevRelayID string
 type MevSelectionMode string
 type NimbusPruningMode string
 type PBSubmissionRef int
+type AlertSeverity string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -91,10 +93,25 @@ const (
 	RewardsMode_Generate RewardsMode = "generate"
 )
 
+// Enum to describe where the Smartnode gets its gas fee suggestions from
+const (
+	GasOracle_Unknown    GasOracle = ""
+	GasOracle_FeeHistory GasOracle = "feeHistory"
+	GasOracle_Beaconcha  GasOracle = "beaconcha"
+	GasOracle_Etherscan  GasOracle = "etherscan"
+)
+
 const (
 	PBSubmission_6AM PBSubmissionRef = 1713420000
 )
 
+// Enum to describe the minimum severity of the alerts a notification sink receives
+const (
+	AlertSeverity_Info     AlertSeverity = "info"
+	AlertSeverity_Warning  AlertSeverity = "warning"
+	AlertSeverity_Critical AlertSeverity = "critical"
+)
+
 // Enum to identify MEV-boost relays
 const (
 	MevRelayID_Unknown            MevRelayID = ""
@@ -0,0 +1,96 @@
+package eth2
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+// Builds withdrawal proofs from any fork's SignedBeaconBlock.
+// The execution payload is found by name, so a new fork only needs its types and their generated encoding.
+type signedBeaconBlock struct {
+	block          SSZContainer
+	beaconBlock    SSZContainer
+	body           reflect.Value
+	bodyChunksCeil uint64
+}
+
+// Wrap a fork's SignedBeaconBlock, checking that it has the fields the proofs need
+func WrapSignedBeaconBlock(block SSZContainer) (SignedBeaconBlock, error) {
+	signedBlock := reflect.ValueOf(block)
+	if signedBlock.Kind() != reflect.Pointer || signedBlock.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("signed beacon block must be a pointer to a struct, got %T", block)
+	}
+
+	// Find the block and its body
+	beaconBlock := signedBlock.Elem().FieldByName("Block")
+	if beaconBlock.Kind() != reflect.Pointer || beaconBlock.IsNil() {
+		return nil, fmt.Errorf("%T doesn't have a block", block)
+	}
+	beaconBlockContainer, isContainer := beaconBlock.Interface().(SSZContainer)
+	if !isContainer {
+		return nil, fmt.Errorf("the block in %T isn't an SSZ container", block)
+	}
+	body := beaconBlock.Elem().Field(int(generic.BeaconBlockBodyIndex))
+	if beaconBlock.Elem().Type().Field(int(generic.BeaconBlockBodyIndex)).Name != "Body" || body.Kind() != reflect.Pointer || body.IsNil() {
+		return nil, fmt.Errorf("%T doesn't have a block body at index %d", block, generic.BeaconBlockBodyIndex)
+	}
+	body = body.Elem()
+
+	// Check the execution payload's withdrawals, which may be a fork's own payload type
+	payloadField := body.Type().Field(int(generic.BeaconBlockBodyExecutionPayloadIndex))
+	if payloadField.Name != "ExecutionPayload" || payloadField.Type.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("%T doesn't have an execution payload at index %d", block, generic.BeaconBlockBodyExecutionPayloadIndex)
+	}
+	withdrawalsField := payloadField.Type.Elem().Field(int(generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex))
+	if withdrawalsField.Name != "Withdrawals" || withdrawalsField.Type != reflect.TypeFor[[]*generic.Withdrawal]() {
+		return nil, fmt.Errorf("%T doesn't have withdrawals at index %d of its execution payload", block, generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex)
+	}
+
+	return &signedBeaconBlock{
+		block:          block,
+		beaconBlock:    beaconBlockContainer,
+		body:           body,
+		bodyChunksCeil: math.GetPowerOfTwoCeil(uint64(body.NumField())),
+	}, nil
+}
+
+func (b *signedBeaconBlock) getExecutionPayload() reflect.Value {
+	return b.body.Field(int(generic.BeaconBlockBodyExecutionPayloadIndex))
+}
+
+func (b *signedBeaconBlock) ProveWithdrawal(indexInWithdrawalsArray uint64) ([][]byte, error) {
+	tree, err := b.beaconBlock.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	gid := uint64(1)
+	// Navigate to the body
+	gid = gid*generic.BeaconBlockChunksCeil + generic.BeaconBlockBodyIndex
+	// Then to the ExecutionPayload
+	gid = gid*b.bodyChunksCeil + generic.BeaconBlockBodyExecutionPayloadIndex
+	// Then to the withdrawals array
+	gid = gid*generic.BeaconBlockBodyExecutionPayloadChunksCeil + generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex
+	// Then to the array contents
+	gid = gid * 2
+	// Finally to the withdrawal in question
+	gid = gid*generic.BeaconBlockWithdrawalsArrayMax + indexInWithdrawalsArray
+
+	proof, err := tree.Prove(int(gid))
+	if err != nil {
+		return nil, err
+	}
+
+	return proof.Hashes, nil
+}
+
+func (b *signedBeaconBlock) HasExecutionPayload() bool {
+	return !b.getExecutionPayload().IsNil()
+}
+
+func (b *signedBeaconBlock) Withdrawals() []*generic.Withdrawal {
+	return b.getExecutionPayload().Elem().Field(int(generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex)).Interface().([]*generic.Withdrawal)
+}
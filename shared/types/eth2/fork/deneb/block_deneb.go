@@ -2,35 +2,6 @@ package deneb
 
 import "github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 
-// Important indices for proof generation:
-const BeaconBlockBodyChunksCeil uint64 = 16
-
-func (b *SignedBeaconBlock) ProveWithdrawal(indexInWithdrawalsArray uint64) ([][]byte, error) {
-	tree, err := b.Block.GetTree()
-	if err != nil {
-		return nil, err
-	}
-
-	gid := uint64(1)
-	// Navigate to the body
-	gid = gid*generic.BeaconBlockChunksCeil + generic.BeaconBlockBodyIndex
-	// Then to the ExecutionPayload
-	gid = gid*BeaconBlockBodyChunksCeil + generic.BeaconBlockBodyExecutionPayloadIndex
-	// Then to the withdrawals array
-	gid = gid*generic.BeaconBlockBodyExecutionPayloadChunksCeil + generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex
-	// Then to the array contents
-	gid = gid * 2
-	// Finally to the withdrawal in question
-	gid = gid*generic.BeaconBlockWithdrawalsArrayMax + indexInWithdrawalsArray
-
-	proof, err := tree.Prove(int(gid))
-	if err != nil {
-		return nil, err
-	}
-
-	return proof.Hashes, nil
-}
-
 // Types needed for withdrawal proofs
 type BeaconBlock struct {
 	Slot          uint64           `json:"slot"`
@@ -79,11 +50,3 @@ type ExecutionPayload struct {
 	BlobGasUsed   uint64                `json:"blob_gas_used"`
 	ExcessBlobGas uint64                `json:"excess_blob_gas"`
 }
-
-func (b *SignedBeaconBlock) HasExecutionPayload() bool {
-	return b.Block.Body.ExecutionPayload != nil
-}
-
-func (b *SignedBeaconBlock) Withdrawals() []*generic.Withdrawal {
-	return b.Block.Body.ExecutionPayload.Withdrawals
-}
@@ -5,35 +5,6 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 )
 
-// Important indices for proof generation:
-const BeaconBlockBodyChunksCeil uint64 = 16
-
-func (b *SignedBeaconBlock) ProveWithdrawal(indexInWithdrawalsArray uint64) ([][]byte, error) {
-	tree, err := b.Block.GetTree()
-	if err != nil {
-		return nil, err
-	}
-
-	gid := uint64(1)
-	// Navigate to the body
-	gid = gid*generic.BeaconBlockChunksCeil + generic.BeaconBlockBodyIndex
-	// Then to the ExecutionPayload
-	gid = gid*BeaconBlockBodyChunksCeil + generic.BeaconBlockBodyExecutionPayloadIndex
-	// Then to the withdrawals array
-	gid = gid*generic.BeaconBlockBodyExecutionPayloadChunksCeil + generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex
-	// Then to the array contents
-	gid = gid * 2
-	// Finally to the withdrawal in question
-	gid = gid*generic.BeaconBlockWithdrawalsArrayMax + indexInWithdrawalsArray
-
-	proof, err := tree.Prove(int(gid))
-	if err != nil {
-		return nil, err
-	}
-
-	return proof.Hashes, nil
-}
-
 // Types needed for withdrawal proofs
 type BeaconBlock struct {
 	Slot          uint64           `json:"slot"`
@@ -107,11 +78,3 @@ type IndexedAttestation struct {
 	Data             *generic.AttestationData `json:"data"`
 	Signature        []byte                   `json:"signature" ssz-size:"96"`
 }
-
-func (b *SignedBeaconBlock) HasExecutionPayload() bool {
-	return b.Block.Body.ExecutionPayload != nil
-}
-
-func (b *SignedBeaconBlock) Withdrawals() []*generic.Withdrawal {
-	return b.Block.Body.ExecutionPayload.Withdrawals
-}
@@ -1,18 +1,9 @@
 package electra
 
 import (
-	"bytes"
-	"errors"
-	"fmt"
-	"reflect"
-	"sync/atomic"
-
 	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
-	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
-const beaconStateChunkCeil uint64 = 64
-
 // Taken from https://github.com/OffchainLabs/prysm/blob/a0071826c5daf7dc3a6e76874fdaa76481a3c665/proto/prysm/v1alpha1/beacon_state.pb.go#L1955
 // Unexported fields stripped, as well as proto-related field tags. JSON and ssz-size tags are preserved, and nested types are replaced with local copies as well.
 type BeaconState struct {
@@ -56,192 +47,3 @@ type BeaconState struct {
 	PendingPartialWithdrawals     []*generic.PendingPartialWithdrawal `json:"pending_partial_withdrawals,omitempty" ssz-max:"134217728"`
 	PendingConsolidations         []*generic.PendingConsolidation     `json:"pending_consolidations,omitempty" ssz-max:"262144"`
 }
-
-var beaconStateChunkSize atomic.Uint64
-
-func getStateChunkSize() uint64 {
-	// Use a static value to avoid multiple reflection calls
-	storedChunkSize := beaconStateChunkSize.Load()
-	if storedChunkSize == 0 {
-		s := reflect.TypeOf(BeaconState{}).NumField()
-		beaconStateChunkSize.Store(uint64(s))
-		storedChunkSize = uint64(s)
-	}
-	return storedChunkSize
-}
-
-func GetGeneralizedIndexForValidators() uint64 {
-	// There's 28 fields, so rounding up to the next power of two is 32, a left-aligned node
-	// BeaconStateValidatorsIndex is the 11th field, so its generalized index is 32 + 11 = 43
-	return math.GetPowerOfTwoCeil(getStateChunkSize()) + generic.BeaconStateValidatorsIndex
-}
-
-func (state *BeaconState) validatorStateProof(index uint64) ([][]byte, error) {
-
-	// Convert the state to a proof tree
-	root, err := state.GetTree()
-	if err != nil {
-		return nil, fmt.Errorf("could not get state tree: %w", err)
-	}
-
-	// Find the validator's generalized index
-	generalizedIndex := generic.GetGeneralizedIndexForValidator(index, GetGeneralizedIndexForValidators())
-
-	// Grab the proof for that index
-	proof, err := root.Prove(int(generalizedIndex))
-	if err != nil {
-		return nil, fmt.Errorf("could not get proof for validator: %w", err)
-	}
-
-	// Sanity check that the proof leaf matches the expected validator
-	validatorHashTreeRoot, err := state.Validators[index].HashTreeRoot()
-	if err != nil {
-		return nil, fmt.Errorf("could not get hash tree root for validator: %w", err)
-	}
-	if !bytes.Equal(proof.Leaf, validatorHashTreeRoot[:]) {
-		return nil, fmt.Errorf("proof leaf does not match expected validator")
-	}
-
-	return proof.Hashes, nil
-
-}
-
-func (state *BeaconState) ValidatorProof(index uint64) ([][]byte, error) {
-
-	if index >= uint64(len(state.Validators)) {
-		return nil, errors.New("validator index out of bounds")
-	}
-
-	proof, err := state.validatorStateProof(index)
-	if err != nil {
-		return nil, fmt.Errorf("could not get validator state proof: %w", err)
-	}
-
-	// The EL proves against BeaconBlockHeader root, so we need to merge the state proof with that.
-	generalizedIndex := generic.BeaconBlockHeaderStateRootGeneralizedIndex
-	root, err := state.LatestBlockHeader.GetTree()
-	if err != nil {
-		return nil, fmt.Errorf("could not get block header tree: %w", err)
-	}
-	blockHeaderProof, err := root.Prove(int(generalizedIndex))
-	if err != nil {
-		return nil, fmt.Errorf("could not get proof for block header: %w", err)
-	}
-
-	return append(proof, blockHeaderProof.Hashes...), nil
-}
-
-func (state *BeaconState) blockHeaderToStateProof(blockHeader *generic.BeaconBlockHeader) ([][]byte, error) {
-	generalizedIndex := generic.BeaconBlockHeaderStateRootGeneralizedIndex
-	root, err := blockHeader.GetTree()
-	if err != nil {
-		return nil, fmt.Errorf("could not get block header tree: %w", err)
-	}
-	blockHeaderProof, err := root.Prove(int(generalizedIndex))
-	if err != nil {
-		return nil, fmt.Errorf("could not get proof for block header: %w", err)
-	}
-	return blockHeaderProof.Hashes, nil
-}
-
-func (state *BeaconState) HistoricalSummaryProof(slot uint64) ([][]byte, error) {
-	isHistorical := slot+generic.SlotsPerHistoricalRoot <= state.Slot
-	if !isHistorical {
-		return nil, fmt.Errorf("slot %d is less than %d slots in the past from the state at slot %d, you must build a proof from the block_roots field instead", slot, generic.SlotsPerHistoricalRoot, state.Slot)
-	}
-	tree, err := state.GetTree()
-	if err != nil {
-		return nil, fmt.Errorf("could not get state tree: %w", err)
-	}
-
-	// Navigate to the historical_summaries
-	gid := uint64(1)
-	gid = gid*beaconStateChunkCeil + generic.BeaconStateHistoricalSummariesFieldIndex
-	// Navigate into the historical summaries vector.
-	arrayIndex := (slot / generic.SlotsPerHistoricalRoot)
-	gid = gid*2*generic.BeaconStateHistoricalSummariesMaxLength + arrayIndex
-
-	proof, err := tree.Prove(int(gid))
-	if err != nil {
-		return nil, fmt.Errorf("could not get proof for historical block root: %w", err)
-	}
-
-	// The EL proves against BeaconBlockHeader root, so we need to merge the state proof with that.
-	blockHeaderProof, err := state.blockHeaderToStateProof(state.LatestBlockHeader)
-	if err != nil {
-		return nil, fmt.Errorf("could not get block header proof: %w", err)
-	}
-	return append(proof.Hashes, blockHeaderProof...), nil
-}
-
-func (state *BeaconState) HistoricalSummaryBlockRootProof(slot int) ([][]byte, error) {
-	// If the state isn't aligned at the end of an 8192 slot era, throw an error
-	if state.Slot%generic.SlotsPerHistoricalRoot != 0 {
-		return nil, fmt.Errorf("state is not aligned at the end of an 8192 slot era")
-	}
-
-	hsls := generic.HistoricalSummaryLists{
-		BlockRoots: state.BlockRoots,
-		StateRoots: state.StateRoots,
-	}
-
-	idx := slot % int(generic.SlotsPerHistoricalRoot)
-	tree, err := hsls.GetTree()
-	if err != nil {
-		return nil, fmt.Errorf("could not get historical summary lists tree: %w", err)
-	}
-
-	gid := uint64(1)
-	gid = gid * 2                              // Now at block_roots
-	gid = gid * generic.SlotsPerHistoricalRoot // Now at the first block_root
-	gid = gid + uint64(idx)                    // Now at the correct block_root
-
-	proof, err := tree.Prove(int(gid))
-	if err != nil {
-		return nil, fmt.Errorf("could not get proof for historical summary: %w", err)
-	}
-
-	return proof.Hashes, nil
-}
-
-func (state *BeaconState) BlockRootProof(slot uint64) ([][]byte, error) {
-	isHistorical := slot+generic.SlotsPerHistoricalRoot <= state.Slot
-	if isHistorical {
-		return nil, fmt.Errorf("slot %d is more than %d slots in the past from the state at slot %d, you must build a proof from the historical_summaries instead", slot, generic.SlotsPerHistoricalRoot, state.Slot)
-	}
-
-	tree, err := state.GetTree()
-	if err != nil {
-		return nil, fmt.Errorf("could not get state tree: %w", err)
-	}
-
-	gid := uint64(1)
-
-	// Navigate to the block_roots
-	gid = gid*beaconStateChunkCeil + generic.BeaconStateBlockRootsFieldIndex
-
-	// We're now at the block_roots vector, which is the root of a slotsPerHistoricalRoot slots vector.
-	// The index we care about is given by slot % slotsPerHistoricalRoot.
-	gid = gid*generic.BeaconStateBlockRootsMaxLength + (slot % generic.SlotsPerHistoricalRoot)
-
-	proof, err := tree.Prove(int(gid))
-	if err != nil {
-		return nil, fmt.Errorf("could not get proof for block root: %w", err)
-	}
-
-	// Finally, prove from the block header to the state root.
-	blockHeaderProof, err := state.blockHeaderToStateProof(state.LatestBlockHeader)
-	if err != nil {
-		return nil, fmt.Errorf("could not get block header proof: %w", err)
-	}
-
-	return append(proof.Hashes, blockHeaderProof...), nil
-}
-
-func (state *BeaconState) GetValidators() []*generic.Validator {
-	return state.Validators
-}
-
-func (state *BeaconState) GetSlot() uint64 {
-	return state.Slot
-}
@@ -0,0 +1,1849 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 23bfaaa1428c1a6536fef36873a740fad4adb2da2dae1482218a9721efc5e881
+// Version: 0.1.3
+package fulu
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+// MarshalSSZ ssz marshals the BeaconBlock object
+func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlock object to a target array
+func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	dst = append(dst, b.ParentRoot[:]...)
+
+	// Field (3) 'StateRoot'
+	dst = append(dst, b.StateRoot[:]...)
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlock object
+func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentRoot'
+	copy(b.ParentRoot[:], buf[16:48])
+
+	// Field (3) 'StateRoot'
+	copy(b.StateRoot[:], buf[48:80])
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if o4 != 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBody)
+		}
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
+func (b *BeaconBlock) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBody)
+	}
+	size += b.Body.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlock object
+func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
+func (b *BeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	hh.PutBytes(b.ParentRoot[:])
+
+	// Field (3) 'StateRoot'
+	hh.PutBytes(b.StateRoot[:])
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlock object
+func (b *BeaconBlock) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
+func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Block'
+	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 100 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		s.Block = new(BeaconBlock)
+	}
+	size += s.Block.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlock object
+func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
+func (s *SignedBeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if err = s.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBeaconBlock object
+func (s *SignedBeaconBlock) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBody object
+func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBody object to a target array
+func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(396)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(generic.Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(generic.SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(generic.ExecutionPayload)
+	}
+	offset += b.ExecutionPayload.SizeSSZ()
+
+	// Offset (10) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlsToExecutionChanges) * 172
+
+	// Offset (11) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKzgCommitments) * 48
+
+	// Offset (12) 'ExecutionRequests'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 1 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 1)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 8 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 8)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (10) 'BlsToExecutionChanges'
+	if size := len(b.BlsToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBody.BlsToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 4096 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBody.BlobKzgCommitments", size, 4096)
+		return
+	}
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		dst = append(dst, b.BlobKzgCommitments[ii][:]...)
+	}
+
+	// Field (12) 'ExecutionRequests'
+	if dst, err = b.ExecutionRequests.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBody object
+func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 396 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o9, o10, o11, o12 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(generic.Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	copy(b.Graffiti[:], buf[168:200])
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 != 396 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(generic.SyncAggregate)
+	}
+	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:380]); err != nil {
+		return err
+	}
+
+	// Offset (9) 'ExecutionPayload'
+	if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (10) 'BlsToExecutionChanges'
+	if o10 = ssz.ReadOffset(buf[384:388]); o10 > size || o9 > o10 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (11) 'BlobKzgCommitments'
+	if o11 = ssz.ReadOffset(buf[388:392]); o11 > size || o10 > o11 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (12) 'ExecutionRequests'
+	if o12 = ssz.ReadOffset(buf[392:396]); o12 > size || o11 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*generic.ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(generic.ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 1)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 8)
+		if err != nil {
+			return err
+		}
+		b.Attestations = make([]*Attestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1240, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*generic.Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(generic.Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:o9]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*generic.SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(generic.SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (9) 'ExecutionPayload'
+	{
+		buf = tail[o9:o10]
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(generic.ExecutionPayload)
+		}
+		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (10) 'BlsToExecutionChanges'
+	{
+		buf = tail[o10:o11]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		if err != nil {
+			return err
+		}
+		b.BlsToExecutionChanges = make([]*generic.SignedBLSToExecutionChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlsToExecutionChanges[ii] == nil {
+				b.BlsToExecutionChanges[ii] = new(generic.SignedBLSToExecutionChange)
+			}
+			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (11) 'BlobKzgCommitments'
+	{
+		buf = tail[o11:o12]
+		num, err := ssz.DivideInt2(len(buf), 48, 4096)
+		if err != nil {
+			return err
+		}
+		b.BlobKzgCommitments = make([][48]byte, num)
+		for ii := 0; ii < num; ii++ {
+			copy(b.BlobKzgCommitments[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+
+	// Field (12) 'ExecutionRequests'
+	{
+		buf = tail[o12:]
+		if b.ExecutionRequests == nil {
+			b.ExecutionRequests = new(ExecutionRequests)
+		}
+		if err = b.ExecutionRequests.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBody object
+func (b *BeaconBlockBody) SizeSSZ() (size int) {
+	size = 396
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (9) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(generic.ExecutionPayload)
+	}
+	size += b.ExecutionPayload.SizeSSZ()
+
+	// Field (10) 'BlsToExecutionChanges'
+	size += len(b.BlsToExecutionChanges) * 172
+
+	// Field (11) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
+
+	// Field (12) 'ExecutionRequests'
+	if b.ExecutionRequests == nil {
+		b.ExecutionRequests = new(ExecutionRequests)
+	}
+	size += b.ExecutionRequests.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBody object
+func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher
+func (b *BeaconBlockBody) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(generic.Eth1Data)
+	}
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 1 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1)
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 8)
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(generic.SyncAggregate)
+	}
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (10) 'BlsToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BlsToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BlsToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (11) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.BlobKzgCommitments", size, 4096)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			hh.PutBytes(i[:])
+		}
+		numItems := uint64(len(b.BlobKzgCommitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, 4096)
+	}
+
+	// Field (12) 'ExecutionRequests'
+	if err = b.ExecutionRequests.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockBody object
+func (b *BeaconBlockBody) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the Attestation object
+func (a *Attestation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the Attestation object to a target array
+func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(236)
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(generic.AttestationData)
+	}
+	if dst, err = a.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	dst = append(dst, a.Signature[:]...)
+
+	// Field (3) 'CommitteeBits'
+	if size := len(a.CommitteeBits); size != 8 {
+		err = ssz.ErrBytesLengthFn("Attestation.CommitteeBits", size, 8)
+		return
+	}
+	dst = append(dst, a.CommitteeBits...)
+
+	// Field (0) 'AggregationBits'
+	if size := len(a.AggregationBits); size > 131072 {
+		err = ssz.ErrBytesLengthFn("Attestation.AggregationBits", size, 131072)
+		return
+	}
+	dst = append(dst, a.AggregationBits...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Attestation object
+func (a *Attestation) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 236 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 236 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(generic.AttestationData)
+	}
+	if err = a.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Signature'
+	copy(a.Signature[:], buf[132:228])
+
+	// Field (3) 'CommitteeBits'
+	if cap(a.CommitteeBits) == 0 {
+		a.CommitteeBits = make([]byte, 0, len(buf[228:236]))
+	}
+	a.CommitteeBits = append(a.CommitteeBits, buf[228:236]...)
+
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 131072); err != nil {
+			return err
+		}
+		if cap(a.AggregationBits) == 0 {
+			a.AggregationBits = make([]byte, 0, len(buf))
+		}
+		a.AggregationBits = append(a.AggregationBits, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Attestation object
+func (a *Attestation) SizeSSZ() (size int) {
+	size = 236
+
+	// Field (0) 'AggregationBits'
+	size += len(a.AggregationBits)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Attestation object
+func (a *Attestation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the Attestation object with a hasher
+func (a *Attestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AggregationBits'
+	if len(a.AggregationBits) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(a.AggregationBits, 131072)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(generic.AttestationData)
+	}
+	if err = a.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	hh.PutBytes(a.Signature[:])
+
+	// Field (3) 'CommitteeBits'
+	if size := len(a.CommitteeBits); size != 8 {
+		err = ssz.ErrBytesLengthFn("Attestation.CommitteeBits", size, 8)
+		return
+	}
+	hh.PutBytes(a.CommitteeBits)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Attestation object
+func (a *Attestation) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
+}
+
+// MarshalSSZ ssz marshals the ExecutionRequests object
+func (e *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionRequests object to a target array
+func (e *ExecutionRequests) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Offset (0) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.Deposits) * 192
+
+	// Offset (1) 'Withdrawals'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.Withdrawals) * 76
+
+	// Offset (2) 'Consolidations'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Deposits'
+	if size := len(e.Deposits); size > 8192 {
+		err = ssz.ErrListTooBigFn("ExecutionRequests.Deposits", size, 8192)
+		return
+	}
+	for ii := 0; ii < len(e.Deposits); ii++ {
+		if dst, err = e.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Withdrawals'
+	if size := len(e.Withdrawals); size > 16 {
+		err = ssz.ErrListTooBigFn("ExecutionRequests.Withdrawals", size, 16)
+		return
+	}
+	for ii := 0; ii < len(e.Withdrawals); ii++ {
+		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Consolidations'
+	if size := len(e.Consolidations); size > 2 {
+		err = ssz.ErrListTooBigFn("ExecutionRequests.Consolidations", size, 2)
+		return
+	}
+	for ii := 0; ii < len(e.Consolidations); ii++ {
+		if dst, err = e.Consolidations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionRequests object
+func (e *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'Deposits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 12 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Withdrawals'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'Consolidations'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Deposits'
+	{
+		buf = tail[o0:o1]
+		num, err := ssz.DivideInt2(len(buf), 192, 8192)
+		if err != nil {
+			return err
+		}
+		e.Deposits = make([]*DepositRequest, num)
+		for ii := 0; ii < num; ii++ {
+			if e.Deposits[ii] == nil {
+				e.Deposits[ii] = new(DepositRequest)
+			}
+			if err = e.Deposits[ii].UnmarshalSSZ(buf[ii*192 : (ii+1)*192]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (1) 'Withdrawals'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DivideInt2(len(buf), 76, 16)
+		if err != nil {
+			return err
+		}
+		e.Withdrawals = make([]*WithdrawalRequest, num)
+		for ii := 0; ii < num; ii++ {
+			if e.Withdrawals[ii] == nil {
+				e.Withdrawals[ii] = new(WithdrawalRequest)
+			}
+			if err = e.Withdrawals[ii].UnmarshalSSZ(buf[ii*76 : (ii+1)*76]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (2) 'Consolidations'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DivideInt2(len(buf), 116, 2)
+		if err != nil {
+			return err
+		}
+		e.Consolidations = make([]*ConsolidationRequest, num)
+		for ii := 0; ii < num; ii++ {
+			if e.Consolidations[ii] == nil {
+				e.Consolidations[ii] = new(ConsolidationRequest)
+			}
+			if err = e.Consolidations[ii].UnmarshalSSZ(buf[ii*116 : (ii+1)*116]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionRequests object
+func (e *ExecutionRequests) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (0) 'Deposits'
+	size += len(e.Deposits) * 192
+
+	// Field (1) 'Withdrawals'
+	size += len(e.Withdrawals) * 76
+
+	// Field (2) 'Consolidations'
+	size += len(e.Consolidations) * 116
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ExecutionRequests object
+func (e *ExecutionRequests) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionRequests object with a hasher
+func (e *ExecutionRequests) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Deposits))
+		if num > 8192 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 8192)
+	}
+
+	// Field (1) 'Withdrawals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Withdrawals))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Withdrawals {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (2) 'Consolidations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Consolidations))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Consolidations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExecutionRequests object
+func (e *ExecutionRequests) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// MarshalSSZ ssz marshals the DepositRequest object
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositRequest object to a target array
+func (d *DepositRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	if size := len(d.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, d.Pubkey...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, d.WithdrawalCredentials...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
+
+	// Field (3) 'Signature'
+	if size := len(d.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.Signature", size, 96)
+		return
+	}
+	dst = append(dst, d.Signature...)
+
+	// Field (4) 'Index'
+	dst = ssz.MarshalUint64(dst, d.Index)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositRequest object
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 192 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	if cap(d.Pubkey) == 0 {
+		d.Pubkey = make([]byte, 0, len(buf[0:48]))
+	}
+	d.Pubkey = append(d.Pubkey, buf[0:48]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if cap(d.WithdrawalCredentials) == 0 {
+		d.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+	}
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+
+	// Field (2) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (3) 'Signature'
+	if cap(d.Signature) == 0 {
+		d.Signature = make([]byte, 0, len(buf[88:184]))
+	}
+	d.Signature = append(d.Signature, buf[88:184]...)
+
+	// Field (4) 'Index'
+	d.Index = ssz.UnmarshallUint64(buf[184:192])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the DepositRequest object
+func (d *DepositRequest) SizeSSZ() (size int) {
+	size = 192
+	return
+}
+
+// HashTreeRoot ssz hashes the DepositRequest object
+func (d *DepositRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher
+func (d *DepositRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if size := len(d.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(d.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.WithdrawalCredentials", size, 32)
+		return
+	}
+	hh.PutBytes(d.WithdrawalCredentials)
+
+	// Field (2) 'Amount'
+	hh.PutUint64(d.Amount)
+
+	// Field (3) 'Signature'
+	if size := len(d.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(d.Signature)
+
+	// Field (4) 'Index'
+	hh.PutUint64(d.Index)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the DepositRequest object
+func (d *DepositRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
+
+// MarshalSSZ ssz marshals the WithdrawalRequest object
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(w)
+}
+
+// MarshalSSZTo ssz marshals the WithdrawalRequest object to a target array
+func (w *WithdrawalRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SourceAddress'
+	if size := len(w.SourceAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("WithdrawalRequest.SourceAddress", size, 20)
+		return
+	}
+	dst = append(dst, w.SourceAddress...)
+
+	// Field (1) 'ValidatorPubkey'
+	if size := len(w.ValidatorPubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("WithdrawalRequest.ValidatorPubkey", size, 48)
+		return
+	}
+	dst = append(dst, w.ValidatorPubkey...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, w.Amount)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the WithdrawalRequest object
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 76 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'SourceAddress'
+	if cap(w.SourceAddress) == 0 {
+		w.SourceAddress = make([]byte, 0, len(buf[0:20]))
+	}
+	w.SourceAddress = append(w.SourceAddress, buf[0:20]...)
+
+	// Field (1) 'ValidatorPubkey'
+	if cap(w.ValidatorPubkey) == 0 {
+		w.ValidatorPubkey = make([]byte, 0, len(buf[20:68]))
+	}
+	w.ValidatorPubkey = append(w.ValidatorPubkey, buf[20:68]...)
+
+	// Field (2) 'Amount'
+	w.Amount = ssz.UnmarshallUint64(buf[68:76])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the WithdrawalRequest object
+func (w *WithdrawalRequest) SizeSSZ() (size int) {
+	size = 76
+	return
+}
+
+// HashTreeRoot ssz hashes the WithdrawalRequest object
+func (w *WithdrawalRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(w)
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest object with a hasher
+func (w *WithdrawalRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	if size := len(w.SourceAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("WithdrawalRequest.SourceAddress", size, 20)
+		return
+	}
+	hh.PutBytes(w.SourceAddress)
+
+	// Field (1) 'ValidatorPubkey'
+	if size := len(w.ValidatorPubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("WithdrawalRequest.ValidatorPubkey", size, 48)
+		return
+	}
+	hh.PutBytes(w.ValidatorPubkey)
+
+	// Field (2) 'Amount'
+	hh.PutUint64(w.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the WithdrawalRequest object
+func (w *WithdrawalRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(w)
+}
+
+// MarshalSSZ ssz marshals the ConsolidationRequest object
+func (c *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ConsolidationRequest object to a target array
+func (c *ConsolidationRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SourceAddress'
+	if size := len(c.SourceAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("ConsolidationRequest.SourceAddress", size, 20)
+		return
+	}
+	dst = append(dst, c.SourceAddress...)
+
+	// Field (1) 'SourcePubkey'
+	if size := len(c.SourcePubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ConsolidationRequest.SourcePubkey", size, 48)
+		return
+	}
+	dst = append(dst, c.SourcePubkey...)
+
+	// Field (2) 'TargetPubkey'
+	if size := len(c.TargetPubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ConsolidationRequest.TargetPubkey", size, 48)
+		return
+	}
+	dst = append(dst, c.TargetPubkey...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ConsolidationRequest object
+func (c *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 116 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'SourceAddress'
+	if cap(c.SourceAddress) == 0 {
+		c.SourceAddress = make([]byte, 0, len(buf[0:20]))
+	}
+	c.SourceAddress = append(c.SourceAddress, buf[0:20]...)
+
+	// Field (1) 'SourcePubkey'
+	if cap(c.SourcePubkey) == 0 {
+		c.SourcePubkey = make([]byte, 0, len(buf[20:68]))
+	}
+	c.SourcePubkey = append(c.SourcePubkey, buf[20:68]...)
+
+	// Field (2) 'TargetPubkey'
+	if cap(c.TargetPubkey) == 0 {
+		c.TargetPubkey = make([]byte, 0, len(buf[68:116]))
+	}
+	c.TargetPubkey = append(c.TargetPubkey, buf[68:116]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ConsolidationRequest object
+func (c *ConsolidationRequest) SizeSSZ() (size int) {
+	size = 116
+	return
+}
+
+// HashTreeRoot ssz hashes the ConsolidationRequest object
+func (c *ConsolidationRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ConsolidationRequest object with a hasher
+func (c *ConsolidationRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	if size := len(c.SourceAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("ConsolidationRequest.SourceAddress", size, 20)
+		return
+	}
+	hh.PutBytes(c.SourceAddress)
+
+	// Field (1) 'SourcePubkey'
+	if size := len(c.SourcePubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ConsolidationRequest.SourcePubkey", size, 48)
+		return
+	}
+	hh.PutBytes(c.SourcePubkey)
+
+	// Field (2) 'TargetPubkey'
+	if size := len(c.TargetPubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ConsolidationRequest.TargetPubkey", size, 48)
+		return
+	}
+	hh.PutBytes(c.TargetPubkey)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ConsolidationRequest object
+func (c *ConsolidationRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// MarshalSSZ ssz marshals the AttesterSlashing object
+func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
+func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Attestation1'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	offset += a.Attestation1.SizeSSZ()
+
+	// Offset (1) 'Attestation2'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Attestation1'
+	if dst, err = a.Attestation1.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if dst, err = a.Attestation2.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttesterSlashing object
+func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Attestation1'
+	{
+		buf = tail[o0:o1]
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
+		}
+		if err = a.Attestation1.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		buf = tail[o1:]
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
+		}
+		if err = a.Attestation2.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashing object
+func (a *AttesterSlashing) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	size += a.Attestation1.SizeSSZ()
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestation)
+	}
+	size += a.Attestation2.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AttesterSlashing object
+func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher
+func (a *AttesterSlashing) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Attestation1'
+	if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the AttesterSlashing object
+func (a *AttesterSlashing) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
+}
+
+// MarshalSSZ ssz marshals the IndexedAttestation object
+func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
+}
+
+// MarshalSSZTo ssz marshals the IndexedAttestation object to a target array
+func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(228)
+
+	// Offset (0) 'AttestingIndices'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(generic.AttestationData)
+	}
+	if dst, err = i.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	if size := len(i.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("IndexedAttestation.Signature", size, 96)
+		return
+	}
+	dst = append(dst, i.Signature...)
+
+	// Field (0) 'AttestingIndices'
+	if size := len(i.AttestingIndices); size > 131072 {
+		err = ssz.ErrListTooBigFn("IndexedAttestation.AttestingIndices", size, 131072)
+		return
+	}
+	for ii := 0; ii < len(i.AttestingIndices); ii++ {
+		dst = ssz.MarshalUint64(dst, i.AttestingIndices[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the IndexedAttestation object
+func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 228 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AttestingIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 228 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(generic.AttestationData)
+	}
+	if err = i.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Signature'
+	if cap(i.Signature) == 0 {
+		i.Signature = make([]byte, 0, len(buf[132:228]))
+	}
+	i.Signature = append(i.Signature, buf[132:228]...)
+
+	// Field (0) 'AttestingIndices'
+	{
+		buf = tail[o0:]
+		num, err := ssz.DivideInt2(len(buf), 8, 131072)
+		if err != nil {
+			return err
+		}
+		i.AttestingIndices = ssz.ExtendUint64(i.AttestingIndices, num)
+		for ii := 0; ii < num; ii++ {
+			i.AttestingIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the IndexedAttestation object
+func (i *IndexedAttestation) SizeSSZ() (size int) {
+	size = 228
+
+	// Field (0) 'AttestingIndices'
+	size += len(i.AttestingIndices) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the IndexedAttestation object
+func (i *IndexedAttestation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
+}
+
+// HashTreeRootWith ssz hashes the IndexedAttestation object with a hasher
+func (i *IndexedAttestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestingIndices'
+	{
+		if size := len(i.AttestingIndices); size > 131072 {
+			err = ssz.ErrListTooBigFn("IndexedAttestation.AttestingIndices", size, 131072)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range i.AttestingIndices {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(i.AttestingIndices))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(131072, numItems, 8))
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(generic.AttestationData)
+	}
+	if err = i.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	if size := len(i.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("IndexedAttestation.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(i.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the IndexedAttestation object
+func (i *IndexedAttestation) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(i)
+}
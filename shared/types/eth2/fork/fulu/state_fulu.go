@@ -0,0 +1,52 @@
+package fulu
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+// The Electra BeaconState with the proposer lookahead added in Fulu.
+// See https://github.com/ethereum/consensus-specs/blob/master/specs/fulu/beacon-chain.md#beaconstate
+type BeaconState struct {
+	GenesisTime                  uint64                          `json:"genesis_time"`
+	GenesisValidatorsRoot        []byte                          `json:"genesis_validators_root" ssz-size:"32"`
+	Slot                         uint64                          `json:"slot"`
+	Fork                         *generic.Fork                   `json:"fork"`
+	LatestBlockHeader            *generic.BeaconBlockHeader      `json:"latest_block_header"`
+	BlockRoots                   [8192][32]byte                  `json:"block_roots" ssz-size:"8192,32"`
+	StateRoots                   [8192][32]byte                  `json:"state_roots" ssz-size:"8192,32"`
+	HistoricalRoots              [][]byte                        `json:"historical_roots" ssz-max:"16777216" ssz-size:"?,32"`
+	Eth1Data                     *generic.Eth1Data               `json:"eth1_data"`
+	Eth1DataVotes                []*generic.Eth1Data             `json:"eth1_data_votes" ssz-max:"2048"`
+	Eth1DepositIndex             uint64                          `json:"eth1_deposit_index"`
+	Validators                   []*generic.Validator            `json:"validators" ssz-max:"1099511627776"`
+	Balances                     []uint64                        `json:"balances" ssz-max:"1099511627776"`
+	RandaoMixes                  [][]byte                        `json:"randao_mixes" ssz-size:"65536,32"`
+	Slashings                    []uint64                        `json:"slashings" ssz-size:"8192"`
+	PreviousEpochParticipation   []byte                          `json:"previous_epoch_participation" ssz-max:"1099511627776"`
+	CurrentEpochParticipation    []byte                          `json:"current_epoch_participation" ssz-max:"1099511627776"`
+	JustificationBits            [1]byte                         `json:"justification_bits" ssz-size:"1"`
+	PreviousJustifiedCheckpoint  *generic.Checkpoint             `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *generic.Checkpoint             `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *generic.Checkpoint             `json:"finalized_checkpoint"`
+	InactivityScores             []uint64                        `json:"inactivity_scores" ssz-max:"1099511627776"`
+	CurrentSyncCommittee         *generic.SyncCommittee          `json:"current_sync_committee"`
+	NextSyncCommittee            *generic.SyncCommittee          `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *generic.ExecutionPayloadHeader `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex          uint64                          `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex uint64                          `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries          []*generic.HistoricalSummary    `json:"historical_summaries" ssz-max:"16777216"`
+
+	// New in Electra
+	DepositRequestsStartIndex     uint64                              `json:"deposit_requests_start_index"`
+	DepositBalanceToConsume       uint64                              `json:"deposit_balance_to_consume"`
+	ExitBalanceToConsume          uint64                              `json:"exit_balance_to_consume"`
+	EarliestExitEpoch             uint64                              `json:"earliest_exit_epoch"`
+	ConsolidationBalanceToConsume uint64                              `json:"consolidation_balance_to_consume"`
+	EarliestConsolidationEpoch    uint64                              `json:"earliest_consolidation_epoch"`
+	PendingDeposits               []*generic.PendingDeposit           `json:"pending_deposits,omitempty" ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*generic.PendingPartialWithdrawal `json:"pending_partial_withdrawals,omitempty" ssz-max:"134217728"`
+	PendingConsolidations         []*generic.PendingConsolidation     `json:"pending_consolidations,omitempty" ssz-max:"262144"`
+
+	// New in Fulu
+	ProposerLookahead []uint64 `json:"proposer_lookahead" ssz-size:"64"`
+}
@@ -0,0 +1,33 @@
+package eth2
+
+// The EIP-3076 slashing protection interchange format version
+const InterchangeFormatVersion string = "5"
+
+// An EIP-3076 slashing protection interchange file
+type SlashingProtectionInterchange struct {
+	Metadata InterchangeMetadata    `json:"metadata"`
+	Data     []InterchangeValidator `json:"data"`
+}
+
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+// The signing history of a single validator
+type InterchangeValidator struct {
+	Pubkey             string                   `json:"pubkey"`
+	SignedBlocks       []InterchangeBlock       `json:"signed_blocks"`
+	SignedAttestations []InterchangeAttestation `json:"signed_attestations"`
+}
+
+type InterchangeBlock struct {
+	Slot        uint64 `json:"slot,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+type InterchangeAttestation struct {
+	SourceEpoch uint64 `json:"source_epoch,string"`
+	TargetEpoch uint64 `json:"target_epoch,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
@@ -16,7 +16,10 @@ import (
 	"testing"
 
 	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/deneb"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/electra"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/fulu"
 	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+	eth2test "github.com/rocket-pool/smartnode/shared/types/eth2/test"
 	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
 )
 
@@ -28,6 +31,14 @@ var testState []byte
 //go:embed testdata/block_11544444.ssz
 var testBlock []byte
 
+// Test block - electra fork. Fulu didn't change the block body, so it doubles as a fulu block.
+//
+//go:embed testdata/block_11900001.ssz
+var testElectraBlock []byte
+
+// Every fork's block body has between 9 and 16 fields, so they're padded to 16 chunks
+const testBeaconBlockBodyChunksCeil uint64 = 16
+
 func init() {
 	decompressor, err := gzip.NewReader(bytes.NewReader(testState))
 	if err != nil {
@@ -105,7 +116,12 @@ func TestOffsetGidRoot(t *testing.T) {
 	}
 }
 
-func validateStateProof(t *testing.T, leaf []byte, proof [][]byte, gid uint64, state *deneb.BeaconState) ([]byte, []byte) {
+// A beacon state from any fork, as far as validating proofs against it is concerned
+type hashableState interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+func validateStateProof(t *testing.T, leaf []byte, proof [][]byte, gid uint64, state hashableState, latestBlockHeader *generic.BeaconBlockHeader) ([]byte, []byte) {
 	// First, offset the gid to account for the fact that state proofs are actually beacon block header proofs
 	gid = offsetGidRoot(gid, generic.BeaconBlockHeaderStateRootGeneralizedIndex)
 	currentHash := leaf
@@ -127,21 +143,16 @@ func validateStateProof(t *testing.T, leaf []byte, proof [][]byte, gid uint64, s
 		currentHash = hash(currentHash, proofRow, neighborIsLeft)
 	}
 
-	// Shallow copy the LatestBlockHeader
-	lbh := *state.LatestBlockHeader
-	// Restore when done
-	defer func() {
-		state.LatestBlockHeader = &lbh
-	}()
-	// Set the state root in LatestBlockHeader before calculating the hash
 	stateRoot, err := state.HashTreeRoot()
 	if err != nil {
 		t.Fatalf("Failed to get state root: %v", err)
 	}
-	state.LatestBlockHeader.StateRoot = stateRoot[:]
+	// Shallow copy the LatestBlockHeader and set the state root in it before calculating the hash
+	lbh := *latestBlockHeader
+	lbh.StateRoot = stateRoot[:]
 
 	// The final hash must be the block root
-	finalHash, err := state.LatestBlockHeader.HashTreeRoot()
+	finalHash, err := lbh.HashTreeRoot()
 	if err != nil {
 		t.Fatalf("Failed to get block root: %v", err)
 	}
@@ -169,6 +180,7 @@ func TestValidatorStateProof(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to unmarshal test state: %v", err)
 	}
+	prover := wrapTestBeaconState(t, state)
 
 	type tc struct {
 		validatorIndex uint64
@@ -183,17 +195,18 @@ func TestValidatorStateProof(t *testing.T) {
 
 	// check a few of the proofs using the ssz library
 	for _, tc := range testCases {
-		proof, err := state.ValidatorProof(tc.validatorIndex)
+		proof, err := prover.ValidatorProof(tc.validatorIndex)
 		if err != nil {
 			t.Fatalf("Failed to get validator credentials proof: %v", err)
 		}
-		gid := generic.GetGeneralizedIndexForValidator(tc.validatorIndex, deneb.GetGeneralizedIndexForValidators())
+		// The deneb state has 28 fields, so they're padded to 32 chunks
+		gid := generic.GetGeneralizedIndexForValidator(tc.validatorIndex, 32+generic.BeaconStateValidatorsIndex)
 		t.Logf("gid: %v", gid)
 		if gid != tc.gid {
 			t.Fatalf("expected gid: %v, got: %v", tc.gid, gid)
 		}
 
-		stateRoot, blockRoot := validateStateProof(t, getValidatorLeaf(t, state.Validators[tc.validatorIndex]), proof, tc.gid, state)
+		stateRoot, blockRoot := validateStateProof(t, getValidatorLeaf(t, state.Validators[tc.validatorIndex]), proof, tc.gid, state, state.LatestBlockHeader)
 		t.Logf("stateRoot: %x", stateRoot)
 		t.Logf("blockRoot: %x", blockRoot)
 		expectedStateRoot, err := hex.DecodeString("2683ebc120f91f740c7bed4c866672d01e1ba51b4cc360297138465ee5df40f0")
@@ -213,21 +226,7 @@ func TestValidatorStateProof(t *testing.T) {
 	}
 }
 
-func validateBlockProof(t *testing.T, leaf [32]byte, proof [][]byte, gid uint64, block *deneb.SignedBeaconBlock) []byte {
-	savedExepectedBlockRoot, err := hex.DecodeString("8442138d973483bfeaba9082f28217234e2879dedb5202e67ef68e2349db9a31")
-	if err != nil {
-		panic(err)
-	}
-
-	expectedBlockRoot, err := block.Block.HashTreeRoot()
-	if err != nil {
-		t.Fatalf("Failed to get block root: %v", err)
-	}
-
-	if !bytes.Equal(expectedBlockRoot[:], savedExepectedBlockRoot[:]) {
-		t.Fatalf("expected block root: %x, got: %x", savedExepectedBlockRoot, expectedBlockRoot)
-	}
-
+func validateBlockProof(t *testing.T, leaf [32]byte, proof [][]byte, gid uint64, expectedBlockRoot [32]byte) []byte {
 	currentHash := leaf[:]
 	for i, proofRow := range proof {
 		// The last neighbor must have a gid of either 2 or 3
@@ -260,9 +259,25 @@ func TestWithdrawalProof(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to unmarshal test block: %v", err)
 	}
+	prover, err := WrapSignedBeaconBlock(block)
+	if err != nil {
+		t.Fatalf("Failed to wrap test block: %v", err)
+	}
+
+	savedExepectedBlockRoot, err := hex.DecodeString("8442138d973483bfeaba9082f28217234e2879dedb5202e67ef68e2349db9a31")
+	if err != nil {
+		panic(err)
+	}
+	expectedBlockRoot, err := block.Block.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("Failed to get block root: %v", err)
+	}
+	if !bytes.Equal(expectedBlockRoot[:], savedExepectedBlockRoot[:]) {
+		t.Fatalf("expected block root: %x, got: %x", savedExepectedBlockRoot, expectedBlockRoot)
+	}
 
 	for idx, withdrawal := range block.Block.Body.ExecutionPayload.Withdrawals {
-		proof, err := block.ProveWithdrawal(uint64(idx))
+		proof, err := prover.ProveWithdrawal(uint64(idx))
 		if err != nil {
 			t.Fatalf("Failed to get withdrawal proof: %v", err)
 		}
@@ -274,7 +289,7 @@ func TestWithdrawalProof(t *testing.T) {
 		}
 		gid := uint64(1)
 		gid = gid*generic.BeaconBlockChunksCeil + generic.BeaconBlockBodyIndex
-		gid = gid*deneb.BeaconBlockBodyChunksCeil + generic.BeaconBlockBodyExecutionPayloadIndex
+		gid = gid*testBeaconBlockBodyChunksCeil + generic.BeaconBlockBodyExecutionPayloadIndex
 		gid = gid*generic.BeaconBlockBodyExecutionPayloadChunksCeil + generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex
 		gid = gid * 2
 		gid = gid*generic.BeaconBlockWithdrawalsArrayMax + uint64(idx)
@@ -282,7 +297,7 @@ func TestWithdrawalProof(t *testing.T) {
 		if err != nil {
 			t.Fatalf("Failed to get withdrawal leaf: %v", err)
 		}
-		validateBlockProof(t, leaf, proof, gid, block)
+		validateBlockProof(t, leaf, proof, gid, expectedBlockRoot)
 	}
 }
 
@@ -363,3 +378,221 @@ func TestBlockRootProof(t *testing.T) {
 	}
 
 }
+
+// The number of validators in the synthetic fulu state
+const testFuluValidatorCount uint64 = 2048
+
+// Round trip the synthetic fulu state through SSZ and the fork dispatch, like a state from the Beacon API.
+// Returns the prover along with the decoded state it wraps.
+func getTestFuluState(t *testing.T) (*beaconState, *fulu.BeaconState) {
+	data, err := eth2test.NewFuluState(testFuluValidatorCount).MarshalSSZ()
+	if err != nil {
+		t.Fatalf("Failed to marshal fulu state: %v", err)
+	}
+	state, err := NewBeaconState(data, "Fulu")
+	if err != nil {
+		t.Fatalf("Failed to unmarshal fulu state: %v", err)
+	}
+	prover, ok := state.(*beaconState)
+	if !ok {
+		t.Fatalf("expected a beacon state prover, got: %T", state)
+	}
+	fuluState, ok := prover.state.(*fulu.BeaconState)
+	if !ok {
+		t.Fatalf("expected a fulu state, got: %T", prover.state)
+	}
+	return prover, fuluState
+}
+
+func wrapTestBeaconState(t *testing.T, state SSZContainer) BeaconState {
+	prover, err := WrapBeaconState(state)
+	if err != nil {
+		t.Fatalf("Failed to wrap test state: %v", err)
+	}
+	return prover
+}
+
+func TestForkDispatch(t *testing.T) {
+	_, err := NewBeaconState(nil, "phase0")
+	if err == nil {
+		t.Fatalf("expected an error for an unsupported fork")
+	}
+	_, err = NewSignedBeaconBlock(testElectraBlock, "phase0")
+	if err == nil {
+		t.Fatalf("expected an error for an unsupported fork")
+	}
+
+	prover, state := getTestFuluState(t)
+	if prover.GetSlot() != eth2test.FuluStateSlot {
+		t.Fatalf("expected slot: %d, got: %d", eth2test.FuluStateSlot, prover.GetSlot())
+	}
+	if uint64(len(prover.GetValidators())) != testFuluValidatorCount {
+		t.Fatalf("expected %d validators, got: %d", testFuluValidatorCount, len(prover.GetValidators()))
+	}
+	if state.ProposerLookahead[1] != 31 {
+		t.Fatalf("expected proposer lookahead 31, got: %d", state.ProposerLookahead[1])
+	}
+
+	// The fulu state is the electra state with the proposer lookahead appended, so it mustn't decode as an electra state
+	data, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatalf("Failed to marshal fulu state: %v", err)
+	}
+	_, err = NewBeaconState(data, "electra")
+	if err == nil {
+		t.Fatalf("expected a fulu state to fail to decode as an electra state")
+	}
+
+	// The provers find the fields they need by position, so containers without them must be rejected
+	_, err = WrapBeaconState(&generic.BeaconBlockHeader{})
+	if err == nil {
+		t.Fatalf("expected an error for a container that isn't a beacon state")
+	}
+	_, err = WrapSignedBeaconBlock(&deneb.BeaconBlock{})
+	if err == nil {
+		t.Fatalf("expected an error for a container that isn't a signed beacon block")
+	}
+}
+
+func TestFuluValidatorStateProof(t *testing.T) {
+	prover, state := getTestFuluState(t)
+
+	type tc struct {
+		validatorIndex uint64
+		gid            uint64
+	}
+
+	testCases := []tc{
+		{validatorIndex: 0, gid: 164926744166400},
+		{validatorIndex: 1111, gid: 164926744167511},
+		{validatorIndex: 2047, gid: 164926744168447},
+	}
+
+	for _, tc := range testCases {
+		proof, err := prover.ValidatorProof(tc.validatorIndex)
+		if err != nil {
+			t.Fatalf("Failed to get validator proof: %v", err)
+		}
+		// The fulu state has 38 fields, so they're padded to 64 chunks
+		gid := generic.GetGeneralizedIndexForValidator(tc.validatorIndex, 64+generic.BeaconStateValidatorsIndex)
+		if gid != tc.gid {
+			t.Fatalf("expected gid: %v, got: %v", tc.gid, gid)
+		}
+
+		stateRoot, blockRoot := validateStateProof(t, getValidatorLeaf(t, state.Validators[tc.validatorIndex]), proof, tc.gid, state, state.LatestBlockHeader)
+		expectedStateRoot, err := hex.DecodeString("2d48bcf9f952949e36a5a14df1790bd186072f4f2a4c128ea0f617b750ea91d0")
+		if err != nil {
+			panic(err)
+		}
+		expectedBlockRoot, err := hex.DecodeString("eccd218f0e9d03290db42acf3b95184b4493282dedfcf6eb671d99f6e52a9d3f")
+		if err != nil {
+			panic(err)
+		}
+		if !bytes.Equal(stateRoot, expectedStateRoot) {
+			t.Fatalf("expected state root: %x, got: %x", expectedStateRoot, stateRoot)
+		}
+		if !bytes.Equal(blockRoot, expectedBlockRoot) {
+			t.Fatalf("expected block root: %x, got: %x", expectedBlockRoot, blockRoot)
+		}
+	}
+
+	_, err := prover.ValidatorProof(testFuluValidatorCount)
+	if err == nil {
+		t.Fatalf("expected an error for a validator index out of bounds")
+	}
+}
+
+func TestFuluBlockRootProof(t *testing.T) {
+	prover, state := getTestFuluState(t)
+
+	for _, slot := range []uint64{eth2test.FuluStateSlot - 1, eth2test.FuluStateSlot - 5000, eth2test.FuluStateSlot - generic.SlotsPerHistoricalRoot + 1} {
+		proof, err := prover.BlockRootProof(slot)
+		if err != nil {
+			t.Fatalf("Failed to get block root proof for slot %d: %v", slot, err)
+		}
+		gid := uint64(64+generic.BeaconStateBlockRootsFieldIndex)*generic.BeaconStateBlockRootsMaxLength + slot%generic.SlotsPerHistoricalRoot
+		validateStateProof(t, state.BlockRoots[slot%generic.SlotsPerHistoricalRoot][:], proof, gid, state, state.LatestBlockHeader)
+	}
+
+	_, err := prover.BlockRootProof(eth2test.FuluStateSlot - generic.SlotsPerHistoricalRoot)
+	if err == nil {
+		t.Fatalf("expected an error for a slot that needs a historical summary proof")
+	}
+}
+
+func TestFuluHistoricalSummaryProof(t *testing.T) {
+	prover, state := getTestFuluState(t)
+
+	for _, slot := range []uint64{0, generic.SlotsPerHistoricalRoot + 17, eth2test.FuluStateSlot - generic.SlotsPerHistoricalRoot} {
+		proof, err := prover.HistoricalSummaryProof(slot)
+		if err != nil {
+			t.Fatalf("Failed to get historical summary proof for slot %d: %v", slot, err)
+		}
+		arrayIndex := slot / generic.SlotsPerHistoricalRoot
+		gid := (64+generic.BeaconStateHistoricalSummariesFieldIndex)*2*generic.BeaconStateHistoricalSummariesMaxLength + arrayIndex
+		leaf, err := state.HistoricalSummaries[arrayIndex].HashTreeRoot()
+		if err != nil {
+			t.Fatalf("Failed to get historical summary leaf: %v", err)
+		}
+		validateStateProof(t, leaf[:], proof, gid, state, state.LatestBlockHeader)
+	}
+
+	_, err := prover.HistoricalSummaryProof(eth2test.FuluStateSlot - 1)
+	if err == nil {
+		t.Fatalf("expected an error for a slot that needs a block root proof")
+	}
+}
+
+func TestFuluWithdrawalProof(t *testing.T) {
+	signedBlock, err := NewSignedBeaconBlock(testElectraBlock, "fulu")
+	if err != nil {
+		t.Fatalf("Failed to unmarshal test block: %v", err)
+	}
+	prover, ok := signedBlock.(*signedBeaconBlock)
+	if !ok {
+		t.Fatalf("expected a signed beacon block prover, got: %T", signedBlock)
+	}
+	block, ok := prover.block.(*fulu.SignedBeaconBlock)
+	if !ok {
+		t.Fatalf("expected a fulu block, got: %T", prover.block)
+	}
+	if !prover.HasExecutionPayload() || len(prover.Withdrawals()) == 0 {
+		t.Fatalf("expected the test block to have withdrawals")
+	}
+
+	// The block body didn't change in fulu, so the block root must match the electra decoding
+	electraBlock := &electra.SignedBeaconBlock{}
+	err = electraBlock.UnmarshalSSZ(testElectraBlock)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal test block as electra: %v", err)
+	}
+	expectedBlockRoot, err := electraBlock.Block.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("Failed to get electra block root: %v", err)
+	}
+	blockRoot, err := block.Block.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("Failed to get block root: %v", err)
+	}
+	if blockRoot != expectedBlockRoot {
+		t.Fatalf("expected block root: %x, got: %x", expectedBlockRoot, blockRoot)
+	}
+
+	for idx, withdrawal := range prover.Withdrawals() {
+		proof, err := prover.ProveWithdrawal(uint64(idx))
+		if err != nil {
+			t.Fatalf("Failed to get withdrawal proof: %v", err)
+		}
+		gid := uint64(1)
+		gid = gid*generic.BeaconBlockChunksCeil + generic.BeaconBlockBodyIndex
+		gid = gid*testBeaconBlockBodyChunksCeil + generic.BeaconBlockBodyExecutionPayloadIndex
+		gid = gid*generic.BeaconBlockBodyExecutionPayloadChunksCeil + generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex
+		gid = gid * 2
+		gid = gid*generic.BeaconBlockWithdrawalsArrayMax + uint64(idx)
+		leaf, err := withdrawal.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("Failed to get withdrawal leaf: %v", err)
+		}
+		validateBlockProof(t, leaf, proof, gid, blockRoot)
+	}
+}
@@ -0,0 +1,247 @@
+package eth2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+// An SSZ container from one of the forks, with the encoding methods generated by fastssz
+type SSZContainer interface {
+	GetTree() (*ssz.Node, error)
+	UnmarshalSSZ(buf []byte) error
+}
+
+// The BeaconState fields the proofs are built from, which have had the same positions and types in every fork since Capella
+var beaconStateProofFields = []struct {
+	name      string
+	index     uint64
+	fieldType reflect.Type
+}{
+	{"Slot", 2, reflect.TypeFor[uint64]()},
+	{"LatestBlockHeader", 4, reflect.TypeFor[*generic.BeaconBlockHeader]()},
+	{"BlockRoots", generic.BeaconStateBlockRootsFieldIndex, reflect.TypeFor[[generic.SlotsPerHistoricalRoot][32]byte]()},
+	{"StateRoots", generic.BeaconStateBlockRootsFieldIndex + 1, reflect.TypeFor[[generic.SlotsPerHistoricalRoot][32]byte]()},
+	{"Validators", generic.BeaconStateValidatorsIndex, reflect.TypeFor[[]*generic.Validator]()},
+	{"HistoricalSummaries", generic.BeaconStateHistoricalSummariesFieldIndex, reflect.TypeFor[[]*generic.HistoricalSummary]()},
+}
+
+// Builds the proofs the Rocket Pool contracts need from any fork's BeaconState.
+// The fields it uses are found by position, so a new fork only needs its types and their generated encoding.
+type beaconState struct {
+	state     SSZContainer
+	fields    reflect.Value
+	chunkCeil uint64
+}
+
+// Wrap a fork's BeaconState, checking that it has the fields the proofs need
+func WrapBeaconState(state SSZContainer) (BeaconState, error) {
+	fields := reflect.ValueOf(state)
+	if fields.Kind() != reflect.Pointer || fields.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("beacon state must be a pointer to a struct, got %T", state)
+	}
+	fields = fields.Elem()
+	for _, field := range beaconStateProofFields {
+		if uint64(fields.NumField()) <= field.index || fields.Type().Field(int(field.index)).Name != field.name || fields.Field(int(field.index)).Type() != field.fieldType {
+			return nil, fmt.Errorf("%T doesn't have a %s field of type %s at index %d", state, field.name, field.fieldType, field.index)
+		}
+	}
+	return &beaconState{
+		state:     state,
+		fields:    fields,
+		chunkCeil: math.GetPowerOfTwoCeil(uint64(fields.NumField())),
+	}, nil
+}
+
+func (s *beaconState) GetSlot() uint64 {
+	return s.fields.Field(2).Uint()
+}
+
+func (s *beaconState) GetValidators() []*generic.Validator {
+	return s.fields.Field(int(generic.BeaconStateValidatorsIndex)).Interface().([]*generic.Validator)
+}
+
+func (s *beaconState) getLatestBlockHeader() *generic.BeaconBlockHeader {
+	return s.fields.Field(4).Interface().(*generic.BeaconBlockHeader)
+}
+
+// Get the generalized index of the validator registry in the state
+func (s *beaconState) getGeneralizedIndexForValidators() uint64 {
+	// The fields are the leaves of a tree padded to the next power of two, so the validators are at chunkCeil + their field index
+	return s.chunkCeil + generic.BeaconStateValidatorsIndex
+}
+
+// ValidatorProof computes the merkle proof for an entire validator container at a specific index in the validator registry.
+func (s *beaconState) ValidatorProof(index uint64) ([][]byte, error) {
+	validators := s.GetValidators()
+	if index >= uint64(len(validators)) {
+		return nil, errors.New("validator index out of bounds")
+	}
+
+	// Convert the state to a proof tree
+	root, err := s.state.GetTree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get state tree: %w", err)
+	}
+
+	// Grab the proof for the validator's generalized index
+	generalizedIndex := generic.GetGeneralizedIndexForValidator(index, s.getGeneralizedIndexForValidators())
+	proof, err := root.Prove(int(generalizedIndex))
+	if err != nil {
+		return nil, fmt.Errorf("could not get proof for validator: %w", err)
+	}
+
+	// Sanity check that the proof leaf matches the expected validator
+	validatorHashTreeRoot, err := validators[index].HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("could not get hash tree root for validator: %w", err)
+	}
+	if !bytes.Equal(proof.Leaf, validatorHashTreeRoot[:]) {
+		return nil, fmt.Errorf("proof leaf does not match expected validator")
+	}
+
+	// The EL proves against BeaconBlockHeader root, so we need to merge the state proof with that.
+	blockHeaderProof, err := blockHeaderToStateProof(s.getLatestBlockHeader())
+	if err != nil {
+		return nil, fmt.Errorf("could not get block header proof: %w", err)
+	}
+	return append(proof.Hashes, blockHeaderProof...), nil
+}
+
+// ValidatorsFieldProof computes the merkle proof for the root of the validator registry, up to the block header root.
+// The proof doesn't depend on the validators themselves, so they're left out of the tree to save memory.
+func (s *beaconState) ValidatorsFieldProof() ([][]byte, error) {
+	stateWithoutValidators := reflect.New(s.fields.Type())
+	stateWithoutValidators.Elem().Set(s.fields)
+	validators := stateWithoutValidators.Elem().Field(int(generic.BeaconStateValidatorsIndex))
+	validators.Set(reflect.Zero(validators.Type()))
+	root, err := stateWithoutValidators.Interface().(SSZContainer).GetTree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get state tree: %w", err)
+	}
+	proof, err := root.Prove(int(s.getGeneralizedIndexForValidators()))
+	if err != nil {
+		return nil, fmt.Errorf("could not get proof for validators: %w", err)
+	}
+
+	blockHeaderProof, err := blockHeaderToStateProof(s.getLatestBlockHeader())
+	if err != nil {
+		return nil, fmt.Errorf("could not get block header proof: %w", err)
+	}
+	return append(proof.Hashes, blockHeaderProof...), nil
+}
+
+func (s *beaconState) HistoricalSummaryProof(slot uint64) ([][]byte, error) {
+	stateSlot := s.GetSlot()
+	isHistorical := slot+generic.SlotsPerHistoricalRoot <= stateSlot
+	if !isHistorical {
+		return nil, fmt.Errorf("slot %d is less than %d slots in the past from the state at slot %d, you must build a proof from the block_roots field instead", slot, generic.SlotsPerHistoricalRoot, stateSlot)
+	}
+	tree, err := s.state.GetTree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get state tree: %w", err)
+	}
+
+	// Navigate to the historical_summaries
+	gid := uint64(1)
+	gid = gid*s.chunkCeil + generic.BeaconStateHistoricalSummariesFieldIndex
+	// Navigate into the historical summaries vector.
+	arrayIndex := (slot / generic.SlotsPerHistoricalRoot)
+	gid = gid*2*generic.BeaconStateHistoricalSummariesMaxLength + arrayIndex
+
+	proof, err := tree.Prove(int(gid))
+	if err != nil {
+		return nil, fmt.Errorf("could not get proof for historical block root: %w", err)
+	}
+
+	// The EL proves against BeaconBlockHeader root, so we need to merge the state proof with that.
+	blockHeaderProof, err := blockHeaderToStateProof(s.getLatestBlockHeader())
+	if err != nil {
+		return nil, fmt.Errorf("could not get block header proof: %w", err)
+	}
+	return append(proof.Hashes, blockHeaderProof...), nil
+}
+
+func (s *beaconState) HistoricalSummaryBlockRootProof(slot int) ([][]byte, error) {
+	// The state must be the first one of an 8192 slot era, so its block roots are the previous era's
+	if s.GetSlot()%generic.SlotsPerHistoricalRoot != 0 {
+		return nil, fmt.Errorf("state is not aligned at the end of an 8192 slot era")
+	}
+
+	hsls := generic.HistoricalSummaryLists{
+		BlockRoots: s.fields.Field(int(generic.BeaconStateBlockRootsFieldIndex)).Interface().([generic.SlotsPerHistoricalRoot][32]byte),
+		StateRoots: s.fields.Field(int(generic.BeaconStateBlockRootsFieldIndex + 1)).Interface().([generic.SlotsPerHistoricalRoot][32]byte),
+	}
+
+	idx := slot % int(generic.SlotsPerHistoricalRoot)
+	tree, err := hsls.GetTree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get historical summary lists tree: %w", err)
+	}
+
+	gid := uint64(1)
+	gid = gid * 2                              // Now at block_roots
+	gid = gid * generic.SlotsPerHistoricalRoot // Now at the first block_root
+	gid = gid + uint64(idx)                    // Now at the correct block_root
+
+	proof, err := tree.Prove(int(gid))
+	if err != nil {
+		return nil, fmt.Errorf("could not get proof for historical summary: %w", err)
+	}
+
+	return proof.Hashes, nil
+}
+
+func (s *beaconState) BlockRootProof(slot uint64) ([][]byte, error) {
+	stateSlot := s.GetSlot()
+	isHistorical := slot+generic.SlotsPerHistoricalRoot <= stateSlot
+	if isHistorical {
+		return nil, fmt.Errorf("slot %d is more than %d slots in the past from the state at slot %d, you must build a proof from the historical_summaries instead", slot, generic.SlotsPerHistoricalRoot, stateSlot)
+	}
+
+	tree, err := s.state.GetTree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get state tree: %w", err)
+	}
+
+	gid := uint64(1)
+
+	// Navigate to the block_roots
+	gid = gid*s.chunkCeil + generic.BeaconStateBlockRootsFieldIndex
+
+	// We're now at the block_roots vector, which is the root of a slotsPerHistoricalRoot slots vector.
+	// The index we care about is given by slot % slotsPerHistoricalRoot.
+	gid = gid*generic.BeaconStateBlockRootsMaxLength + (slot % generic.SlotsPerHistoricalRoot)
+
+	proof, err := tree.Prove(int(gid))
+	if err != nil {
+		return nil, fmt.Errorf("could not get proof for block root: %w", err)
+	}
+
+	// Finally, prove from the block header to the state root.
+	blockHeaderProof, err := blockHeaderToStateProof(s.getLatestBlockHeader())
+	if err != nil {
+		return nil, fmt.Errorf("could not get block header proof: %w", err)
+	}
+
+	return append(proof.Hashes, blockHeaderProof...), nil
+}
+
+// Get the proof from a block header's state root up to the header's root
+func blockHeaderToStateProof(blockHeader *generic.BeaconBlockHeader) ([][]byte, error) {
+	generalizedIndex := generic.BeaconBlockHeaderStateRootGeneralizedIndex
+	root, err := blockHeader.GetTree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get block header tree: %w", err)
+	}
+	blockHeaderProof, err := root.Prove(int(generalizedIndex))
+	if err != nil {
+		return nil, fmt.Errorf("could not get proof for block header: %w", err)
+	}
+	return blockHeaderProof.Hashes, nil
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/fulu"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+// The slot of the synthetic fulu state, 3 historical summaries into the chain
+const FuluStateSlot uint64 = 3*generic.SlotsPerHistoricalRoot + 100
+
+// Build a fulu state with deterministic contents and the provided number of validators, since the proofs only depend on its layout
+func NewFuluState(validatorCount uint64) *fulu.BeaconState {
+	root := func(seed uint64) []byte {
+		out := sha256.Sum256([]byte(fmt.Sprintf("fulu-%d", seed)))
+		return out[:]
+	}
+	state := &fulu.BeaconState{
+		GenesisTime:           1742213400,
+		GenesisValidatorsRoot: root(0),
+		Slot:                  FuluStateSlot,
+		Fork: &generic.Fork{
+			PreviousVersion: []byte{0x60, 0x00, 0x09, 0x10},
+			CurrentVersion:  []byte{0x70, 0x00, 0x09, 0x10},
+			Epoch:           50688,
+		},
+		LatestBlockHeader: &generic.BeaconBlockHeader{
+			Slot:          FuluStateSlot,
+			ProposerIndex: 42,
+			ParentRoot:    root(1),
+			StateRoot:     make([]byte, 32),
+			BodyRoot:      root(2),
+		},
+		Eth1Data: &generic.Eth1Data{
+			DepositRoot:  root(3),
+			DepositCount: validatorCount,
+			BlockHash:    root(4),
+		},
+		Eth1DepositIndex: validatorCount,
+		RandaoMixes:      make([][]byte, 65536),
+		Slashings:        make([]uint64, 8192),
+		PreviousJustifiedCheckpoint: &generic.Checkpoint{
+			Root: root(5),
+		},
+		CurrentJustifiedCheckpoint: &generic.Checkpoint{
+			Root: root(6),
+		},
+		FinalizedCheckpoint: &generic.Checkpoint{
+			Root: root(7),
+		},
+		CurrentSyncCommittee:         &generic.SyncCommittee{PubKeys: make([][]byte, 512)},
+		NextSyncCommittee:            &generic.SyncCommittee{PubKeys: make([][]byte, 512)},
+		LatestExecutionPayloadHeader: &generic.ExecutionPayloadHeader{},
+		DepositRequestsStartIndex:    validatorCount,
+		ProposerLookahead:            make([]uint64, 64),
+	}
+	for i := range state.BlockRoots {
+		copy(state.BlockRoots[i][:], root(uint64(100000+i)))
+		copy(state.StateRoots[i][:], root(uint64(200000+i)))
+	}
+	for i := range state.RandaoMixes {
+		state.RandaoMixes[i] = root(uint64(300000 + i))
+	}
+	for i := range state.CurrentSyncCommittee.PubKeys {
+		state.CurrentSyncCommittee.PubKeys[i] = make([]byte, 48)
+		state.NextSyncCommittee.PubKeys[i] = make([]byte, 48)
+	}
+	if validatorCount > 0 {
+		for i := range state.ProposerLookahead {
+			state.ProposerLookahead[i] = uint64(i*31) % validatorCount
+		}
+	}
+	for i := uint64(0); i < validatorCount; i++ {
+		pubkey := make([]byte, 48)
+		copy(pubkey, root(400000+i))
+		withdrawalCredentials := root(500000 + i)
+		withdrawalCredentials[0] = 0x02
+		state.Validators = append(state.Validators, &generic.Validator{
+			Pubkey:                     pubkey,
+			WithdrawalCredentials:      withdrawalCredentials,
+			EffectiveBalance:           32000000000 + i*1000000000,
+			ActivationEligibilityEpoch: i,
+			ActivationEpoch:            i + 5,
+			ExitEpoch:                  ^uint64(0),
+			WithdrawableEpoch:          ^uint64(0),
+		})
+		state.Balances = append(state.Balances, 32000000000+i)
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 7)
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, 7)
+		state.InactivityScores = append(state.InactivityScores, 0)
+	}
+	for i := uint64(0); i < FuluStateSlot/generic.SlotsPerHistoricalRoot; i++ {
+		summary := &generic.HistoricalSummary{}
+		copy(summary.BlockSummaryRoot[:], root(600000+i))
+		copy(summary.StateSummaryRoot[:], root(700000+i))
+		state.HistoricalSummaries = append(state.HistoricalSummaries, summary)
+	}
+	state.PendingDeposits = []*generic.PendingDeposit{
+		{
+			Pubkey:                make([]byte, 48),
+			WithdrawalCredentials: root(8),
+			Amount:                1000000000,
+			Signature:             make([]byte, 96),
+			Slot:                  FuluStateSlot - 1,
+		},
+	}
+	state.PendingPartialWithdrawals = []*generic.PendingPartialWithdrawal{
+		{ValidatorIndex: 7, Amount: 1000000000, WithdrawableEpoch: 50700},
+	}
+	state.PendingConsolidations = []*generic.PendingConsolidation{
+		{SourceIndex: 8, TargetIndex: 9},
+	}
+	return state
+}
@@ -6,20 +6,14 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/deneb"
 	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/electra"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/fulu"
 	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 )
 
-// State type assertions
-var _ BeaconState = &deneb.BeaconState{}
-var _ BeaconState = &electra.BeaconState{}
-
-// Block type assertions
-var _ SignedBeaconBlock = &deneb.SignedBeaconBlock{}
-var _ SignedBeaconBlock = &electra.SignedBeaconBlock{}
-
 type BeaconState interface {
 	GetSlot() uint64
 	ValidatorProof(index uint64) ([][]byte, error)
+	ValidatorsFieldProof() ([][]byte, error)
 	HistoricalSummaryProof(slot uint64) ([][]byte, error)
 	HistoricalSummaryBlockRootProof(slot int) ([][]byte, error)
 	BlockRootProof(slot uint64) ([][]byte, error)
@@ -32,48 +26,63 @@ type SignedBeaconBlock interface {
 	Withdrawals() []*generic.Withdrawal
 }
 
-func NewBeaconState(data []byte, fork string) (BeaconState, error) {
+// Creates the empty types a fork's SSZ data is decoded into
+type forkTypes struct {
+	newBeaconState       func() SSZContainer
+	newSignedBeaconBlock func() SSZContainer
+}
+
+// The supported forks, keyed by the name the Beacon API reports in its version header.
+// To support a new fork, add its types to a package under fork/, generate their encodings with sszgen.sh, and register them here;
+// the proofs are built from the generated types by the shared provers in state.go and block.go.
+var forks = map[string]forkTypes{
+	"deneb": {
+		newBeaconState:       func() SSZContainer { return &deneb.BeaconState{} },
+		newSignedBeaconBlock: func() SSZContainer { return &deneb.SignedBeaconBlock{} },
+	},
+	"electra": {
+		newBeaconState:       func() SSZContainer { return &electra.BeaconState{} },
+		newSignedBeaconBlock: func() SSZContainer { return &electra.SignedBeaconBlock{} },
+	},
+	"fulu": {
+		newBeaconState:       func() SSZContainer { return &fulu.BeaconState{} },
+		newSignedBeaconBlock: func() SSZContainer { return &fulu.SignedBeaconBlock{} },
+	},
+}
+
+func getForkTypes(fork string) (forkTypes, error) {
 	fork = strings.ToLower(fork)
+	types, exists := forks[fork]
+	if !exists {
+		return forkTypes{}, fmt.Errorf("unsupported fork: %s", fork)
+	}
+	return types, nil
+}
 
-	switch fork {
-	case "deneb":
-		out := &deneb.BeaconState{}
-		err := out.UnmarshalSSZ(data)
-		if err != nil {
-			return nil, err
-		}
-		return out, nil
-	case "electra":
-		out := &electra.BeaconState{}
-		err := out.UnmarshalSSZ(data)
-		if err != nil {
-			return nil, err
-		}
-		return out, nil
-	default:
-		return nil, fmt.Errorf("unsupported fork: %s", fork)
+func NewBeaconState(data []byte, fork string) (BeaconState, error) {
+	types, err := getForkTypes(fork)
+	if err != nil {
+		return nil, err
 	}
+
+	out := types.newBeaconState()
+	err = out.UnmarshalSSZ(data)
+	if err != nil {
+		return nil, err
+	}
+	return WrapBeaconState(out)
 }
 
 func NewSignedBeaconBlock(data []byte, fork string) (SignedBeaconBlock, error) {
-	fork = strings.ToLower(fork)
+	types, err := getForkTypes(fork)
+	if err != nil {
+		return nil, err
+	}
 
-	switch fork {
-	case "deneb":
-		out := &deneb.SignedBeaconBlock{}
-		err := out.UnmarshalSSZ(data)
-		if err != nil {
-			return nil, err
-		}
-		return out, nil
-	case "electra":
-		out := &electra.SignedBeaconBlock{}
-		err := out.UnmarshalSSZ(data)
-		if err != nil {
-			return nil, err
-		}
-		return out, nil
-	default:
-		return nil, fmt.Errorf("unsupported fork: %s", fork)
+	out := types.newSignedBeaconBlock()
+	err = out.UnmarshalSSZ(data)
+	if err != nil {
+		return nil, err
 	}
+	return WrapSignedBeaconBlock(out)
 }
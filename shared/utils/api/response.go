@@ -3,14 +3,32 @@ package api
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"os"
 	"reflect"
+	"sync"
 
 	"github.com/goccy/go-json"
 
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
+// The destination for API responses; this is stdout unless the API is running as a server
+var (
+	output     io.Writer = os.Stdout
+	outputLock sync.Mutex
+)
+
+// Set the destination for API responses, returning the previous one
+func SetOutput(w io.Writer) io.Writer {
+	outputLock.Lock()
+	defer outputLock.Unlock()
+	previous := output
+	output = w
+	return previous
+}
+
 func ZeroIfNil(in **big.Int) {
 	if *in == nil {
 		*in = big.NewInt(0)
@@ -62,7 +80,9 @@ func PrintResponse(response interface{}, responseError error) {
 	}
 
 	// Print
-	fmt.Println(string(responseBytes))
+	outputLock.Lock()
+	defer outputLock.Unlock()
+	fmt.Fprintln(output, string(responseBytes))
 
 }
 
@@ -63,8 +63,8 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 	return true
 }
 
-// Print a TX's details to the logger and waits for it to validated.
-func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, ec rocketpool.ExecutionClient, logger *log.ColorLogger) error {
+// Print a TX's details to the logger.
+func PrintTransactionHash(cfg *config.RocketPoolConfig, hash common.Hash, logger *log.ColorLogger) {
 
 	txWatchUrl := cfg.Smartnode.GetTxWatchUrl()
 	hashString := hash.String()
@@ -74,6 +74,19 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 		logger.Printlnf("You may follow its progress by visiting:")
 		logger.Printlnf("%s/%s\n", txWatchUrl, hashString)
 	}
+
+}
+
+// Print a TX's details to the logger and waits for it to validated.
+func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, ec rocketpool.ExecutionClient, logger *log.ColorLogger) error {
+
+	PrintTransactionHash(cfg, hash, logger)
+
+	// Dry-run transactions are only simulated, so there's nothing to wait for
+	if cfg.Smartnode.DryRun.Value == true {
+		logger.Println("The daemon is in dry-run mode, so the transaction was simulated instead of being sent.")
+		return nil
+	}
 	logger.Println("Waiting for the transaction to be validated...")
 
 	// Wait for the TX to be included in a block
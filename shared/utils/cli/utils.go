@@ -56,6 +56,14 @@ func printTransactionHashImpl(rp *rocketpool.Client, hash common.Hash, finalMess
 		return
 	}
 
+	// Exported transactions don't have a final hash until they're signed
+	if rp.IsOfflineTx() {
+		fmt.Printf("Transaction has been exported to %s for offline signing.\n", cfg.Smartnode.GetOfflineTransactionsPath(false))
+		fmt.Println("Sign it with `rocketpool wallet sign-offline` on the machine that holds your node wallet, then submit it with `rocketpool node broadcast`.")
+		fmt.Println()
+		return
+	}
+
 	txWatchUrl := cfg.Smartnode.GetTxWatchUrl()
 	hashString := hash.String()
 
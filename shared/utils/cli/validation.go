@@ -342,6 +342,20 @@ func ValidatePubkey(name, value string) (types.ValidatorPubkey, error) {
 	return pubkey, nil
 }
 
+// Validate a collection of validator pubkeys
+func ValidatePubkeys(name, value string) ([]types.ValidatorPubkey, error) {
+	elements := strings.Split(value, ",")
+	pubkeys := make([]types.ValidatorPubkey, len(elements))
+	for i, element := range elements {
+		pubkey, err := types.HexToValidatorPubkey(hexutils.RemovePrefix(strings.TrimSpace(element)))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid pubkey %d in %s: '%s'", i, name, element)
+		}
+		pubkeys[i] = pubkey
+	}
+	return pubkeys, nil
+}
+
 // Validate a hex-encoded byte array
 func ValidateByteArray(name, value string) ([]byte, error) {
 	// Remove a 0x prefix if present
@@ -1,6 +1,7 @@
 package log
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/fatih/color"
@@ -41,3 +42,17 @@ func (l *ColorLogger) Printf(format string, v ...interface{}) {
 func (l *ColorLogger) Printlnf(format string, v ...interface{}) {
 	log.Println(l.sprintfFunc(format, v...))
 }
+
+// Get a copy of the logger that starts each line with the provided prefix
+func (l ColorLogger) WithPrefix(prefix string) ColorLogger {
+	sprintFunc := l.sprintFunc
+	return ColorLogger{
+		Color: l.Color,
+		sprintFunc: func(a ...interface{}) string {
+			return sprintFunc(prefix + fmt.Sprint(a...))
+		},
+		sprintfFunc: func(format string, a ...interface{}) string {
+			return sprintFunc(prefix + fmt.Sprintf(format, a...))
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+const (
+	// The most a compounding validator's effective balance can grow to, in gwei
+	MaxEffectiveBalanceElectra uint64 = 2048e9
+
+	// The balance the Beacon Chain consolidates per epoch, in gwei. It's what's left of the balance churn after the
+	// activation and exit churn, which is about 256 ETH per epoch at the current total stake, so it's used for the
+	// estimates instead of loading every validator.
+	EstimatedConsolidationChurn uint64 = 256e9
+)
+
+// A validator that can take part in a consolidation
+type ConsolidationCandidate struct {
+	Pubkey      types.ValidatorPubkey
+	Index       string
+	Balance     uint64
+	Compounding bool
+}
+
+// A consolidation request in a plan. When the source and the target are the same validator,
+// the request switches it to compounding withdrawal credentials.
+type ConsolidationStep struct {
+	Source ConsolidationCandidate
+	Target ConsolidationCandidate
+}
+
+// Check whether the step switches a validator to compounding credentials instead of consolidating it
+func (s ConsolidationStep) IsSwitchToCompounding() bool {
+	return s.Source.Pubkey == s.Target.Pubkey
+}
+
+// The requests needed to consolidate a set of validators, in the order they have to be submitted
+type ConsolidationPlan struct {
+	Steps []ConsolidationStep
+
+	// The total balance of the source validators, in gwei. It goes through the consolidation churn, so a bigger
+	// balance takes longer to process and pushes back consolidations submitted after it.
+	ChurnBalance uint64
+
+	// The number of validators that exit by being consolidated into another one
+	ExitingValidators int
+
+	// The balance of the consolidations already waiting in the Beacon Chain's queue, in gwei
+	PendingChurnBalance uint64
+
+	// The estimated number of epochs until the queue has processed the plan's consolidations
+	EstimatedQueueEpochs uint64
+}
+
+// Plan the consolidation of the provided validators into as few compounding validators as possible.
+// Validators that are already compounding are used as targets first, then the ones with the highest balance.
+// Each target is filled with the smallest remaining validators that keep it under the maximum effective balance, so as many
+// validators as possible are removed for the balance that has to go through the consolidation churn.
+// pendingChurnBalance is the balance of the consolidations already in the queue, which are processed first.
+func PlanConsolidations(candidates []ConsolidationCandidate, pendingChurnBalance uint64) ConsolidationPlan {
+	targets := slices.Clone(candidates)
+	slices.SortStableFunc(targets, func(a, b ConsolidationCandidate) int {
+		if a.Compounding != b.Compounding {
+			if a.Compounding {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(b.Balance, a.Balance)
+	})
+
+	plan := ConsolidationPlan{
+		PendingChurnBalance: pendingChurnBalance,
+	}
+	used := make([]bool, len(targets))
+	for t, target := range targets {
+		if used[t] {
+			continue
+		}
+		used[t] = true
+
+		// Fill the target with the remaining validators, smallest first
+		balance := target.Balance
+		sources := []ConsolidationCandidate{}
+		for s := len(targets) - 1; s > t; s-- {
+			source := targets[s]
+			if used[s] || source.Compounding || balance+source.Balance > MaxEffectiveBalanceElectra {
+				continue
+			}
+			used[s] = true
+			balance += source.Balance
+			sources = append(sources, source)
+		}
+		if len(sources) == 0 {
+			continue
+		}
+
+		// Targets have to be compounding before anything can be consolidated into them
+		if !target.Compounding {
+			plan.Steps = append(plan.Steps, ConsolidationStep{
+				Source: target,
+				Target: target,
+			})
+		}
+		for _, source := range sources {
+			plan.Steps = append(plan.Steps, ConsolidationStep{
+				Source: source,
+				Target: target,
+			})
+			plan.ChurnBalance += source.Balance
+			plan.ExitingValidators++
+		}
+	}
+	if plan.ExitingValidators > 0 {
+		queueBalance := plan.PendingChurnBalance + plan.ChurnBalance
+		plan.EstimatedQueueEpochs = (queueBalance + EstimatedConsolidationChurn - 1) / EstimatedConsolidationChurn
+	}
+	return plan
+}
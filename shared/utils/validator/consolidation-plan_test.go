@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+func newCandidate(id byte, balanceEth uint64, compounding bool) ConsolidationCandidate {
+	return ConsolidationCandidate{
+		Pubkey:      types.ValidatorPubkey{id},
+		Balance:     balanceEth * 1e9,
+		Compounding: compounding,
+	}
+}
+
+func TestPlanConsolidations(t *testing.T) {
+	candidates := []ConsolidationCandidate{
+		newCandidate(1, 32, false),
+		newCandidate(2, 33, false),
+		newCandidate(3, 31, false),
+	}
+	plan := PlanConsolidations(candidates, 0)
+
+	// The biggest validator is switched to compounding first, then the others are consolidated into it, smallest first
+	if len(plan.Steps) != 3 {
+		t.Fatalf("expected 3 steps, got %d", len(plan.Steps))
+	}
+	if !plan.Steps[0].IsSwitchToCompounding() || plan.Steps[0].Target.Pubkey[0] != 2 {
+		t.Fatalf("expected the first step to switch validator 2 to compounding, got %+v", plan.Steps[0])
+	}
+	if plan.Steps[1].Source.Pubkey[0] != 3 || plan.Steps[2].Source.Pubkey[0] != 1 {
+		t.Fatalf("expected validators 3 and 1 to be consolidated in that order, got %+v", plan.Steps[1:])
+	}
+	if plan.ChurnBalance != 63e9 {
+		t.Fatalf("expected a churn balance of 63 ETH, got %d gwei", plan.ChurnBalance)
+	}
+	if plan.ExitingValidators != 2 || plan.EstimatedQueueEpochs != 1 {
+		t.Fatalf("expected 2 validators to exit within 1 epoch, got %d within %d", plan.ExitingValidators, plan.EstimatedQueueEpochs)
+	}
+}
+
+func TestPlanConsolidationsQueueImpact(t *testing.T) {
+	candidates := []ConsolidationCandidate{
+		newCandidate(1, 32, false),
+		newCandidate(2, 32, false),
+	}
+
+	// The consolidations already in the queue are processed first
+	plan := PlanConsolidations(candidates, 2*EstimatedConsolidationChurn)
+	if plan.PendingChurnBalance != 2*EstimatedConsolidationChurn {
+		t.Fatalf("expected the pending churn balance to be reported, got %d gwei", plan.PendingChurnBalance)
+	}
+	if plan.EstimatedQueueEpochs != 3 {
+		t.Fatalf("expected the plan to take 3 epochs, got %d", plan.EstimatedQueueEpochs)
+	}
+
+	// Nothing goes through the churn without a consolidation
+	plan = PlanConsolidations(candidates[:1], 2*EstimatedConsolidationChurn)
+	if plan.ExitingValidators != 0 || plan.EstimatedQueueEpochs != 0 {
+		t.Fatalf("expected no queue impact, got %d validators exiting within %d epochs", plan.ExitingValidators, plan.EstimatedQueueEpochs)
+	}
+}
+
+func TestPlanConsolidationsPrefersCompoundingTargets(t *testing.T) {
+	candidates := []ConsolidationCandidate{
+		newCandidate(1, 40, false),
+		newCandidate(2, 34, true),
+		newCandidate(3, 32, false),
+	}
+	plan := PlanConsolidations(candidates, 0)
+
+	// The compounding validator doesn't need to be switched, so there are only consolidations
+	if len(plan.Steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(plan.Steps))
+	}
+	for _, step := range plan.Steps {
+		if step.IsSwitchToCompounding() || step.Target.Pubkey[0] != 2 {
+			t.Fatalf("expected every step to consolidate into validator 2, got %+v", step)
+		}
+	}
+}
+
+func TestPlanConsolidationsRespectsMaxEffectiveBalance(t *testing.T) {
+	candidates := []ConsolidationCandidate{newCandidate(0, 2000, true)}
+	for i := byte(1); i <= 3; i++ {
+		candidates = append(candidates, newCandidate(i, 32, false))
+	}
+	plan := PlanConsolidations(candidates, 0)
+
+	// Only one validator fits in the compounding one, so the other two are consolidated together
+	if len(plan.Steps) != 3 {
+		t.Fatalf("expected 3 steps, got %d", len(plan.Steps))
+	}
+	if plan.Steps[0].Target.Pubkey[0] != 0 {
+		t.Fatalf("expected the first consolidation to target validator 0, got %+v", plan.Steps[0])
+	}
+	if !plan.Steps[1].IsSwitchToCompounding() {
+		t.Fatalf("expected the second step to switch a validator to compounding, got %+v", plan.Steps[1])
+	}
+	if plan.Steps[2].Target.Pubkey != plan.Steps[1].Target.Pubkey {
+		t.Fatalf("expected the last validator to be consolidated into the one switched to compounding, got %+v", plan.Steps[2])
+	}
+}
+
+func TestPlanConsolidationsSingleValidator(t *testing.T) {
+	plan := PlanConsolidations([]ConsolidationCandidate{newCandidate(1, 32, false)}, 0)
+	if len(plan.Steps) != 0 {
+		t.Fatalf("expected no steps for a single validator, got %d", len(plan.Steps))
+	}
+}
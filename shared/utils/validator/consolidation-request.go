@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+const (
+	// How many epochs a validator needs to have been active for before it can be consolidated into another one
+	ShardCommitteePeriod uint64 = 256
+
+	// The epoch used by the Beacon Chain for events that haven't been scheduled yet
+	farFutureEpoch uint64 = 0xffffffffffffffff
+
+	// The prefix of withdrawal credentials that let a validator's effective balance grow above 32 ETH
+	CompoundingWithdrawalPrefix byte = 0x02
+
+	// Consolidation fees are paid with this multiplier so requests don't revert if the fee rises before they're included.
+	// The contract doesn't refund the excess, but outside of congestion the fee is 1 wei so the buffer costs nothing.
+	requestFeeBuffer int64 = 2
+)
+
+// The EIP-7251 system contract that queues consolidation requests triggered from the execution layer.
+// Consolidations have to be requested by the source validator's withdrawal address;
+// for megapool validators that's the megapool contract, which can't submit them yet.
+var ConsolidationRequestContractAddress = common.HexToAddress("0x0000BBdDc7CE488642fb579F8B00f3a590007251")
+
+// Get the fee currently charged by the system contract for a consolidation request
+func GetConsolidationRequestFee(ec rocketpool.ExecutionClient) (*big.Int, error) {
+	// Calling the contract without any data returns the fee
+	response, err := ec.CallContract(context.Background(), ethereum.CallMsg{
+		To:   &ConsolidationRequestContractAddress,
+		Data: []byte{},
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting consolidation request fee: %w", err)
+	}
+	if len(response) != common.HashLength {
+		return nil, fmt.Errorf("error getting consolidation request fee: unexpected response length %d", len(response))
+	}
+	return new(big.Int).SetBytes(response), nil
+}
+
+// Get the value to send with a consolidation request, which is the current fee with a buffer
+func getRequestValue(fee *big.Int) *big.Int {
+	return new(big.Int).Mul(fee, big.NewInt(requestFeeBuffer))
+}
+
+// Get the calldata for a consolidation request. Using the same validator as the source and target
+// switches it to compounding withdrawal credentials instead of consolidating it.
+func GetConsolidationRequestData(sourcePubkey types.ValidatorPubkey, targetPubkey types.ValidatorPubkey) []byte {
+	data := make([]byte, 0, types.ValidatorPubkeyLength*2)
+	data = append(data, sourcePubkey.Bytes()...)
+	return append(data, targetPubkey.Bytes()...)
+}
+
+// Check whether a validator has compounding withdrawal credentials
+func IsCompounding(withdrawalCredentials common.Hash) bool {
+	return withdrawalCredentials[0] == CompoundingWithdrawalPrefix
+}
+
+// Whether a consolidation can be requested from the execution layer, and why not if it can't
+type ConsolidationRequestCheck struct {
+	InvalidStatus        bool
+	AlreadyExiting       bool
+	NotActiveLongEnough  bool
+	AlreadyCompounding   bool
+	TargetNotCompounding bool
+	BlsCredentials       bool
+	ContractCredentials  bool
+	NotCredentialsOwner  bool
+	CredentialsOwner     common.Address
+	EligibleEpoch        uint64
+}
+
+// Check whether the sender can request the consolidation of the source validator into the target.
+// The request has to come from the source's withdrawal address; poolAddress is the Rocket Pool contract the validators
+// belong to, which can't forward consolidation requests, so validators with credentials pointing to it are flagged separately.
+func CheckConsolidationRequest(source beacon.ValidatorStatus, target beacon.ValidatorStatus, currentEpoch uint64, sender common.Address, poolAddress common.Address) ConsolidationRequestCheck {
+	check := ConsolidationRequestCheck{}
+	switchToCompounding := source.Pubkey == target.Pubkey
+	if (source.Exists && source.ExitEpoch != farFutureEpoch) || (target.Exists && target.ExitEpoch != farFutureEpoch) {
+		check.AlreadyExiting = true
+		return check
+	}
+	if source.Status != beacon.ValidatorState_ActiveOngoing || target.Status != beacon.ValidatorState_ActiveOngoing {
+		check.InvalidStatus = true
+		return check
+	}
+	if switchToCompounding {
+		check.AlreadyCompounding = IsCompounding(source.WithdrawalCredentials)
+	} else {
+		check.EligibleEpoch = source.ActivationEpoch + ShardCommitteePeriod
+		check.NotActiveLongEnough = currentEpoch < check.EligibleEpoch
+		check.TargetNotCompounding = !IsCompounding(target.WithdrawalCredentials)
+	}
+
+	// Only execution credentials (0x01 and 0x02) have an address that can send the request
+	switch source.WithdrawalCredentials[0] {
+	case 0x01, CompoundingWithdrawalPrefix:
+		check.CredentialsOwner = common.BytesToAddress(source.WithdrawalCredentials[12:])
+	default:
+		check.BlsCredentials = true
+		return check
+	}
+	if check.CredentialsOwner == poolAddress {
+		check.ContractCredentials = true
+	} else if check.CredentialsOwner != sender {
+		check.NotCredentialsOwner = true
+	}
+	return check
+}
+
+// Check whether nothing prevents the request
+func (c ConsolidationRequestCheck) CanRequest() bool {
+	return !(c.InvalidStatus || c.AlreadyExiting || c.NotActiveLongEnough || c.AlreadyCompounding || c.TargetNotCompounding ||
+		c.BlsCredentials || c.ContractCredentials || c.NotCredentialsOwner)
+}
+
+// Estimate the gas of a consolidation request
+func EstimateConsolidationRequestGas(ec rocketpool.ExecutionClient, sourcePubkey types.ValidatorPubkey, targetPubkey types.ValidatorPubkey, fee *big.Int, opts *bind.TransactOpts) (rocketpool.GasInfo, error) {
+	opts.Value = getRequestValue(fee)
+	return eth.EstimateSendTransactionGas(ec, ConsolidationRequestContractAddress, GetConsolidationRequestData(sourcePubkey, targetPubkey), true, opts)
+}
+
+// Submit a consolidation request to the system contract
+func SendConsolidationRequest(ec rocketpool.ExecutionClient, chainID *big.Int, sourcePubkey types.ValidatorPubkey, targetPubkey types.ValidatorPubkey, fee *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	opts.Value = getRequestValue(fee)
+	return eth.SendTransaction(ec, ConsolidationRequestContractAddress, chainID, GetConsolidationRequestData(sourcePubkey, targetPubkey), true, opts)
+}
@@ -2,6 +2,8 @@ package validator
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/web3signer"
 	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 
@@ -11,17 +13,55 @@ import (
 // Get deposit data & root for a given validator key and withdrawal credentials
 func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (generic.DepositData, common.Hash, error) {
 
+	// Get signing root
+	dd, srHash, err := getDepositSigningRoot(validatorKey.PublicKey().Marshal(), withdrawalCredentials, eth2Config, depositAmount)
+	if err != nil {
+		return generic.DepositData{}, common.Hash{}, err
+	}
+
+	// Sign deposit data
+	return buildDepositData(dd, validatorKey.Sign(srHash[:]).Marshal())
+
+}
+
+// Get deposit data & root for a given validator from the remote signer holding its key
+func GetRemoteSignedDepositData(signer *web3signer.Client, validatorPubkey types.ValidatorPubkey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (generic.DepositData, common.Hash, error) {
+
+	// Get signing root
+	dd, srHash, err := getDepositSigningRoot(validatorPubkey.Bytes(), withdrawalCredentials, eth2Config, depositAmount)
+	if err != nil {
+		return generic.DepositData{}, common.Hash{}, err
+	}
+
+	// Sign deposit data; deposits always use the genesis fork version, so the signer doesn't need any fork info
+	deposit := web3signer.Deposit{
+		Pubkey:                dd.PublicKey,
+		WithdrawalCredentials: dd.WithdrawalCredentials,
+		Amount:                dd.Amount,
+		GenesisForkVersion:    eth2Config.GenesisForkVersion,
+	}
+	signature, err := signer.SignDeposit(validatorPubkey, srHash[:], deposit)
+	if err != nil {
+		return generic.DepositData{}, common.Hash{}, err
+	}
+	return buildDepositData(dd, signature.Bytes())
+
+}
+
+// Get the unsigned deposit data for a validator and its signing root
+func getDepositSigningRoot(pubkey []byte, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (generic.DepositDataNoSignature, [32]byte, error) {
+
 	// Build deposit data
 	dd := generic.DepositDataNoSignature{
-		PublicKey:             validatorKey.PublicKey().Marshal(),
+		PublicKey:             pubkey,
 		WithdrawalCredentials: withdrawalCredentials[:],
 		Amount:                depositAmount,
 	}
 
-	// Get signing root
+	// Get object root
 	or, err := dd.HashTreeRoot()
 	if err != nil {
-		return generic.DepositData{}, common.Hash{}, err
+		return generic.DepositDataNoSignature{}, [32]byte{}, err
 	}
 
 	sr := generic.SigningRoot{
@@ -32,15 +72,21 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 	// Get signing root with domain
 	srHash, err := sr.HashTreeRoot()
 	if err != nil {
-		return generic.DepositData{}, common.Hash{}, err
+		return generic.DepositDataNoSignature{}, [32]byte{}, err
 	}
+	return dd, srHash, nil
+
+}
+
+// Add a signature to deposit data and get its root
+func buildDepositData(dd generic.DepositDataNoSignature, signature []byte) (generic.DepositData, common.Hash, error) {
 
 	// Build deposit data struct (with signature)
 	var depositData = generic.DepositData{
 		PublicKey:             dd.PublicKey,
 		WithdrawalCredentials: dd.WithdrawalCredentials,
 		Amount:                dd.Amount,
-		Signature:             validatorKey.Sign(srHash[:]).Marshal(),
+		Signature:             signature,
 	}
 
 	// Get deposit data root
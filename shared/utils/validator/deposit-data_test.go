@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/web3signer"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+func TestGetRemoteSignedDepositData(t *testing.T) {
+	if err := InitializeBLS(); err != nil {
+		t.Fatal(err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+	withdrawalCredentials := common.HexToHash("0x010000000000000000000000c0ffee0000000000000000000000000000000000")
+	eth2Config := beacon.Eth2Config{GenesisForkVersion: []byte{0x10, 0x00, 0x09, 0x10}}
+
+	// Sign the requests with the local key, like the signer would with its copy
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Type        string              `json:"type"`
+			SigningRoot hexutil.Bytes       `json:"signingRoot"`
+			Deposit     *web3signer.Deposit `json:"deposit"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path != "/api/v1/eth2/sign/"+hexutil.Encode(pubkey.Bytes()) || request.Type != web3signer.SigningTypeDeposit || request.Deposit == nil ||
+			!bytes.Equal(request.Deposit.WithdrawalCredentials, withdrawalCredentials[:]) || request.Deposit.Amount != 32e9 {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]hexutil.Bytes{
+			"signature": key.Sign(request.SigningRoot).Marshal(),
+		})
+	}))
+	defer server.Close()
+
+	expected, expectedRoot, err := GetDepositData(key, withdrawalCredentials, eth2Config, 32e9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote, remoteRoot, err := GetRemoteSignedDepositData(web3signer.NewClient(server.URL), pubkey, withdrawalCredentials, eth2Config, 32e9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected.Signature, remote.Signature) || expectedRoot != remoteRoot {
+		t.Fatalf("remote deposit data (root %s) doesn't match the locally signed deposit data (root %s)", remoteRoot.Hex(), expectedRoot.Hex())
+	}
+}
@@ -6,14 +6,18 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/common"
+	rptypes "github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
@@ -105,6 +109,34 @@ func RestartValidator(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.C
 
 }
 
+// Make sure the node's validators that the running VC has loaded use the provided fee recipient, setting it through the keymanager API where they don't.
+// Only the loaded validators in pubkeys are changed, so validators that don't belong to the node, such as solo validators or the ones of
+// another node sharing the VC, keep their own fee recipients. Returns the number of validators that were changed.
+func SetFeeRecipientWithKeymanager(km *keymanager.Client, feeRecipient common.Address, pubkeys []rptypes.ValidatorPubkey) (int, error) {
+	loadedPubkeys, err := km.GetLoadedValidators()
+	if err != nil {
+		return 0, err
+	}
+	loadedPubkeys = slices.DeleteFunc(loadedPubkeys, func(pubkey rptypes.ValidatorPubkey) bool {
+		return !slices.Contains(pubkeys, pubkey)
+	})
+	updated := 0
+	for _, pubkey := range loadedPubkeys {
+		currentFeeRecipient, err := km.GetFeeRecipient(pubkey)
+		if err != nil {
+			return updated, err
+		}
+		if currentFeeRecipient == feeRecipient {
+			continue
+		}
+		if err := km.SetFeeRecipient(pubkey, feeRecipient); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+	return updated, nil
+}
+
 // Stops the validator process
 func StopValidator(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.ColorLogger, d *client.Client) error {
 
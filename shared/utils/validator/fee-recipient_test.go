@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rptypes "github.com/rocket-pool/smartnode/bindings/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+)
+
+func TestSetFeeRecipientWithKeymanagerOnlyChangesNodeValidators(t *testing.T) {
+	nodePubkey := rptypes.BytesToValidatorPubkey([]byte{0x01})
+	soloPubkey := rptypes.BytesToValidatorPubkey([]byte{0x02})
+
+	// A VC with a Rocket Pool validator and a solo validator, both using another fee recipient
+	changed := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/eth/v1/keystores":
+			fmt.Fprintf(w, `{"data":[{"validating_pubkey":"%s"},{"validating_pubkey":"%s"}]}`, hexutil.Encode(nodePubkey.Bytes()), hexutil.Encode(soloPubkey.Bytes()))
+		case r.URL.Path == "/eth/v1/remotekeys":
+			fmt.Fprint(w, `{"data":[]}`)
+		case strings.HasSuffix(r.URL.Path, "/feerecipient") && r.Method == http.MethodGet:
+			fmt.Fprint(w, `{"data":{"ethaddress":"0x0000000000000000000000000000000000000001"}}`)
+		case strings.HasSuffix(r.URL.Path, "/feerecipient") && r.Method == http.MethodPost:
+			changed = append(changed, r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	km := keymanager.NewClient(server.URL, "")
+	updated, err := SetFeeRecipientWithKeymanager(km, common.HexToAddress("0x2"), []rptypes.ValidatorPubkey{nodePubkey})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != 1 || len(changed) != 1 || !strings.Contains(changed[0], hexutil.Encode(nodePubkey.Bytes())) {
+		t.Fatalf("expected only the node's validator to be changed, got %v", changed)
+	}
+
+	// Without any node validators nothing is changed
+	changed = changed[:0]
+	updated, err = SetFeeRecipientWithKeymanager(km, common.HexToAddress("0x2"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != 0 || len(changed) != 0 {
+		t.Fatalf("expected nothing to be changed, got %v", changed)
+	}
+}
@@ -31,13 +31,9 @@ const (
 	bucketLimit uint = 2000
 )
 
-func RecoverNodeKeys(c *cli.Context, rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress common.Address, w wallet.Wallet, testOnly bool) ([]types.ValidatorPubkey, error) {
-	cfg, err := services.GetConfig(c)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get node's validating pubkeys
+// Get the pubkeys of all of the node's minipool and megapool validators
+func GetNodeValidatorPubkeys(rp *rocketpool.RocketPool, nodeAddress common.Address) ([]types.ValidatorPubkey, error) {
+	// Get the minipool pubkeys
 	pubkeys, err := minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAddress, nil)
 	if err != nil {
 		return nil, err
@@ -86,7 +82,20 @@ func RecoverNodeKeys(c *cli.Context, rp *rocketpool.RocketPool, bc beacon.Client
 			filteredPubkeys = append(filteredPubkeys, pubkey)
 		}
 	}
-	pubkeys = filteredPubkeys
+	return filteredPubkeys, nil
+}
+
+func RecoverNodeKeys(c *cli.Context, rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress common.Address, w wallet.Wallet, testOnly bool) ([]types.ValidatorPubkey, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get node's validating pubkeys
+	pubkeys, err := GetNodeValidatorPubkeys(rp, nodeAddress)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get validator statuses by pubkeys
 	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
@@ -95,7 +104,7 @@ func RecoverNodeKeys(c *cli.Context, rp *rocketpool.RocketPool, bc beacon.Client
 	}
 
 	// Filter out inactive validators
-	filteredPubkeys = []types.ValidatorPubkey{}
+	filteredPubkeys := []types.ValidatorPubkey{}
 	for _, pubkey := range pubkeys {
 		if statuses[pubkey].Status == beacon.ValidatorState_ActiveOngoing ||
 			statuses[pubkey].Status == beacon.ValidatorState_ActiveExiting ||
@@ -6,4 +6,5 @@ SSZGEN_CMD="go run github.com/ferranbt/fastssz/sszgen@v0.1.4"
 find ./shared/types/eth2 -name "*_encoding.go" -exec sh -c 'head -1 {} | grep -q "Code generated by fastssz"' \; -exec rm {} \;
 $SSZGEN_CMD --path ./shared/types/eth2/fork/deneb --include ./shared/types/eth2/generic
 $SSZGEN_CMD --path ./shared/types/eth2/fork/electra --include ./shared/types/eth2/generic
+$SSZGEN_CMD --path ./shared/types/eth2/fork/fulu --include ./shared/types/eth2/generic
 $SSZGEN_CMD --path ./shared/types/eth2/generic --exclude-objs Uint256
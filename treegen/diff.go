@@ -0,0 +1,486 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/rewards/ssz_types"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	// The number of missed slots to print for each side of a minipool difference
+	maxMissedSlotsToPrint int = 10
+)
+
+// A rewards file and its optional minipool performance file
+type diffSide struct {
+	name        string
+	rewards     rprewards.IRewardsFile
+	performance rprewards.IMinipoolPerformanceFile
+}
+
+// Compares two rewards files (and their minipool performance files, if provided) and explains how they differ
+func DiffTrees(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("diff needs two rewards files, e.g. treegen diff <file A> <file B>")
+	}
+
+	// Load the files
+	a, err := loadDiffSide("A", c.Args().Get(0), c.String("performance-a"))
+	if err != nil {
+		return err
+	}
+	b, err := loadDiffSide("B", c.Args().Get(1), c.String("performance-b"))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("A: %s (version %d)\n", c.Args().Get(0), a.rewards.GetRewardsFileVersion())
+	fmt.Printf("B: %s (version %d)\n", c.Args().Get(1), b.rewards.GetRewardsFileVersion())
+	fmt.Println()
+
+	// Compare the interval info
+	differences := diffHeaders(a, b)
+
+	// Compare the totals and each network
+	differences += diffTotals(a, b)
+	differences += diffNetworks(a, b)
+
+	// Compare each node
+	differences += diffNodes(a, b)
+
+	// Drill down into the minipools and megapool validators
+	if a.performance != nil && b.performance != nil {
+		differences += diffMinipools(a, b)
+		validatorDifferences, err := diffMegapoolValidators(a, b)
+		if err != nil {
+			return err
+		}
+		differences += validatorDifferences
+	} else if a.performance != nil || b.performance != nil {
+		fmt.Println("NOTE: minipool performance was only provided for one of the files, so it won't be compared.")
+		fmt.Println()
+	}
+
+	if differences == 0 {
+		fmt.Println("The files are identical.")
+	} else {
+		fmt.Printf("%sFound %d differences.%s\n", colorRed, differences, colorReset)
+	}
+	return nil
+}
+
+// Loads a rewards file and its minipool performance file
+func loadDiffSide(name string, rewardsPath string, performancePath string) (*diffSide, error) {
+	side := &diffSide{
+		name: name,
+	}
+
+	var err error
+	side.rewards, err = readAnyRewardsFile(rewardsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if performancePath != "" {
+		performanceFile, err := rprewards.ReadLocalMinipoolPerformanceFile(performancePath)
+		if err != nil {
+			return nil, err
+		}
+		side.performance = performanceFile.Impl()
+	}
+	return side, nil
+}
+
+// Reads a rewards file of any version, including the SSZ-encoded ones
+func readAnyRewardsFile(path string) (rprewards.IRewardsFile, error) {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading rewards file from %s: %w", path, err)
+	}
+
+	if bytes.HasPrefix(fileBytes, ssz_types.Magic[:]) {
+		file, err := ssz_types.ParseSSZFile(fileBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing SSZ rewards file from %s: %w", path, err)
+		}
+		return file, nil
+	}
+
+	file, err := rprewards.DeserializeRewardsFile(fileBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling rewards file from %s: %w", path, err)
+	}
+	return file, nil
+}
+
+// Compares the interval info of both files, returning the number of differences
+func diffHeaders(a *diffSide, b *diffSide) int {
+	differences := 0
+	compare := func(name string, valueA any, valueB any) {
+		if fmt.Sprint(valueA) != fmt.Sprint(valueB) {
+			differences++
+			fmt.Printf("%-24s A: %v, B: %v\n", name, valueA, valueB)
+		}
+	}
+
+	fmt.Println("=== Interval ===")
+	compare("Merkle root", a.rewards.GetMerkleRoot(), b.rewards.GetMerkleRoot())
+	compare("Index", a.rewards.GetIndex(), b.rewards.GetIndex())
+	compare("Intervals passed", a.rewards.GetIntervalsPassed(), b.rewards.GetIntervalsPassed())
+	compare("Start time", a.rewards.GetStartTime(), b.rewards.GetStartTime())
+	compare("End time", a.rewards.GetEndTime(), b.rewards.GetEndTime())
+	compare("Consensus start block", a.rewards.GetConsensusStartBlock(), b.rewards.GetConsensusStartBlock())
+	compare("Consensus end block", a.rewards.GetConsensusEndBlock(), b.rewards.GetConsensusEndBlock())
+	compare("Execution start block", a.rewards.GetExecutionStartBlock(), b.rewards.GetExecutionStartBlock())
+	compare("Execution end block", a.rewards.GetExecutionEndBlock(), b.rewards.GetExecutionEndBlock())
+	if differences == 0 {
+		fmt.Println("No differences.")
+	}
+	fmt.Println()
+	return differences
+}
+
+// Compares the total rewards of both files, returning the number of differences
+func diffTotals(a *diffSide, b *diffSide) int {
+	differences := 0
+	fmt.Println("=== Totals ===")
+	differences += printAmountDiff("Collateral RPL", "RPL", a.rewards.GetTotalCollateralRpl(), b.rewards.GetTotalCollateralRpl())
+	differences += printAmountDiff("Oracle DAO RPL", "RPL", a.rewards.GetTotalOracleDaoRpl(), b.rewards.GetTotalOracleDaoRpl())
+	differences += printAmountDiff("Protocol DAO RPL", "RPL", a.rewards.GetTotalProtocolDaoRpl(), b.rewards.GetTotalProtocolDaoRpl())
+	differences += printAmountDiff("Node operator SP ETH", "ETH", a.rewards.GetTotalNodeOperatorSmoothingPoolEth(), b.rewards.GetTotalNodeOperatorSmoothingPoolEth())
+	differences += printAmountDiff("Pool staker SP ETH", "ETH", a.rewards.GetTotalPoolStakerSmoothingPoolEth(), b.rewards.GetTotalPoolStakerSmoothingPoolEth())
+	differences += printAmountDiff("Total node weight", "", a.rewards.GetTotalNodeWeight(), b.rewards.GetTotalNodeWeight())
+	if differences == 0 {
+		fmt.Println("No differences.")
+	}
+	fmt.Println()
+	return differences
+}
+
+// Compares the rewards for each network in both files, returning the number of differences
+func diffNetworks(a *diffSide, b *diffSide) int {
+	differences := 0
+	fmt.Println("=== Networks ===")
+	for _, network := range mergeSorted(getRewardsNetworks(a.rewards), getRewardsNetworks(b.rewards)) {
+		if !a.rewards.HasRewardsForNetwork(network) || !b.rewards.HasRewardsForNetwork(network) {
+			differences++
+			fmt.Printf("Network %d: A has rewards: %t, B has rewards: %t\n", network, a.rewards.HasRewardsForNetwork(network), b.rewards.HasRewardsForNetwork(network))
+			continue
+		}
+		networkDifferences := 0
+		networkDifferences += printAmountDiff(fmt.Sprintf("Network %d collateral RPL", network), "RPL", a.rewards.GetNetworkCollateralRpl(network), b.rewards.GetNetworkCollateralRpl(network))
+		networkDifferences += printAmountDiff(fmt.Sprintf("Network %d oracle DAO RPL", network), "RPL", a.rewards.GetNetworkOracleDaoRpl(network), b.rewards.GetNetworkOracleDaoRpl(network))
+		networkDifferences += printAmountDiff(fmt.Sprintf("Network %d SP ETH", network), "ETH", a.rewards.GetNetworkSmoothingPoolEth(network), b.rewards.GetNetworkSmoothingPoolEth(network))
+		differences += networkDifferences
+	}
+	if differences == 0 {
+		fmt.Println("No differences.")
+	}
+	fmt.Println()
+	return differences
+}
+
+// Compares the rewards for each node in both files, returning the number of differences
+func diffNodes(a *diffSide, b *diffSide) int {
+	differences := 0
+	fmt.Println("=== Nodes ===")
+	for _, address := range mergeAddresses(a.rewards.GetNodeAddresses(), b.rewards.GetNodeAddresses()) {
+		hasA := a.rewards.HasRewardsFor(address)
+		hasB := b.rewards.HasRewardsFor(address)
+		if !hasA || !hasB {
+			differences++
+			fmt.Printf("Node %s is only in %s\n", address.Hex(), onlyIn(hasA))
+			continue
+		}
+
+		collateralA, collateralB := a.rewards.GetNodeCollateralRpl(address), b.rewards.GetNodeCollateralRpl(address)
+		oDaoA, oDaoB := a.rewards.GetNodeOracleDaoRpl(address), b.rewards.GetNodeOracleDaoRpl(address)
+		ethA, ethB := a.rewards.GetNodeSmoothingPoolEth(address), b.rewards.GetNodeSmoothingPoolEth(address)
+		if collateralA.Cmp(collateralB) == 0 && oDaoA.Cmp(oDaoB) == 0 && ethA.Cmp(ethB) == 0 {
+			continue
+		}
+
+		differences++
+		fmt.Printf("Node %s:\n", address.Hex())
+		printAmountDiff("    Collateral RPL", "RPL", collateralA, collateralB)
+		printAmountDiff("    Oracle DAO RPL", "RPL", oDaoA, oDaoB)
+		printAmountDiff("    SP ETH", "ETH", ethA, ethB)
+	}
+	if differences == 0 {
+		fmt.Println("No differences.")
+	}
+	fmt.Println()
+	return differences
+}
+
+// Compares the performance of each minipool in both files, returning the number of differences.
+// The performance files don't record which node each minipool belongs to, so differences are listed by minipool.
+func diffMinipools(a *diffSide, b *diffSide) int {
+	differences := 0
+	totalEthDiff := big.NewInt(0)
+	fmt.Println("=== Minipools ===")
+	for _, address := range mergeAddresses(a.performance.GetMinipoolAddresses(), b.performance.GetMinipoolAddresses()) {
+		perfA, hasA := a.performance.GetSmoothingPoolPerformance(address)
+		perfB, hasB := b.performance.GetSmoothingPoolPerformance(address)
+		if !hasA || !hasB {
+			differences++
+			fmt.Printf("Minipool %s is only in %s\n", address.Hex(), onlyIn(hasA))
+			var earned *big.Int
+			if hasA {
+				earned = new(big.Int).Neg(getTotalEthEarned(perfA))
+			} else {
+				earned = getTotalEthEarned(perfB)
+			}
+			totalEthDiff.Add(totalEthDiff, earned)
+			continue
+		}
+
+		if !minipoolPerformanceDiffers(perfA, perfB) {
+			continue
+		}
+
+		differences++
+		pubkey, _ := perfA.GetPubkey()
+		fmt.Printf("Minipool %s (%s):\n", address.Hex(), pubkey.Hex())
+		printPerformanceDiff(perfA, perfB)
+		totalEthDiff.Add(totalEthDiff, new(big.Int).Sub(getTotalEthEarned(perfB), getTotalEthEarned(perfA)))
+	}
+	if differences == 0 {
+		fmt.Println("No differences.")
+	} else {
+		fmt.Printf("The minipool differences change the ETH earned by node operators by %+.6f ETH (%s wei).\n", eth.WeiToEth(totalEthDiff), totalEthDiff.String())
+	}
+	fmt.Println()
+	return differences
+}
+
+// Compares the performance of each megapool validator in both files, returning the number of differences.
+// Files from before megapools don't have any, so nothing is printed for them.
+func diffMegapoolValidators(a *diffSide, b *diffSide) (int, error) {
+	pubkeysA, err := a.performance.GetMegapoolValidatorPubkeys()
+	if err != nil {
+		return 0, fmt.Errorf("error getting megapool validators from performance file %s: %w", a.name, err)
+	}
+	pubkeysB, err := b.performance.GetMegapoolValidatorPubkeys()
+	if err != nil {
+		return 0, fmt.Errorf("error getting megapool validators from performance file %s: %w", b.name, err)
+	}
+	pubkeys := mergePubkeys(pubkeysA, pubkeysB)
+	if len(pubkeys) == 0 {
+		return 0, nil
+	}
+
+	differences := 0
+	totalEthDiff := big.NewInt(0)
+	fmt.Println("=== Megapool Validators ===")
+	for _, pubkey := range pubkeys {
+		perfA, hasA := a.performance.GetMegapoolValidatorPerformance(pubkey)
+		perfB, hasB := b.performance.GetMegapoolValidatorPerformance(pubkey)
+		if !hasA || !hasB {
+			differences++
+			var earned *big.Int
+			if hasA {
+				fmt.Printf("Megapool validator %s (megapool %s, ID %d) is only in A\n", pubkey.Hex(), perfA.GetMegapoolAddress().Hex(), perfA.GetValidatorId())
+				earned = new(big.Int).Neg(getTotalEthEarned(perfA))
+			} else {
+				fmt.Printf("Megapool validator %s (megapool %s, ID %d) is only in B\n", pubkey.Hex(), perfB.GetMegapoolAddress().Hex(), perfB.GetValidatorId())
+				earned = getTotalEthEarned(perfB)
+			}
+			totalEthDiff.Add(totalEthDiff, earned)
+			continue
+		}
+
+		if !minipoolPerformanceDiffers(perfA, perfB) {
+			continue
+		}
+
+		differences++
+		fmt.Printf("Megapool validator %s (megapool %s, ID %d):\n", pubkey.Hex(), perfA.GetMegapoolAddress().Hex(), perfA.GetValidatorId())
+		printPerformanceDiff(perfA, perfB)
+		totalEthDiff.Add(totalEthDiff, new(big.Int).Sub(getTotalEthEarned(perfB), getTotalEthEarned(perfA)))
+	}
+	if differences == 0 {
+		fmt.Println("No differences.")
+	} else {
+		fmt.Printf("The megapool validator differences change the ETH earned by node operators by %+.6f ETH (%s wei).\n", eth.WeiToEth(totalEthDiff), totalEthDiff.String())
+	}
+	fmt.Println()
+	return differences, nil
+}
+
+// Prints the performance details of a minipool or megapool validator that differ between two files
+func printPerformanceDiff(a rprewards.ISmoothingPoolMinipoolPerformance, b rprewards.ISmoothingPoolMinipoolPerformance) {
+	printCountDiff("    Successful attestations", a.GetSuccessfulAttestationCount(), b.GetSuccessfulAttestationCount())
+	printCountDiff("    Missed attestations", a.GetMissedAttestationCount(), b.GetMissedAttestationCount())
+	printAmountDiff("    Attestation score", "", a.GetAttestationScore(), b.GetAttestationScore())
+	printMissedSlotsDiff(a.GetMissingAttestationSlots(), b.GetMissingAttestationSlots())
+	printAmountDiff("    Consensus income", "ETH", a.GetConsensusIncome(), b.GetConsensusIncome())
+	printAmountDiff("    Effective commission", "", a.GetEffectiveCommission(), b.GetEffectiveCommission())
+	printAmountDiff("    ETH earned", "ETH", a.GetEthEarned(), b.GetEthEarned())
+	printAmountDiff("    Bonus ETH earned", "ETH", a.GetBonusEthEarned(), b.GetBonusEthEarned())
+}
+
+// Check if any of a minipool's performance details differ between two files
+func minipoolPerformanceDiffers(a rprewards.ISmoothingPoolMinipoolPerformance, b rprewards.ISmoothingPoolMinipoolPerformance) bool {
+	return a.GetSuccessfulAttestationCount() != b.GetSuccessfulAttestationCount() ||
+		a.GetMissedAttestationCount() != b.GetMissedAttestationCount() ||
+		a.GetAttestationScore().Cmp(b.GetAttestationScore()) != 0 ||
+		a.GetConsensusIncome().Cmp(b.GetConsensusIncome()) != 0 ||
+		a.GetEffectiveCommission().Cmp(b.GetEffectiveCommission()) != 0 ||
+		a.GetEthEarned().Cmp(b.GetEthEarned()) != 0 ||
+		a.GetBonusEthEarned().Cmp(b.GetBonusEthEarned()) != 0 ||
+		!slices.Equal(a.GetMissingAttestationSlots(), b.GetMissingAttestationSlots())
+}
+
+// Get the ETH a minipool or megapool validator earned from the Smoothing Pool, including its bonus
+func getTotalEthEarned(perf rprewards.ISmoothingPoolMinipoolPerformance) *big.Int {
+	return new(big.Int).Add(perf.GetEthEarned(), perf.GetBonusEthEarned())
+}
+
+// Prints an amount that differs between the files, returning 1 if it differs and 0 if it doesn't.
+// Amounts with a unit are wei and are printed in whole units along with the exact difference.
+func printAmountDiff(name string, unit string, a *big.Int, b *big.Int) int {
+	if a == nil {
+		a = big.NewInt(0)
+	}
+	if b == nil {
+		b = big.NewInt(0)
+	}
+	if a.Cmp(b) == 0 {
+		return 0
+	}
+
+	diff := new(big.Int).Sub(b, a)
+	if unit == "" {
+		fmt.Printf("%-32s A: %s, B: %s (%s%s)\n", name, a.String(), b.String(), signOf(diff), diff.String())
+		return 1
+	}
+	fmt.Printf("%-32s A: %.6f %s, B: %.6f %s (%+.6f %s, %s%s wei)\n", name, eth.WeiToEth(a), unit, eth.WeiToEth(b), unit, eth.WeiToEth(diff), unit, signOf(diff), diff.String())
+	return 1
+}
+
+// Prints a count that differs between the files
+func printCountDiff(name string, a uint64, b uint64) {
+	if a == b {
+		return
+	}
+	fmt.Printf("%-32s A: %d, B: %d (%+d)\n", name, a, b, int64(b)-int64(a))
+}
+
+// Prints the slots that were only missed in one of the files
+func printMissedSlotsDiff(a []uint64, b []uint64) {
+	onlyA := subtractSlots(a, b)
+	onlyB := subtractSlots(b, a)
+	if len(onlyA) > 0 {
+		fmt.Printf("%-32s %s\n", "    Slots only missed in A", formatSlots(onlyA))
+	}
+	if len(onlyB) > 0 {
+		fmt.Printf("%-32s %s\n", "    Slots only missed in B", formatSlots(onlyB))
+	}
+}
+
+// Get the slots in a that aren't in b, sorted
+func subtractSlots(a []uint64, b []uint64) []uint64 {
+	inB := make(map[uint64]bool, len(b))
+	for _, slot := range b {
+		inB[slot] = true
+	}
+	result := []uint64{}
+	for _, slot := range a {
+		if !inB[slot] {
+			result = append(result, slot)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// Formats a list of slots, truncating long ones
+func formatSlots(slots []uint64) string {
+	if len(slots) <= maxMissedSlotsToPrint {
+		return fmt.Sprint(slots)
+	}
+	return fmt.Sprintf("%v and %d more", slots[:maxMissedSlotsToPrint], len(slots)-maxMissedSlotsToPrint)
+}
+
+// Get the networks a rewards file has rewards for
+func getRewardsNetworks(file rprewards.IRewardsFile) []uint64 {
+	networks := []uint64{}
+	switch file := file.(type) {
+	case *rprewards.RewardsFile_v1:
+		for network := range file.NetworkRewards {
+			networks = append(networks, network)
+		}
+	case *rprewards.RewardsFile_v2:
+		for network := range file.NetworkRewards {
+			networks = append(networks, network)
+		}
+	case *rprewards.RewardsFile_v3:
+		for network := range file.NetworkRewards {
+			networks = append(networks, network)
+		}
+	case *ssz_types.SSZFile_v1:
+		for _, networkRewards := range file.NetworkRewards {
+			networks = append(networks, networkRewards.Network)
+		}
+	}
+	return networks
+}
+
+// Merges two lists of networks into a sorted list without duplicates
+func mergeSorted(a []uint64, b []uint64) []uint64 {
+	merged := append(slices.Clone(a), b...)
+	slices.Sort(merged)
+	return slices.Compact(merged)
+}
+
+// Merges two lists of addresses into a sorted list without duplicates
+func mergeAddresses(a []common.Address, b []common.Address) []common.Address {
+	seen := map[common.Address]bool{}
+	merged := []common.Address{}
+	for _, address := range append(slices.Clone(a), b...) {
+		if !seen[address] {
+			seen[address] = true
+			merged = append(merged, address)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return bytes.Compare(merged[i][:], merged[j][:]) < 0
+	})
+	return merged
+}
+
+// Merges two lists of pubkeys into a sorted list without duplicates
+func mergePubkeys(a []types.ValidatorPubkey, b []types.ValidatorPubkey) []types.ValidatorPubkey {
+	merged := append(slices.Clone(a), b...)
+	slices.SortFunc(merged, func(x, y types.ValidatorPubkey) int {
+		return bytes.Compare(x[:], y[:])
+	})
+	return slices.Compact(merged)
+}
+
+// Get the name of the file something was in, if it was only in one of them
+func onlyIn(inA bool) string {
+	if inA {
+		return "A"
+	}
+	return "B"
+}
+
+// Get the sign to print before a difference
+func signOf(value *big.Int) string {
+	if value.Sign() > 0 {
+		return "+"
+	}
+	return ""
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"io"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+)
+
+// Capture what a function prints to stdout
+func captureOutput(t *testing.T, print func()) string {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("error creating pipe: %s", err.Error())
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	print()
+	writer.Close()
+	output, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("error reading output: %s", err.Error())
+	}
+	return string(output)
+}
+
+func TestSubtractSlots(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []uint64
+		b        []uint64
+		expected []uint64
+	}{
+		{"empty", nil, nil, []uint64{}},
+		{"nothing to subtract", []uint64{3, 1, 2}, nil, []uint64{1, 2, 3}},
+		{"everything subtracted", []uint64{1, 2}, []uint64{2, 1, 5}, []uint64{}},
+		{"some subtracted", []uint64{9, 4, 7, 1}, []uint64{7, 8}, []uint64{1, 4, 9}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := subtractSlots(test.a, test.b)
+			if !slices.Equal(result, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestMergeAddresses(t *testing.T) {
+	first := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	second := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	third := common.HexToAddress("0xff00000000000000000000000000000000000000")
+
+	a := []common.Address{third, first}
+	merged := mergeAddresses(a, []common.Address{second, first, third})
+	expected := []common.Address{first, second, third}
+	if !slices.Equal(merged, expected) {
+		t.Fatalf("expected %v, got %v", expected, merged)
+	}
+	if a[0] != third || a[1] != first {
+		t.Fatalf("expected the inputs to be left alone, got %v", a)
+	}
+	if merged := mergeAddresses(nil, nil); len(merged) != 0 {
+		t.Fatalf("expected no addresses, got %v", merged)
+	}
+}
+
+func TestPrintAmountDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		unit       string
+		a          *big.Int
+		b          *big.Int
+		difference int
+		output     string
+	}{
+		{"equal", "ETH", eth.EthToWei(1), eth.EthToWei(1), 0, ""},
+		{"both missing", "ETH", nil, nil, 0, ""},
+		{"missing counts as zero", "", nil, big.NewInt(0), 0, ""},
+		{"increase in wei", "ETH", eth.EthToWei(1), eth.EthToWei(1.5), 1, "A: 1.000000 ETH, B: 1.500000 ETH (+0.500000 ETH, +500000000000000000 wei)"},
+		{"decrease in wei", "RPL", eth.EthToWei(2), nil, 1, "A: 2.000000 RPL, B: 0.000000 RPL (-2.000000 RPL, -2000000000000000000 wei)"},
+		{"unitless", "", big.NewInt(10), big.NewInt(7), 1, "A: 10, B: 7 (-3)"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var difference int
+			output := captureOutput(t, func() {
+				difference = printAmountDiff("Amount", test.unit, test.a, test.b)
+			})
+			if difference != test.difference {
+				t.Fatalf("expected %d differences, got %d", test.difference, difference)
+			}
+			if test.output == "" {
+				if output != "" {
+					t.Fatalf("expected nothing to be printed, got %q", output)
+				}
+				return
+			}
+			if !strings.HasPrefix(output, "Amount") || !strings.Contains(output, test.output) {
+				t.Fatalf("expected the output to contain %q, got %q", test.output, output)
+			}
+		})
+	}
+}
+
+// Create a performance file side with the provided megapool validators
+func newMegapoolDiffSide(name string, validators map[string]*rprewards.SmoothingPoolMegapoolValidatorPerformance_v2) *diffSide {
+	return &diffSide{
+		name: name,
+		performance: &rprewards.MinipoolPerformanceFile_v2{
+			MinipoolPerformance:          map[common.Address]*rprewards.SmoothingPoolMinipoolPerformance_v2{},
+			MegapoolValidatorPerformance: validators,
+		},
+	}
+}
+
+func newMegapoolValidatorPerformance(pubkey types.ValidatorPubkey, id uint32, score int64, missedSlots []uint64, ethEarned float64) *rprewards.SmoothingPoolMegapoolValidatorPerformance_v2 {
+	return &rprewards.SmoothingPoolMegapoolValidatorPerformance_v2{
+		SmoothingPoolMinipoolPerformance_v2: rprewards.SmoothingPoolMinipoolPerformance_v2{
+			Pubkey:                  pubkey.Hex(),
+			AttestationScore:        rprewards.NewQuotedBigInt(score),
+			MissingAttestationSlots: missedSlots,
+			EthEarned:               rprewards.QuotedBigIntFromBigInt(eth.EthToWei(ethEarned)),
+			BonusEthEarned:          rprewards.NewQuotedBigInt(0),
+		},
+		MegapoolAddress: common.HexToAddress("0x1234"),
+		ValidatorId:     id,
+	}
+}
+
+func TestDiffMegapoolValidators(t *testing.T) {
+	same := types.BytesToValidatorPubkey([]byte{0x01})
+	changed := types.BytesToValidatorPubkey([]byte{0x02})
+	onlyInB := types.BytesToValidatorPubkey([]byte{0x03})
+
+	a := newMegapoolDiffSide("A", map[string]*rprewards.SmoothingPoolMegapoolValidatorPerformance_v2{
+		same.Hex():    newMegapoolValidatorPerformance(same, 0, 100, nil, 1),
+		changed.Hex(): newMegapoolValidatorPerformance(changed, 1, 100, []uint64{10}, 1),
+	})
+	b := newMegapoolDiffSide("B", map[string]*rprewards.SmoothingPoolMegapoolValidatorPerformance_v2{
+		same.Hex():    newMegapoolValidatorPerformance(same, 0, 100, nil, 1),
+		changed.Hex(): newMegapoolValidatorPerformance(changed, 1, 90, []uint64{10, 12}, 0.5),
+		onlyInB.Hex(): newMegapoolValidatorPerformance(onlyInB, 2, 100, nil, 0.25),
+	})
+
+	var differences int
+	var err error
+	output := captureOutput(t, func() {
+		differences, err = diffMegapoolValidators(a, b)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if differences != 2 {
+		t.Fatalf("expected 2 differences, got %d", differences)
+	}
+	for _, expected := range []string{
+		"Megapool validator " + changed.Hex() + " (megapool 0x0000000000000000000000000000000000001234, ID 1):",
+		"Attestation score",
+		"Slots only missed in B",
+		"[12]",
+		"Megapool validator " + onlyInB.Hex() + " (megapool 0x0000000000000000000000000000000000001234, ID 2) is only in B",
+		"by -0.250000 ETH",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("expected the output to contain %q, got %q", expected, output)
+		}
+	}
+	if strings.Contains(output, same.Hex()) {
+		t.Fatalf("expected the unchanged validator not to be printed, got %q", output)
+	}
+
+	// Files from before megapools don't print the section at all
+	output = captureOutput(t, func() {
+		differences, err = diffMegapoolValidators(newMegapoolDiffSide("A", nil), newMegapoolDiffSide("B", nil))
+	})
+	if err != nil || differences != 0 || output != "" {
+		t.Fatalf("expected nothing to be compared, got %d differences, error %v and output %q", differences, err, output)
+	}
+}
@@ -119,6 +119,28 @@ func main() {
 		},
 	}
 
+	// Set application commands
+	app.Commands = []*cli.Command{
+		{
+			Name:      "diff",
+			Usage:     "Compare two rewards files and their minipool performance files, and explain where they differ. Works with every rewards file version, including SSZ files.",
+			ArgsUsage: "<rewards file A> <rewards file B>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "performance-a",
+					Aliases: []string{"pa"},
+					Usage:   "The minipool performance file that goes with rewards file A. Provide it along with --performance-b to compare the performance of each minipool and megapool validator.",
+				},
+				&cli.StringFlag{
+					Name:    "performance-b",
+					Aliases: []string{"pb"},
+					Usage:   "The minipool performance file that goes with rewards file B. Provide it along with --performance-a to compare the performance of each minipool and megapool validator.",
+				},
+			},
+			Action: DiffTrees,
+		},
+	}
+
 	app.Action = func(c *cli.Context) error {
 		cpuprofile := c.String("cpuprofile")
 		if cpuprofile != "" {
@@ -101,6 +101,7 @@ type treeGenerator struct {
 	prettyPrint         bool
 	ruleset             uint64
 	generateVotingPower bool
+	useRollingRecords   bool
 }
 
 // Generates a new rewards tree based on the command line flags
@@ -180,6 +181,7 @@ func GenerateTree(c *cli.Context) error {
 		prettyPrint:         c.Bool("pretty-print"),
 		ruleset:             c.Uint64("ruleset"),
 		generateVotingPower: c.Bool("generate-voting-power"),
+		useRollingRecords:   c.Bool("use-rolling-records"),
 	}
 
 	// initialize the generator targets
@@ -537,6 +539,35 @@ func (g *treeGenerator) getGenerator(args *treegenArguments) (*rprewards.TreeGen
 	return out, nil
 }
 
+// Loads the best rolling record from the records folder and updates it to the epoch before the snapshot's epoch
+func (g *treeGenerator) getRollingRecord(args *treegenArguments) (*rprewards.RollingRecord, error) {
+	recordsPath := filepath.Join(g.outputDir, "records")
+	mgr, err := rprewards.NewRollingRecordManager(g.log, "", g.bn, g.beaconConfig, recordsPath, g.cfg.Smartnode.RecordCheckpointInterval.Value.(uint64), g.cfg.Smartnode.CheckpointRetentionLimit.Value.(uint64))
+	if err != nil {
+		return nil, fmt.Errorf("error creating rolling record manager: %w", err)
+	}
+
+	targetSlot := (args.block.Slot/g.beaconConfig.SlotsPerEpoch)*g.beaconConfig.SlotsPerEpoch - 1
+	record, err := mgr.LoadBestRecordFromDisk(args.startSlot, targetSlot, args.index)
+	if err != nil {
+		return nil, fmt.Errorf("error loading rolling record: %w", err)
+	}
+
+	previousSlot := record.LastDutiesSlot
+	err = mgr.UpdateRecord(record, targetSlot, args.state)
+	if err != nil {
+		return nil, fmt.Errorf("error updating rolling record: %w", err)
+	}
+	if record.LastDutiesSlot != previousSlot {
+		err = mgr.SaveRecordToFile(record)
+		if err != nil {
+			return nil, fmt.Errorf("error saving rolling record: %w", err)
+		}
+	}
+
+	return record, nil
+}
+
 // Approximates the rETH stakers' share of the Smoothing Pool's current balance
 func (g *treeGenerator) approximateRethSpRewards() error {
 	args, err := g.getTreegenArgs()
@@ -599,6 +630,15 @@ func (g *treeGenerator) generateTree() error {
 		return err
 	}
 
+	// Bring the rolling record up to date and let the generator use it
+	if g.useRollingRecords {
+		record, err := g.getRollingRecord(args)
+		if err != nil {
+			return fmt.Errorf("error preparing rolling record: %w", err)
+		}
+		treegen.SetRollingRecord(record)
+	}
+
 	// If a voting power file was requested, generate it now.
 	if g.generateVotingPower {
 		votingPowerFile = g.GenerateVotingPower(args.state)